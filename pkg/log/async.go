@@ -0,0 +1,365 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	_ baseLogger              = &AsyncLogger{}
+	_ formatWithNewLineLogger = &AsyncLogger{}
+	_ Logger                  = &AsyncLogger{}
+)
+
+const defaultAsyncQueueSize = 1024
+
+type AsyncOverflowPolicy int
+
+const (
+	// AsyncOverflowBlock
+	// caller waits until worker writes records if queue is full
+	AsyncOverflowBlock AsyncOverflowPolicy = iota
+	// AsyncOverflowDropOldest
+	// oldest record in queue is dropped if queue is full
+	AsyncOverflowDropOldest
+)
+
+type asyncRecord struct {
+	write func()
+	// barrier
+	// record for waiting for all previous records written
+	barrier bool
+}
+
+type asyncQueue struct {
+	mu     sync.RWMutex
+	closed bool
+	policy AsyncOverflowPolicy
+
+	records chan asyncRecord
+	done    chan struct{}
+	dropped atomic.Uint64
+}
+
+func newAsyncQueue(size int) *asyncQueue {
+	if size <= 0 {
+		size = defaultAsyncQueueSize
+	}
+
+	q := &asyncQueue{
+		records: make(chan asyncRecord, size),
+		done:    make(chan struct{}),
+	}
+
+	go q.work()
+
+	return q
+}
+
+func (q *asyncQueue) work() {
+	defer close(q.done)
+
+	for r := range q.records {
+		r.write()
+	}
+}
+
+// push
+// returns false if queue was closed, record should be written by caller
+func (q *asyncQueue) push(r asyncRecord) bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.closed {
+		return false
+	}
+
+	if q.policy == AsyncOverflowBlock || r.barrier {
+		q.records <- r
+		return true
+	}
+
+	for {
+		select {
+		case q.records <- r:
+			return true
+		default:
+		}
+
+		select {
+		case oldest := <-q.records:
+			if oldest.barrier {
+				// do not drop barriers, waiters should be released
+				oldest.write()
+				continue
+			}
+
+			q.dropped.Add(1)
+		default:
+		}
+	}
+}
+
+// wait
+// waits for all records pushed before are written
+func (q *asyncQueue) wait() {
+	written := make(chan struct{})
+
+	if !q.push(asyncRecord{write: func() { close(written) }, barrier: true}) {
+		return
+	}
+
+	<-written
+}
+
+func (q *asyncQueue) close() {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.records)
+	}
+	q.mu.Unlock()
+
+	<-q.done
+}
+
+// AsyncLogger
+// writes records to parent logger from worker goroutine, so slow output
+// (pretty logger in slow terminal for example) does not stall caller
+// records are formatted in caller goroutine
+// all queued records are written before Process starts and ends and in FlushAndClose
+// after FlushAndClose records are written to parent synchronously
+type AsyncLogger struct {
+	*formatWithNewLineLoggerWrapper
+
+	parent Logger
+	queue  *asyncQueue
+}
+
+func NewAsyncLogger(parent Logger, queueSize int) *AsyncLogger {
+	return newAsyncLoggerWithQueue(parent, newAsyncQueue(queueSize))
+}
+
+func newAsyncLoggerWithQueue(parent Logger, queue *asyncQueue) *AsyncLogger {
+	l := &AsyncLogger{
+		parent: parent,
+		queue:  queue,
+	}
+
+	l.formatWithNewLineLoggerWrapper = newFormatWithNewLineLoggerWrapper(l)
+
+	return l
+}
+
+// WithOverflowPolicy
+// set behavior when queue is full, AsyncOverflowBlock by default
+func (l *AsyncLogger) WithOverflowPolicy(policy AsyncOverflowPolicy) *AsyncLogger {
+	l.queue.mu.Lock()
+	defer l.queue.mu.Unlock()
+
+	l.queue.policy = policy
+
+	return l
+}
+
+// Dropped
+// returns count of records dropped with AsyncOverflowDropOldest policy
+func (l *AsyncLogger) Dropped() uint64 {
+	return l.queue.dropped.Load()
+}
+
+// Wait
+// waits for all queued records are written to parent
+func (l *AsyncLogger) Wait() {
+	l.queue.wait()
+}
+
+func (l *AsyncLogger) WithFields(fields map[string]any) Logger {
+	return newAsyncLoggerWithQueue(l.parent.WithFields(fields), l.queue)
+}
+
+func (l *AsyncLogger) WithField(key string, value any) Logger {
+	return l.WithFields(map[string]any{key: value})
+}
+
+func (l *AsyncLogger) WithPrefix(prefix string) Logger {
+	return newPrefixLogger(l, prefix)
+}
+
+func (l *AsyncLogger) ProcessLogger() ProcessLogger {
+	return &asyncProcessLogger{
+		parent: l.parent.ProcessLogger(),
+		logger: l,
+	}
+}
+
+func (l *AsyncLogger) ProgressLogger() ProgressLogger {
+	return &asyncProgressLogger{
+		parent: l.parent.ProgressLogger(),
+		logger: l,
+	}
+}
+
+func (l *AsyncLogger) SilentLogger() *SilentLogger {
+	return l.parent.SilentLogger()
+}
+
+func (l *AsyncLogger) BufferLogger(buffer *bytes.Buffer) Logger {
+	return l.parent.BufferLogger(buffer)
+}
+
+// FlushAndClose
+// writes all queued records, stops worker and closes parent
+func (l *AsyncLogger) FlushAndClose() error {
+	l.queue.close()
+
+	return l.parent.FlushAndClose()
+}
+
+func (l *AsyncLogger) Process(p Process, t string, run func() error) error {
+	l.queue.wait()
+
+	return l.parent.Process(p, t, func() error {
+		defer l.queue.wait()
+
+		return run()
+	})
+}
+
+func (l *AsyncLogger) InfoFWithoutLn(format string, a ...interface{}) {
+	msg := sprintf(format, a...)
+	l.enqueue(func() { l.parent.InfoFWithoutLn("%s", msg) })
+}
+
+// InfoLn
+// Deprecated:
+// Use InfoF(string) it add \n to end
+func (l *AsyncLogger) InfoLn(a ...interface{}) {
+	msg := trimLn(fmt.Sprintln(a...))
+	l.enqueue(func() { l.parent.InfoLn(msg) })
+}
+
+func (l *AsyncLogger) ErrorFWithoutLn(format string, a ...interface{}) {
+	msg := sprintf(format, a...)
+	l.enqueue(func() { l.parent.ErrorFWithoutLn("%s", msg) })
+}
+
+// ErrorLn
+// Deprecated:
+// Use ErrorF(string) it add \n to end
+func (l *AsyncLogger) ErrorLn(a ...interface{}) {
+	msg := trimLn(fmt.Sprintln(a...))
+	l.enqueue(func() { l.parent.ErrorLn(msg) })
+}
+
+func (l *AsyncLogger) DebugFWithoutLn(format string, a ...interface{}) {
+	msg := sprintf(format, a...)
+	l.enqueue(func() { l.parent.DebugFWithoutLn("%s", msg) })
+}
+
+// DebugLn
+// Deprecated:
+// Use DebugF(string) it add \n to end
+func (l *AsyncLogger) DebugLn(a ...interface{}) {
+	msg := trimLn(fmt.Sprintln(a...))
+	l.enqueue(func() { l.parent.DebugLn(msg) })
+}
+
+func (l *AsyncLogger) WarnFWithoutLn(format string, a ...interface{}) {
+	msg := sprintf(format, a...)
+	l.enqueue(func() { l.parent.WarnFWithoutLn("%s", msg) })
+}
+
+// WarnLn
+// Deprecated:
+// Use WarnF(string) it add \n to end
+func (l *AsyncLogger) WarnLn(a ...interface{}) {
+	msg := trimLn(fmt.Sprintln(a...))
+	l.enqueue(func() { l.parent.WarnLn(msg) })
+}
+
+func (l *AsyncLogger) Success(s string) {
+	l.enqueue(func() { l.parent.Success(s) })
+}
+
+func (l *AsyncLogger) Fail(s string) {
+	l.enqueue(func() { l.parent.Fail(s) })
+}
+
+func (l *AsyncLogger) FailRetry(s string) {
+	l.enqueue(func() { l.parent.FailRetry(s) })
+}
+
+func (l *AsyncLogger) JSON(content []byte) {
+	c := bytes.Clone(content)
+	l.enqueue(func() { l.parent.JSON(c) })
+}
+
+// Write
+// content is written asynchronously, so parent write error is not returned
+func (l *AsyncLogger) Write(content []byte) (int, error) {
+	c := bytes.Clone(content)
+	l.enqueue(func() {
+		if _, err := l.parent.Write(c); err != nil {
+			l.parent.DebugF("Cannot write to log: %v", err)
+		}
+	})
+
+	return len(content), nil
+}
+
+func (l *AsyncLogger) enqueue(write func()) {
+	if !l.queue.push(asyncRecord{write: write}) {
+		write()
+	}
+}
+
+type asyncProcessLogger struct {
+	parent ProcessLogger
+	logger *AsyncLogger
+}
+
+func (p *asyncProcessLogger) ProcessStart(name string) {
+	p.logger.enqueue(func() { p.parent.ProcessStart(name) })
+}
+
+func (p *asyncProcessLogger) ProcessFail() {
+	p.logger.enqueue(p.parent.ProcessFail)
+}
+
+func (p *asyncProcessLogger) ProcessEnd() {
+	p.logger.enqueue(p.parent.ProcessEnd)
+}
+
+type asyncProgressLogger struct {
+	parent ProgressLogger
+	logger *AsyncLogger
+}
+
+func (p *asyncProgressLogger) StartProgress(total int) {
+	p.logger.enqueue(func() { p.parent.StartProgress(total) })
+}
+
+func (p *asyncProgressLogger) Increment(msg string) {
+	p.logger.enqueue(func() { p.parent.Increment(msg) })
+}
+
+func (p *asyncProgressLogger) Done() {
+	p.logger.enqueue(p.parent.Done)
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsyncLoggerFollowInterfaces(t *testing.T) {
+	logger := NewAsyncLogger(NewSimpleLogger(LoggerOptions{IsDebug: true}), 10)
+	defer logger.FlushAndClose()
+
+	assertFollowAllInterfaces(t, logger)
+}
+
+func TestAsyncLogger(t *testing.T) {
+	messages := func(logger *InMemoryLogger) []string {
+		res := make([]string, 0)
+		for _, entry := range logger.Entries() {
+			res = append(res, entry.String())
+		}
+
+		return res
+	}
+
+	t.Run("all records written in order after close", func(t *testing.T) {
+		parent := NewInMemoryLogger()
+		logger := NewAsyncLogger(parent, 2)
+
+		expected := make([]string, 0)
+		for i := 0; i < 100; i++ {
+			logger.InfoF("message %d", i)
+			expected = append(expected, fmt.Sprintf("message %d\n", i))
+		}
+
+		logger.WithField("node", "master-0").WarnF("with field")
+		expected = append(expected, "with field | fields: [node='master-0']\n")
+
+		require.NoError(t, logger.FlushAndClose())
+		require.Equal(t, expected, messages(parent))
+
+		logger.InfoF("after close")
+		require.Contains(t, messages(parent), "after close\n")
+	})
+
+	t.Run("records written before process", func(t *testing.T) {
+		parent := NewInMemoryLogger()
+		logger := NewAsyncLogger(parent, 10)
+
+		logger.InfoF("before")
+		err := logger.Process(ProcessBootstrap, "Bootstrap", func() error {
+			logger.InfoF("inside")
+			return nil
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, []string{
+			"before\n",
+			"Start process: bootstrap/Bootstrap",
+			"inside\n",
+			"End process: bootstrap/Bootstrap",
+		}, messages(parent))
+
+		require.NoError(t, logger.FlushAndClose())
+	})
+
+	t.Run("drop oldest", func(t *testing.T) {
+		parent := NewInMemoryLogger()
+		logger := NewAsyncLogger(parent, 1).WithOverflowPolicy(AsyncOverflowDropOldest)
+
+		// block worker
+		started := make(chan struct{})
+		release := make(chan struct{})
+		logger.enqueue(func() {
+			close(started)
+			<-release
+		})
+		<-started
+
+		for i := 0; i < 5; i++ {
+			logger.InfoF("message %d", i)
+		}
+
+		close(release)
+		logger.Wait()
+
+		require.Equal(t, uint64(4), logger.Dropped())
+		require.Equal(t, []string{"message 4\n"}, messages(parent))
+
+		require.NoError(t, logger.FlushAndClose())
+	})
+
+	t.Run("data is copied", func(t *testing.T) {
+		parent := NewInMemoryLogger()
+		logger := NewAsyncLogger(parent, 10)
+
+		content := []byte("content")
+		n, err := logger.Write(content)
+		require.NoError(t, err)
+		require.Equal(t, len(content), n)
+
+		copy(content, "changed")
+
+		require.NoError(t, logger.FlushAndClose())
+		require.Equal(t, []string{"content"}, messages(parent))
+	})
+}
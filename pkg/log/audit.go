@@ -0,0 +1,223 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/name212/govalue"
+)
+
+type AuditOutcome string
+
+const (
+	AuditOutcomeSuccess AuditOutcome = "success"
+	AuditOutcomeFailure AuditOutcome = "failure"
+	AuditOutcomeDenied  AuditOutcome = "denied"
+)
+
+// AuditRecord
+// one line of audit log
+type AuditRecord struct {
+	Time    time.Time      `json:"time"`
+	Action  string         `json:"action"`
+	Subject string         `json:"subject"`
+	Outcome AuditOutcome   `json:"outcome"`
+	Attrs   map[string]any `json:"attrs,omitempty"`
+	// PrevHash and Hash
+	// passed if hash chaining enabled
+	// Hash is sha256 of PrevHash and record without Hash
+	PrevHash string `json:"prev-hash,omitempty"`
+	Hash     string `json:"hash,omitempty"`
+}
+
+type AuditOptions struct {
+	// HashChain
+	// every record contains hash of previous record, use VerifyAuditChain for checking
+	// that records were not changed, removed or reordered
+	HashChain bool
+	// Logger
+	// if passed, every record is also written to logger with info level
+	Logger Logger
+}
+
+// AuditLogger
+// writes audit records as newline-delimited JSON to sink
+// records are written regardless of debug mode and logger level
+// safe for concurrent use
+type AuditLogger struct {
+	mu sync.Mutex
+
+	sink     io.Writer
+	opts     AuditOptions
+	lastHash string
+	now      func() time.Time
+}
+
+func NewAuditLogger(sink io.Writer) *AuditLogger {
+	return NewAuditLoggerWithOptions(sink, AuditOptions{})
+}
+
+func NewAuditLoggerWithOptions(sink io.Writer, opts AuditOptions) *AuditLogger {
+	return &AuditLogger{
+		sink: sink,
+		opts: opts,
+		now:  time.Now,
+	}
+}
+
+// Audit
+// writes record, secrets are masked in subject and string attrs
+// returns error if record was not written to sink
+func (l *AuditLogger) Audit(action, subject string, outcome AuditOutcome, attrs map[string]any) error {
+	record := AuditRecord{
+		Action:  action,
+		Subject: maskSecrets(subject),
+		Outcome: outcome,
+	}
+
+	if len(attrs) > 0 {
+		record.Attrs = make(map[string]any, len(attrs))
+		for k, v := range attrs {
+			if str, ok := v.(string); ok {
+				v = maskSecrets(str)
+			}
+
+			record.Attrs[k] = v
+		}
+	}
+
+	if err := l.write(&record); err != nil {
+		return err
+	}
+
+	if !govalue.IsNil(l.opts.Logger) {
+		l.opts.Logger.WithFields(record.Attrs).InfoF("Audit: action=%s subject=%s outcome=%s", record.Action, record.Subject, record.Outcome)
+	}
+
+	return nil
+}
+
+func (l *AuditLogger) write(record *AuditRecord) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	record.Time = l.now().UTC()
+
+	if l.opts.HashChain {
+		record.PrevHash = l.lastHash
+
+		hash, err := auditRecordHash(*record)
+		if err != nil {
+			return err
+		}
+
+		record.Hash = hash
+	}
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("Cannot marshal audit record: %w", err)
+	}
+
+	if _, err := l.sink.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("Cannot write audit record: %w", err)
+	}
+
+	if l.opts.HashChain {
+		l.lastHash = record.Hash
+	}
+
+	return nil
+}
+
+// auditRecordHash
+// record is hashed in canonical form (sorted keys, numbers as written) because
+// attrs after reading from log are not same types as passed to Audit
+func auditRecordHash(record AuditRecord) (string, error) {
+	record.Hash = ""
+
+	content, err := json.Marshal(record)
+	if err != nil {
+		return "", fmt.Errorf("Cannot marshal audit record: %w", err)
+	}
+
+	var canonical any
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	if err := decoder.Decode(&canonical); err != nil {
+		return "", fmt.Errorf("Cannot decode audit record: %w", err)
+	}
+
+	content, err = json.Marshal(canonical)
+	if err != nil {
+		return "", fmt.Errorf("Cannot marshal audit record: %w", err)
+	}
+
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// VerifyAuditChain
+// checks hash chain of audit log written with HashChain option
+// returns error with line number of first changed, removed or reordered record
+func VerifyAuditChain(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	prevHash := ""
+	line := 0
+
+	for scanner.Scan() {
+		line++
+
+		var record AuditRecord
+		decoder := json.NewDecoder(bytes.NewReader(scanner.Bytes()))
+		decoder.UseNumber()
+		if err := decoder.Decode(&record); err != nil {
+			return fmt.Errorf("Cannot parse audit record on line %d: %w", line, err)
+		}
+
+		if record.PrevHash != prevHash {
+			return fmt.Errorf("Audit record on line %d does not follow previous record", line)
+		}
+
+		hash, err := auditRecordHash(record)
+		if err != nil {
+			return err
+		}
+
+		if hash != record.Hash {
+			return fmt.Errorf("Audit record on line %d was changed", line)
+		}
+
+		prevHash = record.Hash
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("Cannot read audit log: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLogger(t *testing.T) {
+	t.Run("write records", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		logger := NewAuditLogger(out)
+
+		require.NoError(t, logger.Audit("destroy", "cluster", AuditOutcomeSuccess, map[string]any{"user": "admin", "nodes": 3}))
+		require.NoError(t, logger.Audit("converge", "cluster", AuditOutcomeFailure, nil))
+
+		records := testReadAuditRecords(t, out.String())
+		require.Len(t, records, 2)
+
+		require.Equal(t, "destroy", records[0].Action)
+		require.Equal(t, "cluster", records[0].Subject)
+		require.Equal(t, AuditOutcomeSuccess, records[0].Outcome)
+		require.Equal(t, map[string]any{"user": "admin", "nodes": float64(3)}, records[0].Attrs)
+		require.False(t, records[0].Time.IsZero())
+		require.Empty(t, records[0].Hash)
+
+		require.Equal(t, AuditOutcomeFailure, records[1].Outcome)
+		require.Nil(t, records[1].Attrs)
+	})
+
+	t.Run("write error", func(t *testing.T) {
+		logger := NewAuditLogger(&testFailWriter{})
+
+		require.Error(t, logger.Audit("destroy", "cluster", AuditOutcomeSuccess, nil))
+	})
+
+	t.Run("tee to logger", func(t *testing.T) {
+		inMemory := NewInMemoryLogger()
+		logger := NewAuditLoggerWithOptions(&bytes.Buffer{}, AuditOptions{Logger: inMemory})
+
+		require.NoError(t, logger.Audit("destroy", "cluster", AuditOutcomeDenied, map[string]any{"user": "admin"}))
+
+		matches, err := inMemory.AllMatches(&Match{Prefix: []string{"Audit: action=destroy subject=cluster outcome=denied"}})
+		require.NoError(t, err)
+		require.Len(t, matches, 1)
+		require.Contains(t, matches[0], "user='admin'")
+	})
+}
+
+func TestAuditHashChain(t *testing.T) {
+	write := func(t *testing.T) string {
+		out := &bytes.Buffer{}
+		logger := NewAuditLoggerWithOptions(out, AuditOptions{HashChain: true})
+
+		type node struct {
+			Name string `json:"name"`
+			IP   string `json:"ip"`
+		}
+
+		require.NoError(t, logger.Audit("create", "node", AuditOutcomeSuccess, map[string]any{"node": node{Name: "master-0", IP: "10.0.0.1"}}))
+		require.NoError(t, logger.Audit("destroy", "cluster", AuditOutcomeSuccess, map[string]any{"nodes": 3, "ratio": 0.5}))
+		require.NoError(t, logger.Audit("converge", "cluster", AuditOutcomeFailure, nil))
+
+		return out.String()
+	}
+
+	t.Run("valid chain", func(t *testing.T) {
+		content := write(t)
+
+		records := testReadAuditRecords(t, content)
+		require.Len(t, records, 3)
+		require.Empty(t, records[0].PrevHash)
+		require.Equal(t, records[0].Hash, records[1].PrevHash)
+		require.Equal(t, records[1].Hash, records[2].PrevHash)
+
+		require.NoError(t, VerifyAuditChain(strings.NewReader(content)))
+	})
+
+	t.Run("changed record", func(t *testing.T) {
+		content := strings.Replace(write(t), `"outcome":"failure"`, `"outcome":"success"`, 1)
+
+		err := VerifyAuditChain(strings.NewReader(content))
+		require.ErrorContains(t, err, "line 3 was changed")
+	})
+
+	t.Run("removed record", func(t *testing.T) {
+		lines := strings.SplitAfter(write(t), "\n")
+		content := lines[0] + lines[2]
+
+		err := VerifyAuditChain(strings.NewReader(content))
+		require.ErrorContains(t, err, "line 2 does not follow previous record")
+	})
+}
+
+func testReadAuditRecords(t *testing.T, content string) []AuditRecord {
+	res := make([]AuditRecord, 0)
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		var record AuditRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		res = append(res, record)
+	}
+
+	return res
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"io"
+	"os"
+
+	"github.com/gookit/color"
+)
+
+type ColorMode string
+
+const (
+	// ColorModeAuto
+	// colors are enabled if NO_COLOR env is empty and output is terminal
+	ColorModeAuto ColorMode = "auto"
+	// ColorModeAlways
+	// colors are always enabled, also force colors in gookit/color globally
+	ColorModeAlways ColorMode = "always"
+	// ColorModeNever
+	// colors are disabled
+	ColorModeNever ColorMode = "never"
+)
+
+const noColorEnv = "NO_COLOR"
+
+// colorsEnabled
+// empty mode is ColorModeAuto, nil out is stdout
+func colorsEnabled(mode ColorMode, out io.Writer) bool {
+	switch mode {
+	case ColorModeAlways:
+		return true
+	case ColorModeNever:
+		return false
+	}
+
+	if os.Getenv(noColorEnv) != "" {
+		return false
+	}
+
+	return terminalFile(out) != nil
+}
+
+// forceColors
+// gookit/color disables colors if NO_COLOR env passed or terminal does not support colors
+func forceColors() {
+	color.Enable = true
+
+	if !color.SupportColor() {
+		color.ForceOpenColor()
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gookit/color"
+	"github.com/stretchr/testify/require"
+)
+
+const testEscapeSequence = "\x1b["
+
+func TestPrettyColorMode(t *testing.T) {
+	enable := color.Enable
+	level := color.ForceOpenColor()
+	color.ForceSetColorLevel(level)
+
+	t.Cleanup(func() {
+		color.Enable = enable
+		color.ForceSetColorLevel(level)
+	})
+
+	writeAll := func(logger *PrettyLogger) {
+		logger.WarnF("warning %s\n", "message")
+		logger.WarnLn("warning line")
+		_ = logger.Process(ProcessDefault, "my process", func() error {
+			logger.InfoF("in process\n")
+			return nil
+		})
+	}
+
+	tests := []struct {
+		name    string
+		mode    ColorMode
+		noColor string
+		colors  bool
+	}{
+		{name: "auto not terminal", mode: ColorModeAuto},
+		{name: "empty mode not terminal"},
+		{name: "never", mode: ColorModeNever},
+		{name: "always", mode: ColorModeAlways, colors: true},
+		{name: "always with NO_COLOR", mode: ColorModeAlways, noColor: "1", colors: true},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.name, func(t *testing.T) {
+			t.Setenv(noColorEnv, tst.noColor)
+
+			out := &bytes.Buffer{}
+			logger := NewPrettyLogger(LoggerOptions{OutStream: out, ColorMode: tst.mode})
+			writeAll(logger)
+
+			require.Contains(t, out.String(), "warning message")
+			require.Contains(t, out.String(), "warning line")
+			require.Equal(t, tst.colors, bytes.Contains(out.Bytes(), []byte(testEscapeSequence)), out.String())
+		})
+	}
+
+	t.Run("buffer logger inherits colors", func(t *testing.T) {
+		t.Setenv(noColorEnv, "")
+
+		out := &bytes.Buffer{}
+		buffer := &bytes.Buffer{}
+
+		logger := NewPrettyLogger(LoggerOptions{OutStream: out, ColorMode: ColorModeAlways})
+		writeAll(logger.BufferLogger(buffer).(*PrettyLogger))
+		require.Contains(t, buffer.String(), testEscapeSequence)
+
+		buffer.Reset()
+
+		logger = NewPrettyLogger(LoggerOptions{OutStream: out, ColorMode: ColorModeNever})
+		writeAll(logger.BufferLogger(buffer).(*PrettyLogger))
+		require.NotContains(t, buffer.String(), testEscapeSequence)
+	})
+}
+
+func TestColorsEnabled(t *testing.T) {
+	t.Setenv(noColorEnv, "")
+
+	require.False(t, colorsEnabled(ColorModeAuto, &bytes.Buffer{}))
+	require.True(t, colorsEnabled(ColorModeAlways, &bytes.Buffer{}))
+	require.False(t, colorsEnabled(ColorModeNever, &bytes.Buffer{}))
+
+	t.Setenv(noColorEnv, "1")
+
+	require.False(t, colorsEnabled(ColorModeAuto, nil))
+	require.True(t, colorsEnabled(ColorModeAlways, nil))
+}
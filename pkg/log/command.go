@@ -0,0 +1,95 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+)
+
+var _ io.Writer = &CommandWriter{}
+
+// NewCommandStreams
+// returns writers for stdout and stderr of external command.
+// every line is written to logger with prefix (usually command name) like: prefix: line
+// stdout lines are written with InfoF, stderr lines with WarnF.
+// call Flush for both writers after command finished for writing last lines without new line
+func NewCommandStreams(logger Logger, prefix string) (stdout *CommandWriter, stderr *CommandWriter) {
+	logger = SafeProvideLogger(SimpleLoggerProvider(logger)).WithPrefix(prefix)
+
+	stdout = newCommandWriter(func(line string) {
+		logger.InfoF("%s", line)
+	})
+
+	stderr = newCommandWriter(func(line string) {
+		logger.WarnF("%s", line)
+	})
+
+	return stdout, stderr
+}
+
+// CommandWriter
+// splits written content to lines and writes every line to logger
+type CommandWriter struct {
+	writeLine func(line string)
+
+	mu      sync.Mutex
+	partial []byte
+}
+
+func newCommandWriter(writeLine func(line string)) *CommandWriter {
+	return &CommandWriter{
+		writeLine: writeLine,
+	}
+}
+
+// Write
+// last line without new line is kept until next Write or Flush
+func (w *CommandWriter) Write(content []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.partial = append(w.partial, content...)
+
+	for {
+		i := bytes.IndexByte(w.partial, '\n')
+		if i < 0 {
+			break
+		}
+
+		w.write(string(w.partial[:i]))
+		w.partial = w.partial[i+1:]
+	}
+
+	return len(content), nil
+}
+
+// Flush
+// writes last line without new line
+func (w *CommandWriter) Flush() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.partial) > 0 {
+		w.write(string(w.partial))
+		w.partial = nil
+	}
+}
+
+func (w *CommandWriter) write(line string) {
+	w.writeLine(strings.TrimSuffix(line, "\r"))
+}
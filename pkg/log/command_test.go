@@ -0,0 +1,62 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandStreams(t *testing.T) {
+	logger := NewInMemoryLogger()
+	stdout, stderr := NewCommandStreams(logger, "kubeadm")
+
+	write := func(w io.Writer, s string) {
+		n, err := w.Write([]byte(s))
+		require.NoError(t, err)
+		require.Equal(t, len(s), n)
+	}
+
+	write(stdout, "first line\nsecond ")
+	write(stderr, "warning\r\n")
+	write(stdout, "line\nlast")
+
+	require.Len(t, logger.Entries(), 3)
+
+	stdout.Flush()
+	stderr.Flush()
+
+	res := make([]string, 0)
+	for _, entry := range logger.Entries() {
+		res = append(res, fmt.Sprintf("%s: %s", entry.Level, entry.String()))
+	}
+
+	require.Equal(t, []string{
+		"info: kubeadm: first line\n",
+		"warn: kubeadm: warning\n",
+		"info: kubeadm: second line\n",
+		"info: kubeadm: last\n",
+	}, res)
+
+	t.Run("nil logger", func(t *testing.T) {
+		stdout, _ := NewCommandStreams(nil, "kubeadm")
+		require.NotPanics(t, func() {
+			write(stdout, "line\n")
+		})
+	})
+}
@@ -0,0 +1,168 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"strings"
+	"sync"
+)
+
+// ComponentLevelsEnv
+// env with component levels like: ssh=debug,kube=warn
+const ComponentLevelsEnv = "DHCTL_LOG_LEVELS"
+
+var (
+	levelsPriority = map[Level]int{
+		LevelDebug: 0,
+		LevelInfo:  1,
+		LevelWarn:  2,
+		LevelError: 3,
+	}
+
+	levelsAliases = map[string]Level{
+		"debug":   LevelDebug,
+		"info":    LevelInfo,
+		"warn":    LevelWarn,
+		"warning": LevelWarn,
+		"error":   LevelError,
+	}
+
+	defaultComponentLevels = &componentLevelsRegistry{}
+)
+
+// ComponentLevels
+// minimal level of messages for components, component is prefix passed to WithPrefix
+// for nested prefixes most specific component is used, for example
+// for logger.WithPrefix("ssh").WithPrefix("client") levels of "ssh: client" and then "ssh" are checked
+// messages of components without level are passed to logger as is
+type ComponentLevels map[string]Level
+
+// ParseComponentLevels
+// parses levels like: ssh=debug,kube=warn
+// spaces around components and levels and empty entries are ignored
+func ParseComponentLevels(s string) (ComponentLevels, error) {
+	res := make(ComponentLevels)
+
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		component, lvl, found := strings.Cut(entry, "=")
+		component = strings.TrimSpace(component)
+		if !found || component == "" {
+			return nil, fmt.Errorf("Invalid component level '%s'. Should be component=level", entry)
+		}
+
+		level, ok := levelsAliases[strings.ToLower(strings.TrimSpace(lvl))]
+		if !ok {
+			return nil, fmt.Errorf("Unknown level '%s' for component '%s'. Should be debug, info, warn or error", strings.TrimSpace(lvl), component)
+		}
+
+		res[component] = level
+	}
+
+	return res, nil
+}
+
+// SetComponentLevels
+// set levels used by all prefix-scoped loggers, nil resets levels
+func SetComponentLevels(levels ComponentLevels) {
+	defaultComponentLevels.set(levels)
+}
+
+// InitComponentLevelsFromEnv
+// parses ComponentLevelsEnv and set levels used by all prefix-scoped loggers
+// does nothing if env is empty
+func InitComponentLevelsFromEnv() error {
+	env := os.Getenv(ComponentLevelsEnv)
+	if env == "" {
+		return nil
+	}
+
+	levels, err := ParseComponentLevels(env)
+	if err != nil {
+		return fmt.Errorf("Cannot parse %s: %w", ComponentLevelsEnv, err)
+	}
+
+	SetComponentLevels(levels)
+
+	return nil
+}
+
+// levelFor
+// returns level of most specific component for prefix like: parent: child
+func (c ComponentLevels) levelFor(prefix string) (Level, bool) {
+	for prefix != "" {
+		if level, ok := c[prefix]; ok {
+			return level, true
+		}
+
+		i := strings.LastIndex(prefix, ": ")
+		if i < 0 {
+			break
+		}
+
+		prefix = prefix[:i]
+	}
+
+	return "", false
+}
+
+type componentLevelsRegistry struct {
+	m      sync.RWMutex
+	levels ComponentLevels
+}
+
+func (r *componentLevelsRegistry) set(levels ComponentLevels) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.levels = maps.Clone(levels)
+}
+
+func (r *componentLevelsRegistry) levelFor(prefix string) (Level, bool) {
+	r.m.RLock()
+	defer r.m.RUnlock()
+
+	if len(r.levels) == 0 {
+		return "", false
+	}
+
+	return r.levels.levelFor(prefix)
+}
+
+// componentLevelEnabled
+// returns true if message with level should be written for component
+func componentLevelEnabled(prefix string, level Level) bool {
+	minLevel, ok := defaultComponentLevels.levelFor(prefix)
+	if !ok {
+		return true
+	}
+
+	return levelsPriority[level] >= levelsPriority[minLevel]
+}
+
+// componentDebugForced
+// returns true if component level is debug, in this case debug messages
+// are written with info level for showing them without debug mode
+func componentDebugForced(prefix string) bool {
+	level, ok := defaultComponentLevels.levelFor(prefix)
+	return ok && level == LevelDebug
+}
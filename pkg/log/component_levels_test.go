@@ -0,0 +1,106 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseComponentLevels(t *testing.T) {
+	levels, err := ParseComponentLevels(" ssh=debug, kube = WARN,,ssh: client=error ")
+	require.NoError(t, err)
+	require.Equal(t, ComponentLevels{
+		"ssh":         LevelDebug,
+		"kube":        LevelWarn,
+		"ssh: client": LevelError,
+	}, levels)
+
+	levels, err = ParseComponentLevels("")
+	require.NoError(t, err)
+	require.Empty(t, levels)
+
+	_, err = ParseComponentLevels("ssh")
+	require.ErrorContains(t, err, "Should be component=level")
+
+	_, err = ParseComponentLevels("=debug")
+	require.ErrorContains(t, err, "Should be component=level")
+
+	_, err = ParseComponentLevels("ssh=verbose")
+	require.ErrorContains(t, err, "Unknown level 'verbose' for component 'ssh'")
+}
+
+func TestComponentLevelsLevelFor(t *testing.T) {
+	levels := ComponentLevels{
+		"ssh":         LevelDebug,
+		"ssh: client": LevelError,
+	}
+
+	level, ok := levels.levelFor("ssh: client: upload")
+	require.True(t, ok)
+	require.Equal(t, LevelError, level)
+
+	level, ok = levels.levelFor("ssh: server")
+	require.True(t, ok)
+	require.Equal(t, LevelDebug, level)
+
+	_, ok = levels.levelFor("kube")
+	require.False(t, ok)
+}
+
+func TestComponentLevels(t *testing.T) {
+	t.Cleanup(func() {
+		SetComponentLevels(nil)
+	})
+
+	t.Setenv(ComponentLevelsEnv, "ssh=debug,kube=warn")
+	require.NoError(t, InitComponentLevelsFromEnv())
+
+	parent := NewInMemoryLogger()
+	ssh := parent.WithPrefix("ssh")
+	kube := parent.WithPrefix("kube")
+	other := parent.WithPrefix("other")
+
+	ssh.DebugF("ssh debug")
+	kube.DebugF("kube debug")
+	kube.InfoF("kube info")
+	kube.Success("kube success")
+	kube.WarnF("kube warn")
+	kube.ErrorF("kube error")
+	other.DebugF("other debug")
+	other.InfoF("other info")
+
+	matches, err := parent.AllMatches(&Match{Suffix: []string{"\n"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"ssh: ssh debug\n",
+		"kube: kube warn\n",
+		"kube: kube error\n",
+		"other: other debug\n",
+		"other: other info\n",
+	}, matches)
+
+	entries, err := parent.MatchEntries(&Match{Prefix: []string{"ssh: ssh debug"}})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	// debug component messages are shown without debug mode
+	require.Equal(t, LevelInfo, entries[0].Level)
+
+	t.Run("invalid env", func(t *testing.T) {
+		t.Setenv(ComponentLevelsEnv, "ssh")
+		require.ErrorContains(t, InitComponentLevelsFromEnv(), ComponentLevelsEnv)
+	})
+}
@@ -0,0 +1,377 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var (
+	_ baseLogger              = &DedupLogger{}
+	_ formatWithNewLineLogger = &DedupLogger{}
+	_ Logger                  = &DedupLogger{}
+)
+
+// dedupRepeatedFunc
+// writes count of collapsed messages
+type dedupRepeatedFunc func(count int)
+
+// dedupState
+// collapses identical consecutive messages written within window, used by DedupLogger and klog throttle.
+// all writes are called with locked mutex, so writes of count of collapsed messages from timer
+// are serialized with another writes
+type dedupState struct {
+	mu sync.Mutex
+
+	window time.Duration
+	now    func() time.Time
+
+	lastKey      string
+	lastRepeated dedupRepeatedFunc
+	firstAt      time.Time
+	repeated     int
+	generation   int
+	timer        *time.Timer
+}
+
+func newDedupState(window time.Duration) *dedupState {
+	return &dedupState{
+		window: window,
+		now:    time.Now,
+	}
+}
+
+// write
+// calls write if message with key is not equal to previous message written in window,
+// otherwise counts message as repeated. count is written with repeated
+// when another message is written or window is expired
+func (s *dedupState) write(key string, write func(), repeated dedupRepeatedFunc) {
+	if s.window <= 0 {
+		write()
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+
+	if s.lastRepeated != nil && key == s.lastKey && now.Sub(s.firstAt) < s.window {
+		s.repeated++
+		if s.repeated == 1 {
+			s.scheduleFlush(s.window - now.Sub(s.firstAt))
+		}
+
+		return
+	}
+
+	s.flushRepeated()
+
+	s.lastKey = key
+	s.lastRepeated = repeated
+	s.firstAt = now
+
+	write()
+}
+
+// flushAndWrite
+// writes count of collapsed messages and calls write, used for messages which are not deduplicated
+func (s *dedupState) flushAndWrite(write func()) {
+	if s.window <= 0 {
+		write()
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.flushRepeated()
+	write()
+}
+
+// flush
+// writes count of collapsed messages
+func (s *dedupState) flush() {
+	s.flushAndWrite(func() {})
+}
+
+// process
+// calls process with locked mutex for serializing process start and end with another writes,
+// run is called without lock. count of collapsed messages is written before start and end of process
+func (s *dedupState) process(process func(run func() error) error, run func() error) error {
+	if s.window <= 0 {
+		return process(run)
+	}
+
+	s.mu.Lock()
+	locked := true
+
+	defer func() {
+		if locked {
+			s.mu.Unlock()
+		}
+	}()
+
+	s.flushRepeated()
+
+	return process(func() error {
+		s.mu.Unlock()
+		locked = false
+
+		defer func() {
+			s.mu.Lock()
+			locked = true
+
+			s.flushRepeated()
+		}()
+
+		return run()
+	})
+}
+
+// flushRepeated
+// writes count of collapsed messages and resets state
+func (s *dedupState) flushRepeated() {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+
+	s.generation++
+
+	if s.repeated > 0 && s.lastRepeated != nil {
+		s.lastRepeated(s.repeated)
+	}
+
+	s.repeated = 0
+	s.lastKey = ""
+	s.lastRepeated = nil
+}
+
+// scheduleFlush
+// writes count of collapsed messages after window if another message was not written
+func (s *dedupState) scheduleFlush(after time.Duration) {
+	generation := s.generation
+
+	s.timer = time.AfterFunc(after, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if s.generation == generation {
+			s.flushRepeated()
+		}
+	})
+}
+
+// WrapWithDedup
+// returns logger which collapses identical consecutive messages written within window
+// into first message and one message like: …message repeated N times: msg
+// messages are identical if they have the same level, text and fields.
+// count of repeated messages is written when another message is written or window is expired.
+// loggers returned from WithFields and WithPrefix share state with returned logger.
+// processes, json and raw writes are not deduplicated.
+// disabled if window <= 0
+func WrapWithDedup(logger Logger, window time.Duration) *DedupLogger {
+	return newDedupLogger(logger, newDedupState(window), nil)
+}
+
+type DedupLogger struct {
+	*formatWithNewLineLoggerWrapper
+
+	parent Logger
+	state  *dedupState
+	fields map[string]any
+}
+
+func newDedupLogger(parent Logger, state *dedupState, fields map[string]any) *DedupLogger {
+	l := &DedupLogger{
+		parent: parent,
+		state:  state,
+		fields: fields,
+	}
+
+	l.formatWithNewLineLoggerWrapper = newFormatWithNewLineLoggerWrapper(l)
+
+	return l
+}
+
+// WithFields
+// fields are part of message identity, so messages with different fields are not collapsed
+func (l *DedupLogger) WithFields(fields map[string]any) Logger {
+	return newDedupLogger(l.parent.WithFields(fields), l.state, mergeFields(l.fields, fields))
+}
+
+func (l *DedupLogger) WithField(key string, value any) Logger {
+	return l.WithFields(map[string]any{key: value})
+}
+
+func (l *DedupLogger) WithPrefix(prefix string) Logger {
+	return newPrefixLogger(l, prefix)
+}
+
+func (l *DedupLogger) ProcessLogger() ProcessLogger {
+	return &dedupProcessLogger{
+		parent: l.parent.ProcessLogger(),
+		state:  l.state,
+	}
+}
+
+func (l *DedupLogger) ProgressLogger() ProgressLogger {
+	return l.parent.ProgressLogger()
+}
+
+func (l *DedupLogger) SilentLogger() *SilentLogger {
+	return l.parent.SilentLogger()
+}
+
+// BufferLogger
+// buffer logger has own state, because messages are written to another output
+func (l *DedupLogger) BufferLogger(buffer *bytes.Buffer) Logger {
+	return newDedupLogger(l.parent.BufferLogger(buffer), newDedupState(l.state.window), l.fields)
+}
+
+func (l *DedupLogger) FlushAndClose() error {
+	l.state.flush()
+	return l.parent.FlushAndClose()
+}
+
+func (l *DedupLogger) Process(p Process, t string, run func() error) error {
+	return l.state.process(func(run func() error) error {
+		return l.parent.Process(p, t, run)
+	}, run)
+}
+
+func (l *DedupLogger) InfoFWithoutLn(format string, a ...interface{}) {
+	l.write(LevelInfo, sprintf(format, a...), func(msg string) {
+		l.parent.InfoFWithoutLn("%s", msg)
+	})
+}
+
+// InfoLn
+// Deprecated:
+// Use InfoF(string) it add \n to end
+func (l *DedupLogger) InfoLn(a ...interface{}) {
+	l.InfoFWithoutLn("%s", fmt.Sprintln(a...))
+}
+
+func (l *DedupLogger) ErrorFWithoutLn(format string, a ...interface{}) {
+	l.write(LevelError, sprintf(format, a...), func(msg string) {
+		l.parent.ErrorFWithoutLn("%s", msg)
+	})
+}
+
+// ErrorLn
+// Deprecated:
+// Use ErrorF(string) it add \n to end
+func (l *DedupLogger) ErrorLn(a ...interface{}) {
+	l.ErrorFWithoutLn("%s", fmt.Sprintln(a...))
+}
+
+func (l *DedupLogger) DebugFWithoutLn(format string, a ...interface{}) {
+	l.write(LevelDebug, sprintf(format, a...), func(msg string) {
+		l.parent.DebugFWithoutLn("%s", msg)
+	})
+}
+
+// DebugLn
+// Deprecated:
+// Use DebugF(string) it add \n to end
+func (l *DedupLogger) DebugLn(a ...interface{}) {
+	l.DebugFWithoutLn("%s", fmt.Sprintln(a...))
+}
+
+func (l *DedupLogger) WarnFWithoutLn(format string, a ...interface{}) {
+	l.write(LevelWarn, sprintf(format, a...), func(msg string) {
+		l.parent.WarnFWithoutLn("%s", msg)
+	})
+}
+
+// WarnLn
+// Deprecated:
+// Use WarnF(string) it add \n to end
+func (l *DedupLogger) WarnLn(a ...interface{}) {
+	l.WarnFWithoutLn("%s", fmt.Sprintln(a...))
+}
+
+func (l *DedupLogger) Success(s string) {
+	l.write("success", s, func(msg string) {
+		l.parent.Success(trimLn(msg))
+	})
+}
+
+func (l *DedupLogger) Fail(s string) {
+	l.write("fail", s, func(msg string) {
+		l.parent.Fail(trimLn(msg))
+	})
+}
+
+func (l *DedupLogger) FailRetry(s string) {
+	l.write("fail-retry", s, func(msg string) {
+		l.parent.FailRetry(trimLn(msg))
+	})
+}
+
+func (l *DedupLogger) JSON(content []byte) {
+	l.state.flushAndWrite(func() {
+		l.parent.JSON(content)
+	})
+}
+
+func (l *DedupLogger) Write(content []byte) (int, error) {
+	var (
+		n   int
+		err error
+	)
+
+	l.state.flushAndWrite(func() {
+		n, err = l.parent.Write(content)
+	})
+
+	return n, err
+}
+
+func (l *DedupLogger) write(kind Level, msg string, write func(msg string)) {
+	key := fmt.Sprintf("%s/%s%s", kind, msg, fieldsToString(l.fields))
+
+	l.state.write(key, func() {
+		write(msg)
+	}, func(count int) {
+		write(fmt.Sprintf("…message repeated %d times: %s\n", count, trimLn(msg)))
+	})
+}
+
+// dedupProcessLogger
+// writes count of collapsed messages before process start and end
+type dedupProcessLogger struct {
+	parent ProcessLogger
+	state  *dedupState
+}
+
+func (l *dedupProcessLogger) ProcessStart(name string) {
+	l.state.flushAndWrite(func() {
+		l.parent.ProcessStart(name)
+	})
+}
+
+func (l *dedupProcessLogger) ProcessFail() {
+	l.state.flushAndWrite(l.parent.ProcessFail)
+}
+
+func (l *dedupProcessLogger) ProcessEnd() {
+	l.state.flushAndWrite(l.parent.ProcessEnd)
+}
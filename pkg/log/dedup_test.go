@@ -0,0 +1,202 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDedupLogger(t *testing.T) {
+	newLogger := func(window time.Duration) (*DedupLogger, *InMemoryLogger, *time.Time) {
+		parent := NewInMemoryLogger()
+		now := time.Now()
+
+		logger := WrapWithDedup(parent, window)
+		logger.state.now = func() time.Time {
+			return now
+		}
+
+		return logger, parent, &now
+	}
+
+	messages := func(logger *InMemoryLogger) []string {
+		res := make([]string, 0)
+		for _, entry := range logger.Entries() {
+			res = append(res, fmt.Sprintf("%s: %s", entry.Level, entry.String()))
+		}
+
+		return res
+	}
+
+	t.Run("dedup", func(t *testing.T) {
+		logger, parent, now := newLogger(time.Minute)
+
+		for i := 0; i < 5; i++ {
+			logger.ErrorF("connection refused")
+			*now = now.Add(time.Second)
+		}
+
+		logger.InfoF("connected")
+
+		require.Equal(t, []string{
+			"error: connection refused\n",
+			"error: …message repeated 4 times: connection refused\n",
+			"info: connected\n",
+		}, messages(parent))
+	})
+
+	t.Run("different levels and fields are not collapsed", func(t *testing.T) {
+		logger, parent, _ := newLogger(time.Minute)
+
+		logger.ErrorF("connection refused")
+		logger.WarnF("connection refused")
+		logger.WithField("host", "master-0").WarnF("connection refused")
+		logger.WithField("host", "master-0").WarnF("connection refused")
+		logger.WithField("host", "master-1").WarnF("connection refused")
+
+		require.Equal(t, []string{
+			"error: connection refused\n",
+			"warn: connection refused\n",
+			"warn: connection refused | fields: [host='master-0']\n",
+			"warn: …message repeated 1 times: connection refused | fields: [host='master-0']\n",
+			"warn: connection refused | fields: [host='master-1']\n",
+		}, messages(parent))
+	})
+
+	t.Run("window expired", func(t *testing.T) {
+		logger, parent, now := newLogger(10 * time.Second)
+
+		logger.ErrorF("connection refused")
+		*now = now.Add(5 * time.Second)
+		logger.ErrorF("connection refused")
+		*now = now.Add(5 * time.Second)
+		logger.ErrorF("connection refused")
+
+		require.Equal(t, []string{
+			"error: connection refused\n",
+			"error: …message repeated 1 times: connection refused\n",
+			"error: connection refused\n",
+		}, messages(parent))
+	})
+
+	t.Run("flush by timer", func(t *testing.T) {
+		parent := NewInMemoryLogger()
+		logger := WrapWithDedup(parent, 50*time.Millisecond)
+
+		logger.WarnF("connection refused")
+		logger.WarnF("connection refused")
+
+		require.Eventually(t, func() bool {
+			return len(parent.Entries()) == 2
+		}, time.Second, 10*time.Millisecond)
+
+		require.Equal(t, "…message repeated 1 times: connection refused\n", parent.Entries()[1].Message)
+	})
+
+	t.Run("flush before process and on close", func(t *testing.T) {
+		logger, parent, _ := newLogger(time.Minute)
+
+		logger.FailRetry("retry")
+		logger.FailRetry("retry")
+
+		err := logger.Process(ProcessDefault, "process", func() error {
+			logger.InfoF("in process")
+			logger.InfoF("in process")
+			return nil
+		})
+		require.NoError(t, err)
+
+		logger.Success("done")
+		logger.Success("done")
+		require.NoError(t, logger.FlushAndClose())
+
+		require.Equal(t, []string{
+			"warn: Fail retry: retry",
+			"warn: Fail retry: …message repeated 1 times: retry",
+			"info: Start process: default/process",
+			"info: in process\n",
+			"info: …message repeated 1 times: in process\n",
+			"info: End process: default/process",
+			"info: Success: done",
+			"info: Success: …message repeated 1 times: done",
+		}, messages(parent))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		logger, parent, _ := newLogger(0)
+
+		logger.InfoF("msg")
+		logger.InfoF("msg")
+
+		require.Equal(t, []string{
+			"info: msg\n",
+			"info: msg\n",
+		}, messages(parent))
+	})
+
+	t.Run("writes are serialized", func(t *testing.T) {
+		out := &serialCheckWriter{}
+		logger := WrapWithDedup(NewDummyLoggerWithOptions(LoggerOptions{OutStream: out}), time.Millisecond)
+
+		deadline := time.Now().Add(50 * time.Millisecond)
+		wg := sync.WaitGroup{}
+
+		wg.Go(func() {
+			for i := 0; time.Now().Before(deadline); i++ {
+				// repeated messages start timer
+				logger.InfoF("message %d", i/3)
+			}
+		})
+
+		wg.Go(func() {
+			for time.Now().Before(deadline) {
+				_, _ = logger.Write([]byte("raw\n"))
+				logger.JSON([]byte(`{"a":1}`))
+			}
+		})
+
+		wg.Wait()
+
+		require.False(t, out.overlapped.Load(), "writes to parent should not overlap")
+	})
+
+	t.Run("follow interfaces", func(t *testing.T) {
+		assertFollowAllInterfaces(t, WrapWithDedup(NewInMemoryLogger(), time.Minute))
+	})
+}
+
+// serialCheckWriter
+// detects concurrent writes
+type serialCheckWriter struct {
+	active     atomic.Int32
+	overlapped atomic.Bool
+}
+
+func (w *serialCheckWriter) Write(p []byte) (int, error) {
+	if w.active.Add(1) > 1 {
+		w.overlapped.Store(true)
+	}
+
+	time.Sleep(50 * time.Microsecond)
+	w.active.Add(-1)
+
+	return len(p), nil
+}
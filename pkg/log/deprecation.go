@@ -0,0 +1,131 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Deprecations
+// returns sink for reporting usage of deprecated features to logger
+func Deprecations(logger Logger) *DeprecationSink {
+	return &DeprecationSink{
+		logger: SafeProvideLogger(SimpleLoggerProvider(logger)),
+		used:   make(map[string]*deprecatedFeature),
+	}
+}
+
+type deprecatedFeature struct {
+	name        string
+	replacement string
+	count       int
+}
+
+// DeprecationSink
+// collects usage of deprecated features, every feature is reported once at first usage
+// and all used features are reported in summary block at FlushAndClose
+type DeprecationSink struct {
+	logger Logger
+
+	mu       sync.Mutex
+	used     map[string]*deprecatedFeature
+	features []*deprecatedFeature
+}
+
+// Warn
+// writes warning at first usage of feature, replacement can be empty
+func (s *DeprecationSink) Warn(feature, replacement string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if f, ok := s.used[feature]; ok {
+		f.count++
+		return
+	}
+
+	f := &deprecatedFeature{
+		name:        feature,
+		replacement: replacement,
+		count:       1,
+	}
+
+	s.used[feature] = f
+	s.features = append(s.features, f)
+
+	s.logger.WarnF("Deprecated: %s", f.String())
+}
+
+// Features
+// returns used deprecated features in order of first usage
+func (s *DeprecationSink) Features() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := make([]string, 0, len(s.features))
+	for _, f := range s.features {
+		res = append(res, f.name)
+	}
+
+	return res
+}
+
+// FlushAndClose
+// writes summary of used deprecated features and flushes and closes logger
+func (s *DeprecationSink) FlushAndClose() error {
+	s.mu.Lock()
+	summary := s.summary()
+	s.mu.Unlock()
+
+	if summary != "" {
+		s.logger.WarnF("%s", summary)
+	}
+
+	return s.logger.FlushAndClose()
+}
+
+// summary
+// returns block like:
+// Deprecated features were used:
+//   - feature (used 2 times). Use replacement instead
+func (s *DeprecationSink) summary() string {
+	if len(s.features) == 0 {
+		return ""
+	}
+
+	b := &strings.Builder{}
+	b.WriteString("Deprecated features were used:")
+
+	for _, f := range s.features {
+		b.WriteString("\n  - ")
+		b.WriteString(f.String())
+	}
+
+	return b.String()
+}
+
+func (f *deprecatedFeature) String() string {
+	res := f.name
+	if f.count > 1 {
+		res = fmt.Sprintf("%s (used %d times)", res, f.count)
+	}
+
+	if f.replacement != "" {
+		res = fmt.Sprintf("%s. Use %s instead", res, f.replacement)
+	}
+
+	return res
+}
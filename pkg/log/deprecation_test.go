@@ -0,0 +1,61 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeprecationSink(t *testing.T) {
+	logger := NewInMemoryLogger()
+	sink := Deprecations(logger)
+
+	sink.Warn("InfoLn", "InfoF")
+	sink.Warn("InfoLn", "InfoF")
+	sink.Warn("ProcessMirror", "")
+	sink.Warn("InfoLn", "InfoF")
+
+	require.Equal(t, []string{"InfoLn", "ProcessMirror"}, sink.Features())
+
+	matches, err := logger.AllMatches(&Match{Levels: []Level{LevelWarn}})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"Deprecated: InfoLn. Use InfoF instead\n",
+		"Deprecated: ProcessMirror\n",
+	}, matches)
+
+	require.NoError(t, sink.FlushAndClose())
+
+	matches, err = logger.AllMatches(&Match{Levels: []Level{LevelWarn}})
+	require.NoError(t, err)
+	require.Len(t, matches, 3)
+	require.Equal(t, "Deprecated features were used:\n"+
+		"  - InfoLn (used 3 times). Use InfoF instead\n"+
+		"  - ProcessMirror\n", matches[2])
+
+	t.Run("without usages", func(t *testing.T) {
+		logger := NewInMemoryLogger()
+		require.NoError(t, Deprecations(logger).FlushAndClose())
+		require.Empty(t, logger.Entries())
+	})
+
+	t.Run("nil logger", func(t *testing.T) {
+		require.NotPanics(t, func() {
+			Deprecations(nil).Warn("InfoLn", "InfoF")
+		})
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"os"
 )
 
 var (
@@ -31,11 +32,24 @@ type DummyLogger struct {
 	*formatWithNewLineLoggerWrapper
 
 	isDebug bool
+	out     io.Writer
 }
 
 func NewDummyLogger(isDebug bool) *DummyLogger {
+	return NewDummyLoggerWithOptions(LoggerOptions{IsDebug: isDebug})
+}
+
+// NewDummyLoggerWithOptions
+// uses IsDebug, OutStream, WithTimestamps and TimestampFormat options
+// if OutStream is not set, os.Stdout is used
+func NewDummyLoggerWithOptions(opts LoggerOptions) *DummyLogger {
 	l := &DummyLogger{
-		isDebug: isDebug,
+		isDebug: opts.IsDebug,
+		out:     opts.OutStream,
+	}
+
+	if opts.WithTimestamps {
+		l.out = newTimestampWriter(opts.OutStream, timestampFormat(opts))
 	}
 
 	l.formatWithNewLineLoggerWrapper = newFormatWithNewLineLoggerWrapper(l)
@@ -47,6 +61,10 @@ func (d *DummyLogger) ProcessLogger() ProcessLogger {
 	return newWrappedProcessLogger(d)
 }
 
+func (d *DummyLogger) ProgressLogger() ProgressLogger {
+	return newWrappedProgressLogger(d)
+}
+
 func (d *DummyLogger) SilentLogger() *SilentLogger {
 	return NewSilentLogger()
 }
@@ -55,42 +73,66 @@ func (d *DummyLogger) BufferLogger(buffer *bytes.Buffer) Logger {
 	return NewSimpleLogger(LoggerOptions{OutStream: buffer, IsDebug: d.isDebug})
 }
 
+func (d *DummyLogger) WithFields(fields map[string]any) Logger {
+	return newFieldsLogger(d, fields)
+}
+
+func (d *DummyLogger) WithField(key string, value any) Logger {
+	return d.WithFields(map[string]any{key: value})
+}
+
+func (d *DummyLogger) WithPrefix(prefix string) Logger {
+	return newPrefixLogger(d, prefix)
+}
+
 func (d *DummyLogger) FlushAndClose() error {
 	return nil
 }
 
-func (d *DummyLogger) Process(_ Process, t string, run func() error) error {
-	fmt.Println(t)
-	err := run()
-	fmt.Println(t)
+func (d *DummyLogger) Process(p Process, t string, run func() error) error {
+	t = maskSecrets(t)
+
+	fmt.Fprintln(d.writer(), t)
+	duration, err := runProcess(p, t, run)
+	fmt.Fprintln(d.writer(), t, formatProcessDuration(duration))
 	return err
 }
 
 func (d *DummyLogger) InfoFWithoutLn(format string, a ...interface{}) {
-	fmt.Printf(format, a...)
+	format, a = maskSecretsF(format, a)
+
+	fmt.Fprintf(d.writer(), format, a...)
 }
 
 // InfoLn
 // Deprecated:
 // Use InfoF(string) it add \n to end
 func (d *DummyLogger) InfoLn(a ...interface{}) {
-	fmt.Println(a...)
+	a = maskSecretsLn(a)
+
+	fmt.Fprintln(d.writer(), a...)
 }
 
 func (d *DummyLogger) ErrorFWithoutLn(format string, a ...interface{}) {
-	fmt.Printf(format, a...)
+	format, a = maskSecretsF(format, a)
+
+	fmt.Fprintf(d.writer(), format, a...)
 }
 
 // ErrorLn
 // Deprecated:
 // Use ErrorF(string) it add \n to end
 func (d *DummyLogger) ErrorLn(a ...interface{}) {
-	fmt.Println(a...)
+	a = maskSecretsLn(a)
+
+	fmt.Fprintln(d.writer(), a...)
 }
 
 func (d *DummyLogger) DebugFWithoutLn(format string, a ...interface{}) {
+	format, a = maskSecretsF(format, a)
+
 	if d.isDebug {
-		fmt.Printf(format, a...)
+		fmt.Fprintf(d.writer(), format, a...)
 	}
 }
 
@@ -98,20 +140,28 @@ func (d *DummyLogger) DebugFWithoutLn(format string, a ...interface{}) {
 // Deprecated:
 // Use DebugF(string) it add \n to end
 func (d *DummyLogger) DebugLn(a ...interface{}) {
+	a = maskSecretsLn(a)
+
 	if d.isDebug {
-		fmt.Println(a...)
+		fmt.Fprintln(d.writer(), a...)
 	}
 }
 
 func (d *DummyLogger) Success(l string) {
-	fmt.Println(l)
+	l = maskSecrets(l)
+
+	fmt.Fprintln(d.writer(), l)
 }
 
 func (d *DummyLogger) Fail(l string) {
-	fmt.Println(l)
+	l = maskSecrets(l)
+
+	fmt.Fprintln(d.writer(), l)
 }
 
 func (d *DummyLogger) FailRetry(l string) {
+	l = maskSecrets(l)
+
 	d.Fail(l)
 }
 
@@ -119,18 +169,32 @@ func (d *DummyLogger) FailRetry(l string) {
 // Deprecated:
 // Use WarnF(string) it add \n to end
 func (d *DummyLogger) WarnLn(a ...interface{}) {
-	fmt.Println(a...)
+	a = maskSecretsLn(a)
+
+	fmt.Fprintln(d.writer(), a...)
 }
 
 func (d *DummyLogger) WarnFWithoutLn(format string, a ...interface{}) {
-	fmt.Printf(format, a...)
+	format, a = maskSecretsF(format, a)
+
+	fmt.Fprintf(d.writer(), format, a...)
 }
 
 func (d *DummyLogger) JSON(content []byte) {
-	fmt.Println(string(content))
+	fmt.Fprintln(d.writer(), maskSecrets(string(content)))
 }
 
 func (d *DummyLogger) Write(content []byte) (int, error) {
-	fmt.Print(string(content))
+	fmt.Fprint(d.writer(), maskSecrets(string(content)))
 	return len(content), nil
 }
+
+// writer
+// os.Stdout is resolved on every write, so redirected stdout is used
+func (d *DummyLogger) writer() io.Writer {
+	if d.out == nil {
+		return os.Stdout
+	}
+
+	return d.out
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"k8s.io/klog/v2"
+)
+
+// envs read by NewFromEnv, prefix passed to NewFromEnv is added to every env
+const (
+	// LogTypeEnv
+	// logger type: pretty, simple, json or silent. pretty by default
+	LogTypeEnv = "LOG_TYPE"
+	// LogLevelEnv
+	// debug enables debug messages, warn and error write to output
+	// only processes, warnings, errors and fails (see WrapWithQuiet). info by default
+	LogLevelEnv = "LOG_LEVEL"
+	// LogFileEnv
+	// path to file for writing all messages additionally (see TeeLogger), file is appended
+	LogFileEnv = "LOG_FILE"
+	// LogColorEnv
+	// color mode: auto, always or never. auto by default, NO_COLOR env is respected in auto mode
+	LogColorEnv = "LOG_COLOR"
+)
+
+const envTeeBufferSize = 4096
+
+var colorModesMap = map[string]ColorMode{
+	string(ColorModeAuto):   ColorModeAuto,
+	string(ColorModeAlways): ColorModeAlways,
+	string(ColorModeNever):  ColorModeNever,
+}
+
+// NewFromEnv
+// builds logger from envs with prefix, for example with prefix DHCTL_ envs DHCTL_LOG_TYPE,
+// DHCTL_LOG_LEVEL, DHCTL_LOG_FILE and DHCTL_LOG_COLOR are read (see LogTypeEnv and others).
+// also initializes klog with returned logger and component levels from ComponentLevelsEnv.
+// returned cleanup func flushes klog and flushes and closes logger and log file,
+// call it before exit:
+//
+//	logger, cleanup, err := NewFromEnv("DHCTL_")
+//	if err != nil {
+//		return err
+//	}
+//	defer cleanup()
+func NewFromEnv(prefix string) (Logger, func() error, error) {
+	getEnv := func(name string) string {
+		return strings.TrimSpace(os.Getenv(prefix + name))
+	}
+
+	loggerType := Pretty
+	if t := getEnv(LogTypeEnv); t != "" {
+		var err error
+		loggerType, err = ConvertType(strings.ToLower(t))
+		if err != nil {
+			return nil, nil, fmt.Errorf("Cannot parse %s%s: %w", prefix, LogTypeEnv, err)
+		}
+	}
+
+	level := LevelInfo
+	if l := getEnv(LogLevelEnv); l != "" {
+		var ok bool
+		level, ok = levelsAliases[strings.ToLower(l)]
+		if !ok {
+			return nil, nil, fmt.Errorf("Unknown level '%s' in %s%s. Should be debug, info, warn or error", l, prefix, LogLevelEnv)
+		}
+	}
+
+	colorMode := ColorModeAuto
+	if c := getEnv(LogColorEnv); c != "" {
+		var ok bool
+		colorMode, ok = colorModesMap[strings.ToLower(c)]
+		if !ok {
+			return nil, nil, fmt.Errorf("Unknown color mode '%s' in %s%s. Should be auto, always or never", c, prefix, LogColorEnv)
+		}
+	}
+
+	if err := InitComponentLevelsFromEnv(); err != nil {
+		return nil, nil, err
+	}
+
+	logger, err := NewLoggerWithOptions(loggerType, LoggerOptions{
+		IsDebug:   level == LevelDebug,
+		ColorMode: colorMode,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if level == LevelWarn || level == LevelError {
+		logger = WrapWithQuiet(logger)
+	}
+
+	if path := getEnv(LogFileEnv); path != "" {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Cannot open log file %s: %w", path, err)
+		}
+
+		logger, err = WrapWithTeeLogger(logger, file, envTeeBufferSize)
+		if err != nil {
+			_ = file.Close()
+			return nil, nil, err
+		}
+	}
+
+	if err := InitKlog(logger); err != nil {
+		_ = logger.FlushAndClose()
+		return nil, nil, err
+	}
+
+	cleanup := func() error {
+		klog.Flush()
+		return logger.FlushAndClose()
+	}
+
+	return logger, cleanup, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFromEnv(t *testing.T) {
+	const prefix = "TEST_"
+
+	setEnvs := func(t *testing.T, envs map[string]string) {
+		for _, env := range []string{LogTypeEnv, LogLevelEnv, LogFileEnv, LogColorEnv} {
+			t.Setenv(prefix+env, envs[env])
+		}
+
+		t.Setenv(ComponentLevelsEnv, "")
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		setEnvs(t, nil)
+
+		logger, cleanup, err := NewFromEnv(prefix)
+		require.NoError(t, err)
+		require.IsType(t, &PrettyLogger{}, logger)
+		require.False(t, logger.(*PrettyLogger).isDebug)
+		require.NoError(t, cleanup())
+	})
+
+	t.Run("type and level", func(t *testing.T) {
+		setEnvs(t, map[string]string{
+			LogTypeEnv:  "JSON",
+			LogLevelEnv: "debug",
+		})
+
+		logger, cleanup, err := NewFromEnv(prefix)
+		require.NoError(t, err)
+		require.IsType(t, &SimpleLogger{}, logger)
+		require.True(t, logger.(*SimpleLogger).isDebug)
+		require.NoError(t, cleanup())
+	})
+
+	t.Run("quiet level", func(t *testing.T) {
+		setEnvs(t, map[string]string{
+			LogTypeEnv:  "simple",
+			LogLevelEnv: "warn",
+		})
+
+		logger, cleanup, err := NewFromEnv(prefix)
+		require.NoError(t, err)
+		require.IsType(t, &QuietLogger{}, logger)
+		require.NoError(t, cleanup())
+	})
+
+	t.Run("log file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "dhctl.log")
+		require.NoError(t, os.WriteFile(path, []byte("previous run\n"), 0o644))
+
+		setEnvs(t, map[string]string{
+			LogTypeEnv:  "silent",
+			LogLevelEnv: "error",
+			LogFileEnv:  path,
+		})
+
+		logger, cleanup, err := NewFromEnv(prefix)
+		require.NoError(t, err)
+		require.IsType(t, &TeeLogger{}, logger)
+
+		logger.InfoF("info message")
+		require.NoError(t, cleanup())
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Contains(t, string(content), "previous run\n")
+		require.Contains(t, string(content), "info message")
+	})
+
+	t.Run("invalid envs", func(t *testing.T) {
+		for env, value := range map[string]string{
+			LogTypeEnv:  "xml",
+			LogLevelEnv: "trace",
+			LogColorEnv: "sometimes",
+			LogFileEnv:  filepath.Join(t.TempDir(), "not-exists", "dhctl.log"),
+		} {
+			setEnvs(t, map[string]string{env: value})
+
+			_, _, err := NewFromEnv(prefix)
+			require.Error(t, err, env)
+		}
+	})
+}
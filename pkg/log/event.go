@@ -0,0 +1,444 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	_ baseLogger              = &EventLogger{}
+	_ formatWithNewLineLogger = &EventLogger{}
+	_ Logger                  = &EventLogger{}
+	_ io.Writer               = &EventLogger{}
+	_ ProcessLogger           = &eventProcessLogger{}
+)
+
+// EventFormatVersion
+// version of events format written in "v" field of every event
+// new optional fields and new event types can be added without changing version,
+// removing, renaming or changing type of existing fields requires new version
+const EventFormatVersion = "1"
+
+// eventSchema
+// JSON schema of event for current EventFormatVersion
+//
+//go:embed event_schema_v1.json
+var eventSchema []byte
+
+// EventSchema
+// returns JSON schema of event for EventFormatVersion
+func EventSchema() []byte {
+	return bytes.Clone(eventSchema)
+}
+
+type EventLevel string
+
+const (
+	EventLevelDebug EventLevel = "debug"
+	EventLevelInfo  EventLevel = "info"
+	EventLevelWarn  EventLevel = "warn"
+	EventLevelError EventLevel = "error"
+)
+
+type EventType string
+
+const (
+	EventTypeMessage      EventType = "message"
+	EventTypeSuccess      EventType = "success"
+	EventTypeFail         EventType = "fail"
+	EventTypeJSON         EventType = "json"
+	EventTypeProcessStart EventType = "process-start"
+	EventTypeProcessEnd   EventType = "process-end"
+	EventTypeProcessFail  EventType = "process-fail"
+)
+
+// Event
+// one line of EventLogger output
+type Event struct {
+	Version string     `json:"v"`
+	Time    time.Time  `json:"time"`
+	Level   EventLevel `json:"level"`
+	Type    EventType  `json:"type"`
+	Message string     `json:"message"`
+	// Process
+	// type of innermost running process, empty for events outside process
+	Process string `json:"process,omitempty"`
+	// ProcessTitle
+	// title of innermost running process
+	ProcessTitle string         `json:"process-title,omitempty"`
+	OperationID  string         `json:"operation-id,omitempty"`
+	Fields       map[string]any `json:"fields,omitempty"`
+	// DurationSeconds
+	// passed for process-end and process-fail events
+	DurationSeconds float64 `json:"duration-seconds,omitempty"`
+	// Error
+	// passed for process-fail events of Process
+	Error string `json:"error,omitempty"`
+}
+
+type EventOptions struct {
+	// OperationID
+	// passed in every event, used for correlating events of one operation
+	OperationID string
+	IsDebug     bool
+}
+
+// EventLogger
+// writes newline-delimited JSON events with stable fields (see Event and EventSchema)
+// for parsing by other programs, for example by commander
+// unlike JSON logger, format does not depend on deckhouse pkg/log and versioned with EventFormatVersion
+// write errors are returned from FlushAndClose, out is not closed
+type EventLogger struct {
+	*formatWithNewLineLoggerWrapper
+
+	writer      *eventWriter
+	operationID string
+	fields      map[string]any
+	isDebug     bool
+}
+
+func NewEventLogger(out io.Writer, opts EventOptions) *EventLogger {
+	return newEventLoggerWithWriter(&eventWriter{out: out, now: time.Now}, opts.OperationID, nil, opts.IsDebug)
+}
+
+func newEventLoggerWithWriter(writer *eventWriter, operationID string, fields map[string]any, isDebug bool) *EventLogger {
+	l := &EventLogger{
+		writer:      writer,
+		operationID: operationID,
+		fields:      fields,
+		isDebug:     isDebug,
+	}
+
+	l.formatWithNewLineLoggerWrapper = newFormatWithNewLineLoggerWrapper(l)
+
+	return l
+}
+
+func (d *EventLogger) ProcessLogger() ProcessLogger {
+	return &eventProcessLogger{logger: d}
+}
+
+func (d *EventLogger) ProgressLogger() ProgressLogger {
+	return newWrappedProgressLogger(d)
+}
+
+func (d *EventLogger) SilentLogger() *SilentLogger {
+	return NewSilentLogger()
+}
+
+// BufferLogger
+// writes events to buffer with same operation id and fields
+func (d *EventLogger) BufferLogger(buffer *bytes.Buffer) Logger {
+	return newEventLoggerWithWriter(&eventWriter{out: buffer, now: d.writer.now}, d.operationID, d.fields, d.isDebug)
+}
+
+// WithFields
+// fields are written in "fields" of every event,
+// operation id passed with WithOperationID is written in operation-id
+func (d *EventLogger) WithFields(fields map[string]any) Logger {
+	fields, operationID := extractOperationID(fields)
+	if operationID == "" {
+		operationID = d.operationID
+	}
+
+	return newEventLoggerWithWriter(d.writer, operationID, mergeFields(d.fields, fields), d.isDebug)
+}
+
+func (d *EventLogger) WithField(key string, value any) Logger {
+	return d.WithFields(map[string]any{key: value})
+}
+
+func (d *EventLogger) WithPrefix(prefix string) Logger {
+	return newPrefixLogger(d, prefix)
+}
+
+// FlushAndClose
+// returns write errors, events written after close are dropped
+func (d *EventLogger) FlushAndClose() error {
+	return d.writer.close()
+}
+
+func (d *EventLogger) Process(p Process, t string, run func() error) error {
+	t = maskSecrets(t)
+
+	d.writer.startProcess(string(p), t)
+	d.emit(Event{Level: EventLevelInfo, Type: EventTypeProcessStart, Message: t})
+
+	duration, err := runProcess(p, t, run)
+	if err != nil {
+		d.emit(Event{
+			Level:           EventLevelError,
+			Type:            EventTypeProcessFail,
+			Message:         t,
+			DurationSeconds: duration.Seconds(),
+			Error:           maskSecrets(err.Error()),
+		})
+		d.writer.endProcess()
+		return err
+	}
+
+	d.emit(Event{
+		Level:           EventLevelInfo,
+		Type:            EventTypeProcessEnd,
+		Message:         t,
+		DurationSeconds: duration.Seconds(),
+	})
+	d.writer.endProcess()
+
+	return nil
+}
+
+func (d *EventLogger) InfoFWithoutLn(format string, a ...interface{}) {
+	d.message(EventLevelInfo, sprintf(format, a...))
+}
+
+// InfoLn
+// Deprecated:
+// Use InfoF(string) it add \n to end
+func (d *EventLogger) InfoLn(a ...interface{}) {
+	d.message(EventLevelInfo, fmt.Sprintln(a...))
+}
+
+func (d *EventLogger) ErrorFWithoutLn(format string, a ...interface{}) {
+	d.message(EventLevelError, sprintf(format, a...))
+}
+
+// ErrorLn
+// Deprecated:
+// Use ErrorF(string) it add \n to end
+func (d *EventLogger) ErrorLn(a ...interface{}) {
+	d.message(EventLevelError, fmt.Sprintln(a...))
+}
+
+func (d *EventLogger) DebugFWithoutLn(format string, a ...interface{}) {
+	if d.isDebug {
+		d.message(EventLevelDebug, sprintf(format, a...))
+	}
+}
+
+// DebugLn
+// Deprecated:
+// Use DebugF(string) it add \n to end
+func (d *EventLogger) DebugLn(a ...interface{}) {
+	if d.isDebug {
+		d.message(EventLevelDebug, fmt.Sprintln(a...))
+	}
+}
+
+func (d *EventLogger) WarnFWithoutLn(format string, a ...interface{}) {
+	d.message(EventLevelWarn, sprintf(format, a...))
+}
+
+// WarnLn
+// Deprecated:
+// Use WarnF(string) it add \n to end
+func (d *EventLogger) WarnLn(a ...interface{}) {
+	d.message(EventLevelWarn, fmt.Sprintln(a...))
+}
+
+func (d *EventLogger) Success(l string) {
+	d.emit(Event{Level: EventLevelInfo, Type: EventTypeSuccess, Message: l})
+}
+
+func (d *EventLogger) Fail(l string) {
+	d.emit(Event{Level: EventLevelError, Type: EventTypeFail, Message: l})
+}
+
+func (d *EventLogger) FailRetry(l string) {
+	d.emit(Event{Level: EventLevelWarn, Type: EventTypeFail, Message: l})
+}
+
+// JSON
+// content is written as message string
+func (d *EventLogger) JSON(content []byte) {
+	d.emit(Event{Level: EventLevelInfo, Type: EventTypeJSON, Message: string(content)})
+}
+
+// Write
+// every not empty line is written as info message event
+func (d *EventLogger) Write(content []byte) (int, error) {
+	for _, line := range strings.Split(string(content), "\n") {
+		d.message(EventLevelInfo, line)
+	}
+
+	return len(content), nil
+}
+
+func (d *EventLogger) message(level EventLevel, msg string) {
+	d.emit(Event{Level: level, Type: EventTypeMessage, Message: msg})
+}
+
+func (d *EventLogger) emit(event Event) {
+	event.Message = strings.TrimRight(maskSecrets(event.Message), "\n")
+	if event.Message == "" && event.Type == EventTypeMessage {
+		return
+	}
+
+	event.OperationID = d.operationID
+
+	if len(d.fields) > 0 {
+		event.Fields = make(map[string]any, len(d.fields))
+		for k, v := range d.fields {
+			if str, ok := v.(string); ok {
+				v = maskSecrets(str)
+			}
+
+			event.Fields[k] = v
+		}
+	}
+
+	d.writer.write(event)
+}
+
+type eventProcess struct {
+	process string
+	title   string
+	start   time.Time
+}
+
+// eventWriter
+// shared between loggers created with WithFields
+// keeps stack of running processes for filling process of events
+type eventWriter struct {
+	mu sync.Mutex
+
+	out       io.Writer
+	now       func() time.Time
+	processes []eventProcess
+	closed    bool
+	err       error
+}
+
+func (w *eventWriter) startProcess(process, title string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.processes = append(w.processes, eventProcess{process: process, title: title, start: w.now()})
+}
+
+func (w *eventWriter) endProcess() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.processes) > 0 {
+		w.processes = w.processes[:len(w.processes)-1]
+	}
+}
+
+// currentProcess
+// returns innermost running process, false if no running processes
+func (w *eventWriter) currentProcess() (eventProcess, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.processes) == 0 {
+		return eventProcess{}, false
+	}
+
+	return w.processes[len(w.processes)-1], true
+}
+
+func (w *eventWriter) write(event Event) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return
+	}
+
+	event.Version = EventFormatVersion
+	event.Time = w.now().UTC()
+
+	if len(w.processes) > 0 {
+		p := w.processes[len(w.processes)-1]
+		event.Process = p.process
+		event.ProcessTitle = p.title
+	}
+
+	line, err := json.Marshal(event)
+	if err != nil {
+		// fields can contain not serializable values
+		event.Fields = map[string]any{"marshal-error": err.Error()}
+		line, err = json.Marshal(event)
+		if err != nil {
+			w.err = errors.Join(w.err, err)
+			return
+		}
+	}
+
+	line = append(line, '\n')
+
+	if _, err := w.out.Write(line); err != nil {
+		w.err = errors.Join(w.err, fmt.Errorf("Cannot write event: %w", err))
+	}
+}
+
+func (w *eventWriter) close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.closed = true
+
+	err := w.err
+	w.err = nil
+
+	return err
+}
+
+// eventProcessLogger
+// writes process-start, process-end and process-fail events
+// processes started with ProcessStart have empty process type
+type eventProcessLogger struct {
+	logger *EventLogger
+}
+
+func (l *eventProcessLogger) ProcessStart(name string) {
+	name = maskSecrets(name)
+
+	l.logger.writer.startProcess("", name)
+	l.logger.emit(Event{Level: EventLevelInfo, Type: EventTypeProcessStart, Message: name})
+}
+
+func (l *eventProcessLogger) ProcessEnd() {
+	l.end(EventLevelInfo, EventTypeProcessEnd)
+}
+
+func (l *eventProcessLogger) ProcessFail() {
+	l.end(EventLevelError, EventTypeProcessFail)
+}
+
+func (l *eventProcessLogger) end(level EventLevel, eventType EventType) {
+	p, ok := l.logger.writer.currentProcess()
+	if !ok {
+		return
+	}
+
+	l.logger.emit(Event{
+		Level:           level,
+		Type:            eventType,
+		Message:         p.title,
+		DurationSeconds: l.logger.writer.now().Sub(p.start).Seconds(),
+	})
+	l.logger.writer.endProcess()
+}
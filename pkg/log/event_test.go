@@ -0,0 +1,238 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventLoggerFollowInterfaces(t *testing.T) {
+	assertFollowAllInterfaces(t, NewEventLogger(&bytes.Buffer{}, EventOptions{IsDebug: true}))
+}
+
+func TestEventLogger(t *testing.T) {
+	t.Run("levels and types", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		logger := NewEventLogger(out, EventOptions{OperationID: "op-1", IsDebug: true})
+
+		logger.InfoF("info %s", "message")
+		logger.WarnF("warn message")
+		logger.ErrorF("error message")
+		logger.DebugF("debug message")
+		logger.Success("success\n")
+		logger.Fail("fail")
+		logger.FailRetry("fail retry")
+		logger.JSON([]byte(`{"a":"b"}`))
+		_, err := logger.Write([]byte("first\n\nsecond\n"))
+		require.NoError(t, err)
+
+		require.NoError(t, logger.FlushAndClose())
+
+		logger.InfoF("after close")
+
+		events := testReadEvents(t, out)
+
+		type short struct {
+			level   EventLevel
+			tp      EventType
+			message string
+		}
+
+		res := make([]short, 0, len(events))
+		for _, e := range events {
+			require.Equal(t, EventFormatVersion, e.Version)
+			require.Equal(t, "op-1", e.OperationID)
+			require.False(t, e.Time.IsZero())
+			res = append(res, short{level: e.Level, tp: e.Type, message: e.Message})
+		}
+
+		require.Equal(t, []short{
+			{EventLevelInfo, EventTypeMessage, "info message"},
+			{EventLevelWarn, EventTypeMessage, "warn message"},
+			{EventLevelError, EventTypeMessage, "error message"},
+			{EventLevelDebug, EventTypeMessage, "debug message"},
+			{EventLevelInfo, EventTypeSuccess, "success"},
+			{EventLevelError, EventTypeFail, "fail"},
+			{EventLevelWarn, EventTypeFail, "fail retry"},
+			{EventLevelInfo, EventTypeJSON, `{"a":"b"}`},
+			{EventLevelInfo, EventTypeMessage, "first"},
+			{EventLevelInfo, EventTypeMessage, "second"},
+		}, res)
+	})
+
+	t.Run("debug disabled", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		logger := NewEventLogger(out, EventOptions{})
+
+		logger.DebugF("debug message")
+		logger.DebugLn("debug message")
+		logger.InfoF("")
+
+		require.Empty(t, out.String())
+	})
+
+	t.Run("fields", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		logger := NewEventLogger(out, EventOptions{})
+
+		logger.WithField("node", "master-0").WithFields(map[string]any{"attempt": 2}).InfoF("with fields")
+		logger.InfoF("without fields")
+
+		events := testReadEvents(t, out)
+		require.Len(t, events, 2)
+		require.Equal(t, map[string]any{"node": "master-0", "attempt": float64(2)}, events[0].Fields)
+		require.Nil(t, events[1].Fields)
+	})
+
+	t.Run("process", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		logger := NewEventLogger(out, EventOptions{})
+
+		err := logger.Process(ProcessBootstrap, "bootstrap cluster", func() error {
+			logger.InfoF("in bootstrap")
+
+			return logger.Process(ProcessCommon, "install", func() error {
+				logger.WithField("node", "master-0").InfoF("in install")
+				return errors.New("install failed")
+			})
+		})
+		require.Error(t, err)
+
+		logger.InfoF("outside")
+
+		events := testReadEvents(t, out)
+		require.Len(t, events, 7)
+
+		require.Equal(t, EventTypeProcessStart, events[0].Type)
+		require.Equal(t, "bootstrap", events[0].Process)
+		require.Equal(t, "bootstrap cluster", events[0].ProcessTitle)
+
+		require.Equal(t, "in bootstrap", events[1].Message)
+		require.Equal(t, "bootstrap", events[1].Process)
+
+		require.Equal(t, EventTypeProcessStart, events[2].Type)
+		require.Equal(t, "common", events[2].Process)
+
+		require.Equal(t, "in install", events[3].Message)
+		require.Equal(t, "install", events[3].ProcessTitle)
+
+		require.Equal(t, EventTypeProcessFail, events[4].Type)
+		require.Equal(t, EventLevelError, events[4].Level)
+		require.Equal(t, "install", events[4].Message)
+		require.Equal(t, "install failed", events[4].Error)
+		require.Equal(t, "common", events[4].Process)
+
+		require.Equal(t, EventTypeProcessFail, events[5].Type)
+		require.Equal(t, "bootstrap", events[5].Process)
+
+		require.Equal(t, "outside", events[6].Message)
+		require.Empty(t, events[6].Process)
+	})
+
+	t.Run("process logger", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		logger := NewEventLogger(out, EventOptions{})
+
+		now := time.Now()
+		logger.writer.now = func() time.Time {
+			return now
+		}
+
+		processLogger := logger.ProcessLogger()
+		processLogger.ProcessStart("my process")
+		now = now.Add(2 * time.Second)
+		processLogger.ProcessEnd()
+		processLogger.ProcessEnd()
+
+		events := testReadEvents(t, out)
+		require.Len(t, events, 2)
+		require.Equal(t, EventTypeProcessStart, events[0].Type)
+		require.Equal(t, EventTypeProcessEnd, events[1].Type)
+		require.Equal(t, "my process", events[1].Message)
+		require.Equal(t, "my process", events[1].ProcessTitle)
+		require.Equal(t, float64(2), events[1].DurationSeconds)
+	})
+
+	t.Run("write error", func(t *testing.T) {
+		logger := NewEventLogger(&testFailWriter{}, EventOptions{})
+
+		logger.InfoF("message")
+
+		require.Error(t, logger.FlushAndClose())
+	})
+
+	t.Run("not serializable field", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		logger := NewEventLogger(out, EventOptions{})
+
+		logger.WithField("func", func() {}).InfoF("message")
+
+		events := testReadEvents(t, out)
+		require.Len(t, events, 1)
+		require.Contains(t, events[0].Fields, "marshal-error")
+	})
+}
+
+func TestEventSchema(t *testing.T) {
+	schema := map[string]any{}
+	require.NoError(t, json.Unmarshal(EventSchema(), &schema))
+
+	properties := schema["properties"].(map[string]any)
+	require.Equal(t, EventFormatVersion, properties["v"].(map[string]any)["const"])
+
+	out := &bytes.Buffer{}
+	logger := NewEventLogger(out, EventOptions{OperationID: "op"})
+	_ = logger.Process(ProcessCommon, "process", func() error {
+		logger.WithField("key", "value").InfoF("message")
+		return errors.New("error")
+	})
+
+	// all written keys are described in schema
+	scanner := bufio.NewScanner(out)
+	for scanner.Scan() {
+		event := map[string]any{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+
+		for key := range event {
+			require.Contains(t, properties, key)
+		}
+	}
+}
+
+type testFailWriter struct{}
+
+func (w *testFailWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write error")
+}
+
+func testReadEvents(t *testing.T, out *bytes.Buffer) []Event {
+	res := make([]Event, 0)
+
+	scanner := bufio.NewScanner(out)
+	for scanner.Scan() {
+		var event Event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event), scanner.Text())
+		res = append(res, event)
+	}
+
+	return res
+}
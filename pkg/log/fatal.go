@@ -0,0 +1,129 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// DefaultFatalExitCode
+// exit code used by FatalF if another code was not set with SetFatalExitCode
+const DefaultFatalExitCode = 1
+
+// FatalError
+// passed to panic by FatalF if panic mode is enabled (see SetFatalPanic)
+type FatalError struct {
+	Message  string
+	ExitCode int
+}
+
+func (e *FatalError) Error() string {
+	return fmt.Sprintf("Fatal error (exit code %d): %s", e.ExitCode, e.Message)
+}
+
+type fatalHandler struct {
+	mu       sync.RWMutex
+	lastID   int
+	hooks    map[int]func()
+	exitCode int
+	panic    bool
+	exit     func(code int)
+}
+
+var defaultFatalHandler = &fatalHandler{
+	hooks:    make(map[int]func()),
+	exitCode: DefaultFatalExitCode,
+	exit:     os.Exit,
+}
+
+// OnFatal
+// register cleanup hook called by FatalF before exit, for example for closing sinks.
+// hooks are called in reverse order of registration, panics in hooks are skipped.
+// returns function for unregister hook
+func OnFatal(hook func()) func() {
+	h := defaultFatalHandler
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.lastID++
+	id := h.lastID
+	h.hooks[id] = hook
+
+	return func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		delete(h.hooks, id)
+	}
+}
+
+// SetFatalExitCode
+// set exit code used by FatalF
+func SetFatalExitCode(code int) {
+	h := defaultFatalHandler
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.exitCode = code
+}
+
+// SetFatalPanic
+// if enabled FatalF panics with *FatalError instead of exit.
+// disabled by default, enable it in tests for recovering FatalError instead of exit of test binary
+func SetFatalPanic(enable bool) {
+	h := defaultFatalHandler
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.panic = enable
+}
+
+// fatal
+// calls cleanup hooks, flushes and closes logger and exits or panics
+func (h *fatalHandler) fatal(logger baseLogger, msg string) {
+	h.mu.RLock()
+	hooks := make([]func(), 0, len(h.hooks))
+	for id := h.lastID; id > 0; id-- {
+		if hook, ok := h.hooks[id]; ok {
+			hooks = append(hooks, hook)
+		}
+	}
+	exitCode, panicMode, exit := h.exitCode, h.panic, h.exit
+	h.mu.RUnlock()
+
+	// call without lock for allow register hooks from hook
+	for _, hook := range hooks {
+		_ = callIsolated(func() error {
+			hook()
+			return nil
+		})
+	}
+
+	// flush tee file and another buffered outputs
+	if err := callIsolated(logger.FlushAndClose); err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot flush and close logger: %v\n", err)
+	}
+
+	if panicMode {
+		panic(&FatalError{Message: msg, ExitCode: exitCode})
+	}
+
+	exit(exitCode)
+}
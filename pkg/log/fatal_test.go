@@ -0,0 +1,91 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFatalF(t *testing.T) {
+	t.Run("panic mode", func(t *testing.T) {
+		SetFatalPanic(true)
+		t.Cleanup(func() {
+			SetFatalPanic(false)
+		})
+
+		calls := make([]string, 0)
+
+		unregisterFirst := OnFatal(func() {
+			calls = append(calls, "first")
+		})
+		defer unregisterFirst()
+
+		unregisterSecond := OnFatal(func() {
+			panic("hook failed")
+		})
+		defer unregisterSecond()
+
+		unregisterThird := OnFatal(func() {
+			calls = append(calls, "third")
+		})
+		defer unregisterThird()
+
+		writer := newTestWriterCloser()
+		tee, err := NewTeeLogger(NewInMemoryLogger(), writer, 1024)
+		require.NoError(t, err)
+
+		logger := tee.WithPrefix("bootstrap")
+
+		require.PanicsWithError(t, "Fatal error (exit code 1): Cannot connect to host", func() {
+			logger.FatalF("Cannot connect to %s\n", "host")
+		})
+
+		require.Equal(t, []string{"third", "first"}, calls)
+		// tee buffer is flushed before exit
+		require.Contains(t, writer.writer.String(), "bootstrap: Cannot connect to host\n")
+		require.True(t, writer.closed)
+	})
+
+	t.Run("exit with configured code", func(t *testing.T) {
+		h := defaultFatalHandler
+		exitCode := -1
+
+		h.mu.Lock()
+		oldExit := h.exit
+		h.exit = func(code int) {
+			exitCode = code
+		}
+		h.mu.Unlock()
+
+		SetFatalExitCode(3)
+
+		t.Cleanup(func() {
+			h.mu.Lock()
+			h.exit = oldExit
+			h.mu.Unlock()
+
+			SetFatalExitCode(DefaultFatalExitCode)
+		})
+
+		buf := &bytes.Buffer{}
+		NewSimpleLogger(LoggerOptions{OutStream: buf}).FatalF("failed")
+
+		require.Equal(t, 3, exitCode)
+		require.Contains(t, buf.String(), "failed")
+	})
+}
@@ -0,0 +1,217 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+var (
+	_ baseLogger              = &fieldsLogger{}
+	_ formatWithNewLineLogger = &fieldsLogger{}
+	_ Logger                  = &fieldsLogger{}
+)
+
+func mergeFields(parent, fields map[string]any) map[string]any {
+	res := make(map[string]any, len(parent)+len(fields))
+	maps.Copy(res, parent)
+	maps.Copy(res, fields)
+	return res
+}
+
+func sortedFieldsKeys(fields map[string]any) []string {
+	return slices.Sorted(maps.Keys(fields))
+}
+
+// fieldsToString
+// returns fields in stable order like: " | fields: [key='value' key2='value2']"
+func fieldsToString(fields map[string]any) string {
+	if len(fields) == 0 {
+		return ""
+	}
+
+	pairs := make([]string, 0, len(fields))
+	for _, key := range sortedFieldsKeys(fields) {
+		pairs = append(pairs, fmt.Sprintf("%s='%v'", key, fields[key]))
+	}
+
+	return maskSecrets(fmt.Sprintf(" | fields: [%s]", strings.Join(pairs, " ")))
+}
+
+// addFieldsSuffix
+// add fields to end of message, but before last new line
+func addFieldsSuffix(msg string, fields map[string]any) string {
+	if len(fields) == 0 {
+		return msg
+	}
+
+	suffix := fieldsToString(fields)
+
+	if strings.HasSuffix(msg, "\n") {
+		return trimLn(msg) + suffix + "\n"
+	}
+
+	return msg + suffix
+}
+
+// fieldsLogger
+// adds fields as suffix to all messages and passes them to parent logger
+// used for loggers which do not support structured output
+type fieldsLogger struct {
+	*formatWithNewLineLoggerWrapper
+
+	parent Logger
+	fields map[string]any
+}
+
+// newFieldsLogger
+// operation id passed with WithOperationID is added as prefix like: operation: msg
+func newFieldsLogger(parent Logger, fields map[string]any) Logger {
+	fields, operationID := extractOperationID(fields)
+
+	l := &fieldsLogger{
+		parent: parent,
+		fields: fields,
+	}
+
+	l.formatWithNewLineLoggerWrapper = newFormatWithNewLineLoggerWrapper(l)
+
+	return newPrefixLogger(l, operationID)
+}
+
+func (l *fieldsLogger) WithFields(fields map[string]any) Logger {
+	return newFieldsLogger(l.parent, mergeFields(l.fields, fields))
+}
+
+func (l *fieldsLogger) WithField(key string, value any) Logger {
+	return l.WithFields(map[string]any{key: value})
+}
+
+func (l *fieldsLogger) WithPrefix(prefix string) Logger {
+	return newPrefixLogger(l, prefix)
+}
+
+func (l *fieldsLogger) ProcessLogger() ProcessLogger {
+	return l.parent.ProcessLogger()
+}
+
+// ProgressLogger
+// progress is reported with fields suffix
+func (l *fieldsLogger) ProgressLogger() ProgressLogger {
+	return &filteredProgressLogger{
+		parent: l.parent.ProgressLogger(),
+		filter: func(msg string) string {
+			return addFieldsSuffix(msg, l.fields)
+		},
+	}
+}
+
+func (l *fieldsLogger) SilentLogger() *SilentLogger {
+	return l.parent.SilentLogger()
+}
+
+func (l *fieldsLogger) BufferLogger(buffer *bytes.Buffer) Logger {
+	return l.parent.BufferLogger(buffer).WithFields(l.fields)
+}
+
+func (l *fieldsLogger) FlushAndClose() error {
+	return l.parent.FlushAndClose()
+}
+
+func (l *fieldsLogger) Process(p Process, t string, run func() error) error {
+	return l.parent.Process(p, t, run)
+}
+
+func (l *fieldsLogger) Spin(title string, fn func() error) error {
+	return l.parent.Spin(title, fn)
+}
+
+func (l *fieldsLogger) InfoFWithoutLn(format string, a ...interface{}) {
+	l.parent.InfoFWithoutLn("%s", l.format(format, a...))
+}
+
+// InfoLn
+// Deprecated:
+// Use InfoF(string) it add \n to end
+func (l *fieldsLogger) InfoLn(a ...interface{}) {
+	l.parent.InfoLn(l.formatLn(a...))
+}
+
+func (l *fieldsLogger) ErrorFWithoutLn(format string, a ...interface{}) {
+	l.parent.ErrorFWithoutLn("%s", l.format(format, a...))
+}
+
+// ErrorLn
+// Deprecated:
+// Use ErrorF(string) it add \n to end
+func (l *fieldsLogger) ErrorLn(a ...interface{}) {
+	l.parent.ErrorLn(l.formatLn(a...))
+}
+
+func (l *fieldsLogger) DebugFWithoutLn(format string, a ...interface{}) {
+	l.parent.DebugFWithoutLn("%s", l.format(format, a...))
+}
+
+// DebugLn
+// Deprecated:
+// Use DebugF(string) it add \n to end
+func (l *fieldsLogger) DebugLn(a ...interface{}) {
+	l.parent.DebugLn(l.formatLn(a...))
+}
+
+func (l *fieldsLogger) WarnFWithoutLn(format string, a ...interface{}) {
+	l.parent.WarnFWithoutLn("%s", l.format(format, a...))
+}
+
+// WarnLn
+// Deprecated:
+// Use WarnF(string) it add \n to end
+func (l *fieldsLogger) WarnLn(a ...interface{}) {
+	l.parent.WarnLn(l.formatLn(a...))
+}
+
+func (l *fieldsLogger) Success(s string) {
+	l.parent.Success(addFieldsSuffix(s, l.fields))
+}
+
+func (l *fieldsLogger) Fail(s string) {
+	l.parent.Fail(addFieldsSuffix(s, l.fields))
+}
+
+func (l *fieldsLogger) FailRetry(s string) {
+	l.parent.FailRetry(addFieldsSuffix(s, l.fields))
+}
+
+// JSON
+// content is passed as is for keeping it valid json
+func (l *fieldsLogger) JSON(content []byte) {
+	l.parent.JSON(content)
+}
+
+func (l *fieldsLogger) Write(content []byte) (int, error) {
+	return l.parent.Write(content)
+}
+
+func (l *fieldsLogger) format(format string, a ...any) string {
+	return addFieldsSuffix(sprintf(format, a...), l.fields)
+}
+
+func (l *fieldsLogger) formatLn(a ...any) string {
+	return addFieldsSuffix(trimLn(fmt.Sprintln(a...)), l.fields)
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddFieldsSuffix(t *testing.T) {
+	fields := map[string]any{"node": "master-0", "attempt": 2}
+
+	require.Equal(t, "msg | fields: [attempt='2' node='master-0']", addFieldsSuffix("msg", fields))
+	require.Equal(t, "msg | fields: [attempt='2' node='master-0']\n", addFieldsSuffix("msg\n", fields))
+	require.Equal(t, "msg\n", addFieldsSuffix("msg\n", nil))
+}
+
+func TestWithFields(t *testing.T) {
+	t.Run("simple logger emits structured fields", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := NewSimpleLogger(LoggerOptions{OutStream: buf}).
+			WithFields(map[string]any{"node": "master-0"}).
+			WithField("attempt", 2)
+
+		logger.InfoF("connected")
+
+		record := make(map[string]any)
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+		require.Equal(t, "connected\n", record["msg"])
+		require.Equal(t, "master-0", record["node"])
+		require.Equal(t, float64(2), record["attempt"])
+	})
+
+	t.Run("pretty logger adds suffix", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := NewPrettyLogger(LoggerOptions{OutStream: buf}).WithField("node", "master-0")
+
+		logger.InfoF("connected")
+		logger.WarnF("retry")
+
+		assertInBuffer(t, buf, "connected | fields: [node='master-0']\n", true)
+		assertInBuffer(t, buf, "retry | fields: [node='master-0']", true)
+	})
+
+	t.Run("in memory logger stores entries in root", func(t *testing.T) {
+		parent := NewInMemoryLoggerWithParent(NewSimpleLogger(LoggerOptions{OutStream: &bytes.Buffer{}}))
+		child := parent.WithFields(map[string]any{"node": "master-0"})
+		grandChild := child.WithField("attempt", 1)
+
+		parent.InfoF("parent")
+		child.InfoF("child")
+		grandChild.ErrorF("grand child")
+
+		matches, err := parent.AllMatches(&Match{Suffix: []string{"\n"}})
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"parent\n",
+			"child | fields: [node='master-0']\n",
+			"grand child | fields: [attempt='1' node='master-0']\n",
+		}, matches)
+
+		childMatches, err := child.(*InMemoryLogger).AllMatches(&Match{Prefix: []string{"parent"}})
+		require.NoError(t, err)
+		require.Len(t, childMatches, 1)
+	})
+
+	t.Run("tee logger writes fields to file", func(t *testing.T) {
+		writer := newTestWriterCloser()
+		parent := NewInMemoryLogger()
+
+		tee, err := NewTeeLogger(parent, writer, 1024)
+		require.NoError(t, err)
+
+		child := tee.WithField("node", "master-0")
+		child.InfoF("connected")
+		tee.InfoF("without fields")
+
+		require.NoError(t, child.FlushAndClose())
+		require.True(t, writer.closed)
+
+		assertInBuffer(t, writer.writer, "connected | fields: [node='master-0']\n", true)
+		assertInBuffer(t, writer.writer, "without fields\n", true)
+
+		match, err := parent.FirstMatch(&Match{Prefix: []string{"connected"}})
+		require.NoError(t, err)
+		require.Equal(t, "connected | fields: [node='master-0']\n", match)
+	})
+
+	t.Run("silent logger without tee", func(t *testing.T) {
+		logger := NewSilentLogger()
+		require.Same(t, logger, logger.WithField("node", "master-0"))
+	})
+
+	t.Run("slog record attributes", func(t *testing.T) {
+		logger, target := testCreateSLogLogger("", false)
+
+		logger.With("node", "master-0").Info("connected", "attempt", 3)
+
+		match, err := target.FirstMatch(&Match{Prefix: []string{"connected"}})
+		require.NoError(t, err)
+		require.Equal(t, "connected | fields: [attempt='3' node='master-0']\n", match)
+	})
+
+	for _, logger := range []Logger{
+		NewPrettyLogger(LoggerOptions{OutStream: &bytes.Buffer{}, IsDebug: true}),
+		NewSimpleLogger(LoggerOptions{OutStream: &bytes.Buffer{}, IsDebug: true}),
+		NewDummyLogger(true),
+		NewInMemoryLogger(),
+	} {
+		t.Run(fmt.Sprintf("%T follow interfaces", logger), func(t *testing.T) {
+			assertFollowAllInterfaces(t, logger.WithField("node", "master-0"))
+		})
+	}
+}
@@ -0,0 +1,355 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+)
+
+var (
+	_ baseLogger              = &FlushOnErrorLogger{}
+	_ formatWithNewLineLogger = &FlushOnErrorLogger{}
+	_ Logger                  = &FlushOnErrorLogger{}
+)
+
+// DefaultFlushOnErrorMaxRecords
+// count of buffered records used if maxRecords <= 0 passed to WrapWithFlushOnError
+const DefaultFlushOnErrorMaxRecords = 1000
+
+type flushOnErrorBuffer struct {
+	mu sync.Mutex
+
+	max     int
+	records []func()
+	dropped int
+	// scopes
+	// indexes of first records of active processes
+	scopes []int
+}
+
+func newFlushOnErrorBuffer(maxRecords int) *flushOnErrorBuffer {
+	if maxRecords <= 0 {
+		maxRecords = DefaultFlushOnErrorMaxRecords
+	}
+
+	return &flushOnErrorBuffer{
+		max: maxRecords,
+	}
+}
+
+// add
+// oldest record is dropped if buffer is full
+func (b *flushOnErrorBuffer) add(record func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.records) >= b.max {
+		b.records = b.records[1:]
+		b.dropped++
+
+		for i := range b.scopes {
+			b.scopes[i] = max(b.scopes[i]-1, 0)
+		}
+	}
+
+	b.records = append(b.records, record)
+}
+
+// flush
+// writes all buffered records with write function of parent
+func (b *flushOnErrorBuffer) flush(parent Logger) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.dropped > 0 {
+		parent.InfoF("... %d records dropped", b.dropped)
+	}
+
+	for _, record := range b.records {
+		record()
+	}
+
+	b.reset()
+}
+
+func (b *flushOnErrorBuffer) discard() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.reset()
+}
+
+func (b *flushOnErrorBuffer) reset() {
+	b.records = nil
+	b.dropped = 0
+
+	for i := range b.scopes {
+		b.scopes[i] = 0
+	}
+}
+
+func (b *flushOnErrorBuffer) startScope() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.scopes = append(b.scopes, len(b.records))
+}
+
+// endScope
+// discards records buffered in successful process
+func (b *flushOnErrorBuffer) endScope() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.scopes) == 0 {
+		return
+	}
+
+	start := b.scopes[len(b.scopes)-1]
+	b.scopes = b.scopes[:len(b.scopes)-1]
+
+	if start < len(b.records) {
+		b.records = b.records[:start]
+	}
+}
+
+func (b *flushOnErrorBuffer) popScope() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.scopes) > 0 {
+		b.scopes = b.scopes[:len(b.scopes)-1]
+	}
+}
+
+// WrapWithFlushOnError
+// returns logger which keeps debug and info records in memory and writes them to logger
+// only when error or fail is written or process is failed.
+// debug records are written with info level for showing them without debug mode.
+// records buffered in successful process are discarded, FlushAndClose discards all buffered records.
+// not more than maxRecords are kept, oldest records are dropped (DefaultFlushOnErrorMaxRecords if maxRecords <= 0).
+// warnings, processes and progress are written without buffering.
+// loggers returned from WithFields, WithPrefix and BufferLogger share buffer with returned logger
+func WrapWithFlushOnError(logger Logger, maxRecords int) *FlushOnErrorLogger {
+	return newFlushOnErrorLogger(logger, newFlushOnErrorBuffer(maxRecords))
+}
+
+type FlushOnErrorLogger struct {
+	*formatWithNewLineLoggerWrapper
+
+	parent Logger
+	buffer *flushOnErrorBuffer
+}
+
+func newFlushOnErrorLogger(parent Logger, buffer *flushOnErrorBuffer) *FlushOnErrorLogger {
+	l := &FlushOnErrorLogger{
+		parent: parent,
+		buffer: buffer,
+	}
+
+	l.formatWithNewLineLoggerWrapper = newFormatWithNewLineLoggerWrapper(l)
+
+	return l
+}
+
+// Flush
+// writes all buffered records to logger
+func (l *FlushOnErrorLogger) Flush() {
+	l.buffer.flush(l.parent)
+}
+
+// Discard
+// drops all buffered records
+func (l *FlushOnErrorLogger) Discard() {
+	l.buffer.discard()
+}
+
+func (l *FlushOnErrorLogger) WithFields(fields map[string]any) Logger {
+	return newFlushOnErrorLogger(l.parent.WithFields(fields), l.buffer)
+}
+
+func (l *FlushOnErrorLogger) WithField(key string, value any) Logger {
+	return l.WithFields(map[string]any{key: value})
+}
+
+func (l *FlushOnErrorLogger) WithPrefix(prefix string) Logger {
+	return newPrefixLogger(l, prefix)
+}
+
+func (l *FlushOnErrorLogger) ProcessLogger() ProcessLogger {
+	return &flushOnErrorProcessLogger{
+		parent: l.parent.ProcessLogger(),
+		logger: l,
+	}
+}
+
+func (l *FlushOnErrorLogger) ProgressLogger() ProgressLogger {
+	return l.parent.ProgressLogger()
+}
+
+func (l *FlushOnErrorLogger) SilentLogger() *SilentLogger {
+	return l.parent.SilentLogger()
+}
+
+func (l *FlushOnErrorLogger) BufferLogger(buffer *bytes.Buffer) Logger {
+	return newFlushOnErrorLogger(l.parent.BufferLogger(buffer), l.buffer)
+}
+
+// FlushAndClose
+// buffered records are discarded, because errors were not written
+func (l *FlushOnErrorLogger) FlushAndClose() error {
+	l.buffer.discard()
+	return l.parent.FlushAndClose()
+}
+
+func (l *FlushOnErrorLogger) Process(p Process, t string, run func() error) error {
+	return l.parent.Process(p, t, func() error {
+		l.buffer.startScope()
+
+		err := run()
+		if err != nil {
+			l.buffer.popScope()
+			l.Flush()
+			return err
+		}
+
+		l.buffer.endScope()
+
+		return nil
+	})
+}
+
+func (l *FlushOnErrorLogger) InfoFWithoutLn(format string, a ...interface{}) {
+	msg := sprintf(format, a...)
+
+	l.buffer.add(func() {
+		l.parent.InfoFWithoutLn("%s", msg)
+	})
+}
+
+// InfoLn
+// Deprecated:
+// Use InfoF(string) it add \n to end
+func (l *FlushOnErrorLogger) InfoLn(a ...interface{}) {
+	msg := trimLn(fmt.Sprintln(a...))
+
+	l.buffer.add(func() {
+		l.parent.InfoLn(msg)
+	})
+}
+
+func (l *FlushOnErrorLogger) ErrorFWithoutLn(format string, a ...interface{}) {
+	l.Flush()
+	l.parent.ErrorFWithoutLn(format, a...)
+}
+
+// ErrorLn
+// Deprecated:
+// Use ErrorF(string) it add \n to end
+func (l *FlushOnErrorLogger) ErrorLn(a ...interface{}) {
+	l.Flush()
+	l.parent.ErrorLn(a...)
+}
+
+func (l *FlushOnErrorLogger) DebugFWithoutLn(format string, a ...interface{}) {
+	msg := sprintf(format, a...)
+
+	l.buffer.add(func() {
+		l.parent.InfoFWithoutLn("%s", msg)
+	})
+}
+
+// DebugLn
+// Deprecated:
+// Use DebugF(string) it add \n to end
+func (l *FlushOnErrorLogger) DebugLn(a ...interface{}) {
+	msg := trimLn(fmt.Sprintln(a...))
+
+	l.buffer.add(func() {
+		l.parent.InfoLn(msg)
+	})
+}
+
+func (l *FlushOnErrorLogger) WarnFWithoutLn(format string, a ...interface{}) {
+	l.parent.WarnFWithoutLn(format, a...)
+}
+
+// WarnLn
+// Deprecated:
+// Use WarnF(string) it add \n to end
+func (l *FlushOnErrorLogger) WarnLn(a ...interface{}) {
+	l.parent.WarnLn(a...)
+}
+
+func (l *FlushOnErrorLogger) Success(s string) {
+	l.buffer.add(func() {
+		l.parent.Success(s)
+	})
+}
+
+func (l *FlushOnErrorLogger) Fail(s string) {
+	l.Flush()
+	l.parent.Fail(s)
+}
+
+func (l *FlushOnErrorLogger) FailRetry(s string) {
+	l.parent.FailRetry(s)
+}
+
+func (l *FlushOnErrorLogger) JSON(content []byte) {
+	content = bytes.Clone(content)
+
+	l.buffer.add(func() {
+		l.parent.JSON(content)
+	})
+}
+
+func (l *FlushOnErrorLogger) Write(content []byte) (int, error) {
+	content = bytes.Clone(content)
+
+	l.buffer.add(func() {
+		_, err := l.parent.Write(content)
+		if err != nil {
+			l.parent.WarnF("Cannot write buffered content: %v", err)
+		}
+	})
+
+	return len(content), nil
+}
+
+// flushOnErrorProcessLogger
+// discards records of successful processes and writes records of failed processes
+type flushOnErrorProcessLogger struct {
+	parent ProcessLogger
+	logger *FlushOnErrorLogger
+}
+
+func (l *flushOnErrorProcessLogger) ProcessStart(name string) {
+	l.parent.ProcessStart(name)
+	l.logger.buffer.startScope()
+}
+
+func (l *flushOnErrorProcessLogger) ProcessFail() {
+	l.logger.buffer.popScope()
+	l.logger.Flush()
+	l.parent.ProcessFail()
+}
+
+func (l *flushOnErrorProcessLogger) ProcessEnd() {
+	l.logger.buffer.endScope()
+	l.parent.ProcessEnd()
+}
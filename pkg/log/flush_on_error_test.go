@@ -0,0 +1,132 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlushOnErrorLogger(t *testing.T) {
+	messages := func(logger *InMemoryLogger) []string {
+		res := make([]string, 0)
+		for _, entry := range logger.Entries() {
+			res = append(res, fmt.Sprintf("%s: %s", entry.Level, entry.String()))
+		}
+
+		return res
+	}
+
+	t.Run("flush on error", func(t *testing.T) {
+		parent := NewInMemoryLogger()
+		logger := WrapWithFlushOnError(parent, 0)
+
+		logger.DebugF("connecting")
+		logger.WithField("node", "master-0").InfoF("connected")
+		logger.WarnF("slow connection")
+
+		require.Equal(t, []string{
+			"warn: slow connection\n",
+		}, messages(parent))
+
+		logger.ErrorF("failed")
+
+		require.Equal(t, []string{
+			"warn: slow connection\n",
+			"info: connecting\n",
+			"info: connected | fields: [node='master-0']\n",
+			"error: failed\n",
+		}, messages(parent))
+	})
+
+	t.Run("discard on success", func(t *testing.T) {
+		parent := NewInMemoryLogger()
+		logger := WrapWithFlushOnError(parent, 0)
+
+		logger.InfoF("before process")
+
+		err := logger.Process(ProcessDefault, "process", func() error {
+			logger.InfoF("in successful process")
+			return nil
+		})
+		require.NoError(t, err)
+
+		err = logger.Process(ProcessDefault, "failed", func() error {
+			logger.DebugF("in failed process")
+			return errors.New("error")
+		})
+		require.Error(t, err)
+
+		logger.InfoF("after process")
+		require.NoError(t, logger.FlushAndClose())
+		logger.Fail("fail")
+
+		require.Equal(t, []string{
+			"info: Start process: default/process",
+			"info: End process: default/process",
+			"info: Start process: default/failed",
+			"info: before process\n",
+			"info: in failed process\n",
+			"info: End process: default/failed",
+			"error: Fail: fail",
+		}, messages(parent))
+	})
+
+	t.Run("process logger", func(t *testing.T) {
+		parent := NewInMemoryLogger()
+		logger := WrapWithFlushOnError(parent, 0)
+		processLogger := logger.ProcessLogger()
+
+		processLogger.ProcessStart("success")
+		logger.InfoF("in successful process")
+		processLogger.ProcessEnd()
+
+		processLogger.ProcessStart("fail")
+		logger.InfoF("in failed process")
+		processLogger.ProcessFail()
+
+		require.Equal(t, []string{
+			"info: Start process: success",
+			"info: End process",
+			"info: Start process: fail",
+			"info: in failed process\n",
+			"error: Fail process",
+		}, messages(parent))
+	})
+
+	t.Run("size cap", func(t *testing.T) {
+		parent := NewInMemoryLogger()
+		logger := WrapWithFlushOnError(parent, 2)
+
+		for i := 0; i < 5; i++ {
+			logger.InfoF("message %d", i)
+		}
+
+		logger.Flush()
+
+		require.Equal(t, []string{
+			"info: ... 3 records dropped\n",
+			"info: message 3\n",
+			"info: message 4\n",
+		}, messages(parent))
+	})
+
+	t.Run("follow interfaces", func(t *testing.T) {
+		assertFollowAllInterfaces(t, WrapWithFlushOnError(NewInMemoryLogger(), 0))
+	})
+}
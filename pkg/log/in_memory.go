@@ -18,9 +18,12 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"maps"
 	"regexp"
+	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/name212/govalue"
 )
@@ -32,12 +35,55 @@ var (
 	_ io.Writer               = &InMemoryLogger{}
 )
 
+type Level string
+
+const (
+	LevelDebug Level = "debug"
+	LevelInfo  Level = "info"
+	LevelWarn  Level = "warn"
+	LevelError Level = "error"
+)
+
+// Entry
+// record stored by InMemoryLogger
+// Process is name of process in which record was written, empty outside processes
+// Message is formatted message without error or debug prefix and fields
+// OperationID is id passed with WithOperationID, empty if it was not passed
+type Entry struct {
+	Time        time.Time
+	Level       Level
+	Process     string
+	Message     string
+	Fields      map[string]any
+	OperationID string
+
+	prefix string
+}
+
+// String
+// returns entry as it was stored by InMemoryLogger before structured entries:
+// message with error or debug prefix and fields suffix
+func (e Entry) String() string {
+	return addFieldsSuffix(e.messageWithPrefix(), e.Fields)
+}
+
+func (e Entry) messageWithPrefix() string {
+	if e.prefix == "" {
+		return e.Message
+	}
+
+	return fmt.Sprintf("%s: %s", e.prefix, e.Message)
+}
+
 // Match
 // if Regex passed Prefix and Suffix will be ignored
+// if Levels passed, only entries with passed levels are matched,
+// Levels can be used without another conditions for matching all entries with levels
 type Match struct {
 	Prefix []string
 	Suffix []string
 	Regex  []*regexp.Regexp
+	Levels []Level
 }
 
 func (m *Match) IsValid() error {
@@ -45,23 +91,63 @@ func (m *Match) IsValid() error {
 		return fmt.Errorf("Match is nil")
 	}
 
-	if len(m.Regex) > 0 {
+	if len(m.Regex) > 0 || len(m.Levels) > 0 {
 		return nil
 	}
 
 	if len(m.Prefix) == 0 && len(m.Suffix) == 0 {
-		return fmt.Errorf("Invalid Match: must pass Regex or Prefix or/and Suffix")
+		return fmt.Errorf("Invalid Match: must pass Regex or Prefix or/and Suffix or Levels")
 	}
 
 	return nil
 }
 
+// Matches
+// returns true if entry is matched with m, m should be valid (see IsValid)
+func (m *Match) Matches(entry Entry) bool {
+	if len(m.Levels) > 0 && !slices.Contains(m.Levels, entry.Level) {
+		return false
+	}
+
+	entity := entry.String()
+
+	if len(m.Regex) > 0 {
+		for _, regex := range m.Regex {
+			if regex.MatchString(entity) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if len(m.Prefix) == 0 && len(m.Suffix) == 0 {
+		// only levels passed
+		return true
+	}
+
+	for _, prefix := range m.Prefix {
+		if strings.HasPrefix(entity, prefix) {
+			return true
+		}
+	}
+
+	for _, suffix := range m.Suffix {
+		if strings.HasSuffix(entity, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
 type InMemoryLogger struct {
 	*formatWithNewLineLoggerWrapper
 
-	m       sync.RWMutex
-	entries []string
-	buffer  *bytes.Buffer
+	m         sync.RWMutex
+	entries   []Entry
+	processes []string
+	buffer    *bytes.Buffer
 
 	parent Logger
 
@@ -69,6 +155,14 @@ type InMemoryLogger struct {
 	debugPrefix string
 
 	notDebug bool
+
+	writeOptions WriteOptions
+
+	// root
+	// logger created with WithFields stores entries in root logger
+	root        *InMemoryLogger
+	fields      map[string]any
+	operationID string
 }
 
 func NewInMemoryLogger() *InMemoryLogger {
@@ -77,7 +171,7 @@ func NewInMemoryLogger() *InMemoryLogger {
 
 func NewInMemoryLoggerWithParent(parent Logger) *InMemoryLogger {
 	l := &InMemoryLogger{
-		entries: make([]string, 0),
+		entries: make([]Entry, 0),
 	}
 
 	l.formatWithNewLineLoggerWrapper = newFormatWithNewLineLoggerWrapper(l)
@@ -108,11 +202,60 @@ func (l *InMemoryLogger) WithDebugPrefix(prefix string) *InMemoryLogger {
 	return l
 }
 
+// WithWriteOptions
+// configures level and splitting of content passed to Write before recording entries,
+// content is passed to parent as is
+func (l *InMemoryLogger) WithWriteOptions(opts WriteOptions) *InMemoryLogger {
+	l.writeOptions = opts
+	return l
+}
+
 func (l *InMemoryLogger) WithBuffer(buffer *bytes.Buffer) *InMemoryLogger {
-	l.m.Lock()
-	defer l.m.Unlock()
+	s := l.storage()
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.buffer = buffer
+	return l
+}
+
+// WithFields
+// returns logger which stores entries with fields in this logger
+// and passes fields to parent logger.
+// operation id passed with WithOperationID is stored in Entry.OperationID
+func (l *InMemoryLogger) WithFields(fields map[string]any) Logger {
+	res := NewInMemoryLoggerWithParent(l.parent.WithFields(fields))
+
+	fields, operationID := extractOperationID(fields)
+	if operationID == "" {
+		operationID = l.operationID
+	}
+
+	res.errorPrefix = l.errorPrefix
+	res.debugPrefix = l.debugPrefix
+	res.notDebug = l.notDebug
+	res.writeOptions = l.writeOptions
+	res.root = l.storage()
+	res.fields = mergeFields(l.fields, fields)
+	res.operationID = operationID
+
+	return res
+}
+
+func (l *InMemoryLogger) WithField(key string, value any) Logger {
+	return l.WithFields(map[string]any{key: value})
+}
+
+func (l *InMemoryLogger) WithPrefix(prefix string) Logger {
+	return newPrefixLogger(l, prefix)
+}
+
+func (l *InMemoryLogger) storage() *InMemoryLogger {
+	if l.root != nil {
+		return l.root
+	}
 
-	l.buffer = buffer
 	return l
 }
 
@@ -120,37 +263,57 @@ func (l *InMemoryLogger) Parent() Logger {
 	return l.parent
 }
 
-func (l *InMemoryLogger) FirstMatch(m *Match) (string, error) {
+// Entries
+// returns copy of all stored entries in order of writing
+func (l *InMemoryLogger) Entries() []Entry {
+	s := l.storage()
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	return slices.Clone(s.entries)
+}
+
+// MatchEntries
+// like AllMatches but returns entries
+func (l *InMemoryLogger) MatchEntries(m *Match) ([]Entry, error) {
 	if err := m.IsValid(); err != nil {
-		return "", err
+		return nil, err
 	}
 
-	l.m.RLock()
-	defer l.m.RUnlock()
+	result := make([]Entry, 0)
 
-	for _, entry := range l.entries {
-		if l.match(m, entry) {
-			return entry, nil
+	for _, entry := range l.Entries() {
+		if m.Matches(entry) {
+			result = append(result, entry)
 		}
 	}
 
-	return "", nil
+	return result, nil
 }
 
-func (l *InMemoryLogger) AllMatches(m *Match) ([]string, error) {
-	if err := m.IsValid(); err != nil {
-		return nil, err
+func (l *InMemoryLogger) FirstMatch(m *Match) (string, error) {
+	entries, err := l.MatchEntries(m)
+	if err != nil {
+		return "", err
 	}
 
-	l.m.RLock()
-	defer l.m.RUnlock()
+	if len(entries) == 0 {
+		return "", nil
+	}
 
-	result := make([]string, 0)
+	return entries[0].String(), nil
+}
 
-	for _, entry := range l.entries {
-		if l.match(m, entry) {
-			result = append(result, entry)
-		}
+func (l *InMemoryLogger) AllMatches(m *Match) ([]string, error) {
+	entries, err := l.MatchEntries(m)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, entry.String())
 	}
 
 	return result, nil
@@ -161,35 +324,41 @@ func (l *InMemoryLogger) FlushAndClose() error {
 }
 
 func (l *InMemoryLogger) Process(p Process, t string, action func() error) error {
-	l.writeEntityFormatted("Start process: %s/%s", p, t)
+	l.startProcess(t)
+	l.writeEntityFormatted(LevelInfo, "Start process: %s/%s", p, t)
 	err := l.parent.Process(p, t, action)
-	l.writeEntityFormatted("End process: %s/%s", p, t)
+	l.writeEntityFormatted(LevelInfo, "End process: %s/%s", p, t)
+	l.endProcess()
 	return err
 }
 
+// InfoFWithoutLn
+// message is formatted once and passed to parent without args
 func (l *InMemoryLogger) InfoFWithoutLn(format string, a ...interface{}) {
-	l.writeEntityFormatted(format, a...)
-	l.parent.InfoFWithoutLn(format, a...)
+	msg := l.formatString(format, a...)
+	l.writeEntity(LevelInfo, "", msg)
+	l.parent.InfoFWithoutLn(formattedAsFormat(msg))
 }
 
 // InfoLn
 // Deprecated:
 // Use InfoF(string) it add \n to end
 func (l *InMemoryLogger) InfoLn(a ...interface{}) {
-	l.writeEntityFormatted(listToString(a))
+	l.writeEntityFormatted(LevelInfo, listToString(a))
 	l.parent.InfoLn(a...)
 }
 
 func (l *InMemoryLogger) ErrorFWithoutLn(format string, a ...interface{}) {
-	l.writeEntityWithPrefix(l.errorPrefix, format, a...)
-	l.parent.ErrorFWithoutLn(format, a...)
+	msg := l.formatString(format, a...)
+	l.writeEntity(LevelError, l.errorPrefix, msg)
+	l.parent.ErrorFWithoutLn(formattedAsFormat(msg))
 }
 
 // ErrorLn
 // Deprecated:
 // Use ErrorF(string) it add \n to end
 func (l *InMemoryLogger) ErrorLn(a ...interface{}) {
-	l.writeEntityWithPrefix(l.errorPrefix, listToString(a))
+	l.writeEntityWithPrefix(LevelError, l.errorPrefix, listToString(a))
 	l.parent.ErrorLn(a...)
 }
 
@@ -198,8 +367,9 @@ func (l *InMemoryLogger) DebugFWithoutLn(format string, a ...interface{}) {
 		return
 	}
 
-	l.writeEntityWithPrefix(l.debugPrefix, format, a...)
-	l.parent.DebugFWithoutLn(format, a...)
+	msg := l.formatString(format, a...)
+	l.writeEntity(LevelDebug, l.debugPrefix, msg)
+	l.parent.DebugFWithoutLn(formattedAsFormat(msg))
 }
 
 // DebugLn
@@ -210,40 +380,41 @@ func (l *InMemoryLogger) DebugLn(a ...interface{}) {
 		return
 	}
 
-	l.writeEntityWithPrefix(l.debugPrefix, listToString(a))
+	l.writeEntityWithPrefix(LevelDebug, l.debugPrefix, listToString(a))
 	l.parent.DebugLn(a...)
 }
 
 func (l *InMemoryLogger) WarnFWithoutLn(format string, a ...interface{}) {
-	l.writeEntityFormatted(format, a...)
-	l.parent.WarnFWithoutLn(format, a...)
+	msg := l.formatString(format, a...)
+	l.writeEntity(LevelWarn, "", msg)
+	l.parent.WarnFWithoutLn(formattedAsFormat(msg))
 }
 
 // WarnLn
 // Deprecated:
 // Use WarnF(string) it add \n to end
 func (l *InMemoryLogger) WarnLn(a ...interface{}) {
-	l.writeEntityFormatted(listToString(a))
+	l.writeEntityFormatted(LevelWarn, listToString(a))
 	l.parent.WarnLn(a...)
 }
 
 func (l *InMemoryLogger) Success(s string) {
-	l.writeEntityFormatted("Success: %s", s)
+	l.writeEntityFormatted(LevelInfo, "Success: %s", s)
 	l.parent.Success(s)
 }
 
 func (l *InMemoryLogger) Fail(s string) {
-	l.writeEntityWithPrefix(l.errorPrefix, "Fail: %s", s)
+	l.writeEntityWithPrefix(LevelError, l.errorPrefix, "Fail: %s", s)
 	l.parent.Fail(s)
 }
 
 func (l *InMemoryLogger) FailRetry(s string) {
-	l.writeEntityWithPrefix(l.errorPrefix, "Fail retry: %s", s)
+	l.writeEntityWithPrefix(LevelWarn, l.errorPrefix, "Fail retry: %s", s)
 	l.parent.FailRetry(s)
 }
 
 func (l *InMemoryLogger) JSON(s []byte) {
-	l.writeEntity(string(s))
+	l.writeEntity(LevelInfo, "", string(s))
 	l.parent.JSON(s)
 }
 
@@ -251,6 +422,10 @@ func (l *InMemoryLogger) ProcessLogger() ProcessLogger {
 	return newInMemoryProcessLogger(l, l.parent.ProcessLogger())
 }
 
+func (l *InMemoryLogger) ProgressLogger() ProgressLogger {
+	return newInMemoryProgressLogger(l, l.parent.ProgressLogger())
+}
+
 func (l *InMemoryLogger) SilentLogger() *SilentLogger {
 	return NewSilentLogger()
 }
@@ -260,44 +435,68 @@ func (l *InMemoryLogger) BufferLogger(buffer *bytes.Buffer) Logger {
 }
 
 func (l *InMemoryLogger) Write(s []byte) (int, error) {
-	l.writeEntity(string(s))
+	for _, record := range l.writeOptions.records(s) {
+		switch record.level {
+		case LevelError:
+			l.writeEntity(LevelError, l.errorPrefix, record.msg)
+		case LevelDebug:
+			if !l.notDebug {
+				l.writeEntity(LevelDebug, l.debugPrefix, record.msg)
+			}
+		default:
+			l.writeEntity(record.level, "", record.msg)
+		}
+	}
+
 	return l.parent.Write(s)
 }
 
-func (l *InMemoryLogger) match(m *Match, entity string) bool {
-	if len(m.Regex) > 0 {
-		for _, regex := range m.Regex {
-			if regex.MatchString(entity) {
-				return true
-			}
-		}
+func (l *InMemoryLogger) startProcess(name string) {
+	s := l.storage()
 
-		return false
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.processes = append(s.processes, name)
+}
+
+func (l *InMemoryLogger) endProcess() {
+	s := l.storage()
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if len(s.processes) > 0 {
+		s.processes = s.processes[:len(s.processes)-1]
 	}
+}
 
-	for _, prefix := range m.Prefix {
-		if strings.HasPrefix(entity, prefix) {
-			return true
-		}
+func (l *InMemoryLogger) writeEntity(level Level, prefix, msg string) {
+	entry := Entry{
+		Time:        time.Now(),
+		Level:       level,
+		Message:     maskSecrets(msg),
+		OperationID: l.operationID,
+		prefix:      prefix,
 	}
 
-	for _, suffix := range m.Suffix {
-		if strings.HasSuffix(entity, suffix) {
-			return true
-		}
+	if len(l.fields) > 0 {
+		entry.Fields = maps.Clone(l.fields)
 	}
 
-	return false
-}
+	s := l.storage()
 
-func (l *InMemoryLogger) writeEntity(entity string) {
-	l.m.Lock()
-	defer l.m.Unlock()
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if len(s.processes) > 0 {
+		entry.Process = s.processes[len(s.processes)-1]
+	}
 
-	l.entries = append(l.entries, entity)
+	s.entries = append(s.entries, entry)
 
-	if l.buffer != nil {
-		l.buffer.WriteString(entity)
+	if s.buffer != nil {
+		s.buffer.WriteString(entry.String())
 	}
 }
 
@@ -307,22 +506,15 @@ func (l *InMemoryLogger) formatString(f string, a ...any) string {
 		format = "%v"
 	}
 
-	return fmt.Sprintf(format, a...)
+	return sprintf(format, a...)
 }
 
-func (l *InMemoryLogger) writeEntityFormatted(f string, a ...any) {
-	l.writeEntity(l.formatString(f, a...))
+func (l *InMemoryLogger) writeEntityFormatted(level Level, f string, a ...any) {
+	l.writeEntity(level, "", l.formatString(f, a...))
 }
 
-func (l *InMemoryLogger) writeEntityWithPrefix(prefix, f string, a ...any) {
-	msg := l.formatString(f, a...)
-
-	if prefix != "" {
-		l.writeEntityFormatted("%s: %s", prefix, msg)
-		return
-	}
-
-	l.writeEntity(msg)
+func (l *InMemoryLogger) writeEntityWithPrefix(level Level, prefix, f string, a ...any) {
+	l.writeEntity(level, prefix, l.formatString(f, a...))
 }
 
 type inMemoryProcessLogger struct {
@@ -339,15 +531,62 @@ func newInMemoryProcessLogger(inMemory *InMemoryLogger, parent ProcessLogger) *i
 
 func (l *inMemoryProcessLogger) ProcessStart(name string) {
 	l.parent.ProcessStart(name)
-	l.inMemory.writeEntityFormatted("Start process: %s", name)
+	l.inMemory.startProcess(name)
+	l.inMemory.writeEntityFormatted(LevelInfo, "Start process: %s", name)
 }
 
 func (l *inMemoryProcessLogger) ProcessFail() {
 	l.parent.ProcessFail()
-	l.inMemory.writeEntityWithPrefix(l.inMemory.errorPrefix, "Fail process")
+	l.inMemory.writeEntityWithPrefix(LevelError, l.inMemory.errorPrefix, "Fail process")
+	l.inMemory.endProcess()
 }
 
 func (l *inMemoryProcessLogger) ProcessEnd() {
 	l.parent.ProcessEnd()
-	l.inMemory.writeEntity("End process")
+	l.inMemory.writeEntity(LevelInfo, "", "End process")
+	l.inMemory.endProcess()
+}
+
+// inMemoryProgressLogger
+// stores progress as info entries with progress_current and progress_total fields
+type inMemoryProgressLogger struct {
+	parent   ProgressLogger
+	inMemory *InMemoryLogger
+	counter  *progressCounter
+}
+
+func newInMemoryProgressLogger(inMemory *InMemoryLogger, parent ProgressLogger) *inMemoryProgressLogger {
+	return &inMemoryProgressLogger{
+		parent:   parent,
+		inMemory: inMemory,
+		counter:  &progressCounter{},
+	}
+}
+
+func (l *inMemoryProgressLogger) StartProgress(total int) {
+	l.parent.StartProgress(total)
+	l.counter.start(total)
+	l.write(0, total, "Start progress")
+}
+
+func (l *inMemoryProgressLogger) Increment(msg string) {
+	l.parent.Increment(msg)
+	current, total := l.counter.increment()
+	l.write(current, total, fmt.Sprintf("%s %s", formatProgress(current, total), msg))
+}
+
+func (l *inMemoryProgressLogger) Done() {
+	l.parent.Done()
+	current, total := l.counter.state()
+	l.write(current, total, fmt.Sprintf("%s Done", formatProgress(current, total)))
+}
+
+func (l *inMemoryProgressLogger) write(current, total int, msg string) {
+	logger := l.inMemory.WithFields(map[string]any{
+		progressCurrentField: current,
+		progressTotalField:   total,
+	}).(*InMemoryLogger)
+
+	// do not pass progress to parent, parent progress logger reports it
+	logger.writeEntity(LevelInfo, "", msg)
 }
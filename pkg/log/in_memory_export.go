@@ -0,0 +1,134 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/name212/govalue"
+)
+
+type ExportFormat string
+
+const (
+	// ExportText
+	// every entry on own line as it returned from Entry.String
+	ExportText ExportFormat = "text"
+	// ExportJSON
+	// json array of entries
+	ExportJSON ExportFormat = "json"
+)
+
+// ExportedEntry
+// entry representation in ExportJSON format
+type ExportedEntry struct {
+	Time        time.Time      `json:"time"`
+	Level       Level          `json:"level"`
+	Process     string         `json:"process,omitempty"`
+	Message     string         `json:"message"`
+	Fields      map[string]any `json:"fields,omitempty"`
+	OperationID string         `json:"operation,omitempty"`
+}
+
+// Export
+// writes all stored entries to w in format
+func (l *InMemoryLogger) Export(w io.Writer, format ExportFormat) error {
+	if govalue.IsNil(w) {
+		return fmt.Errorf("Cannot export logs: writer is nil")
+	}
+
+	entries := l.Entries()
+
+	switch format {
+	case ExportText:
+		return exportText(w, entries)
+	case ExportJSON:
+		return exportJSON(w, entries)
+	default:
+		return fmt.Errorf("Unknown export format: '%s'. Should be %s or %s", format, ExportText, ExportJSON)
+	}
+}
+
+// Replay
+// writes all stored entries to target with their levels, fields and operation ids.
+// processes are not restarted, process start and end entries are written as info messages
+func (l *InMemoryLogger) Replay(target Logger) {
+	if govalue.IsNil(target) {
+		return
+	}
+
+	for _, entry := range l.Entries() {
+		logger := target
+		if len(entry.Fields) > 0 {
+			logger = target.WithFields(entry.Fields)
+		}
+
+		if entry.OperationID != "" {
+			logger = WithOperationID(logger, entry.OperationID)
+		}
+
+		msg := entry.messageWithPrefix()
+
+		switch entry.Level {
+		case LevelDebug:
+			logger.DebugFWithoutLn("%s", msg)
+		case LevelWarn:
+			logger.WarnFWithoutLn("%s", msg)
+		case LevelError:
+			logger.ErrorFWithoutLn("%s", msg)
+		default:
+			logger.InfoFWithoutLn("%s", msg)
+		}
+	}
+}
+
+func exportText(w io.Writer, entries []Entry) error {
+	for _, entry := range entries {
+		line := entry.String()
+		if !strings.HasSuffix(line, "\n") {
+			line += "\n"
+		}
+
+		if _, err := io.WriteString(w, line); err != nil {
+			return fmt.Errorf("Cannot export logs: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func exportJSON(w io.Writer, entries []Entry) error {
+	exported := make([]ExportedEntry, 0, len(entries))
+	for _, entry := range entries {
+		exported = append(exported, ExportedEntry{
+			Time:        entry.Time,
+			Level:       entry.Level,
+			Process:     entry.Process,
+			Message:     entry.messageWithPrefix(),
+			Fields:      entry.Fields,
+			OperationID: entry.OperationID,
+		})
+	}
+
+	if err := json.NewEncoder(w).Encode(exported); err != nil {
+		return fmt.Errorf("Cannot export logs: %w", err)
+	}
+
+	return nil
+}
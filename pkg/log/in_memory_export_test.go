@@ -0,0 +1,110 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemoryLoggerExport(t *testing.T) {
+	newLogger := func() *InMemoryLogger {
+		logger := NewInMemoryLogger().WithErrorPrefix("ERR")
+
+		logger.InfoF("info")
+		logger.WithField("node", "master-0").WarnF("warn")
+		_ = logger.Process(ProcessDefault, "process", func() error {
+			logger.ErrorFWithoutLn("error")
+			return nil
+		})
+
+		return logger
+	}
+
+	t.Run("text", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		require.NoError(t, newLogger().Export(buf, ExportText))
+
+		require.Equal(t, "info\n"+
+			"warn | fields: [node='master-0']\n"+
+			"Start process: default/process\n"+
+			"ERR: error\n"+
+			"End process: default/process\n", buf.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		require.NoError(t, newLogger().Export(buf, ExportJSON))
+
+		var entries []ExportedEntry
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &entries))
+		require.Len(t, entries, 5)
+
+		require.Equal(t, LevelWarn, entries[1].Level)
+		require.Equal(t, "warn\n", entries[1].Message)
+		require.Equal(t, map[string]any{"node": "master-0"}, entries[1].Fields)
+
+		require.Equal(t, LevelError, entries[3].Level)
+		require.Equal(t, "ERR: error", entries[3].Message)
+		require.Equal(t, "process", entries[3].Process)
+		require.False(t, entries[3].Time.IsZero())
+	})
+
+	t.Run("empty logger", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		require.NoError(t, NewInMemoryLogger().Export(buf, ExportJSON))
+		require.Equal(t, "[]\n", buf.String())
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		err := newLogger().Export(&bytes.Buffer{}, "yaml")
+		require.ErrorContains(t, err, "Unknown export format: 'yaml'")
+	})
+
+	t.Run("write error", func(t *testing.T) {
+		err := newLogger().Export(&testFailWriter{}, ExportText)
+		require.ErrorContains(t, err, "Cannot export logs")
+	})
+}
+
+func TestInMemoryLoggerReplay(t *testing.T) {
+	source := NewInMemoryLogger()
+
+	source.InfoF("info")
+	source.DebugF("debug")
+	source.WithField("node", "master-0").WarnF("warn")
+	source.ErrorF("error")
+
+	target := NewInMemoryLogger()
+	source.Replay(target)
+
+	require.Equal(t, source.Entries()[0].String(), target.Entries()[0].String())
+
+	targetEntries := target.Entries()
+	require.Len(t, targetEntries, 4)
+
+	for i, entry := range source.Entries() {
+		require.Equal(t, entry.Level, targetEntries[i].Level)
+		require.Equal(t, entry.Message, targetEntries[i].Message)
+		require.Equal(t, entry.Fields, targetEntries[i].Fields)
+	}
+
+	require.NotPanics(t, func() {
+		source.Replay(nil)
+	})
+}
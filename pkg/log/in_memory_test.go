@@ -16,6 +16,7 @@ package log
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -74,3 +75,69 @@ func TestInMemoryLoggerProcessLogger(t *testing.T) {
 		})
 	})
 }
+
+func TestInMemoryLoggerEntries(t *testing.T) {
+	logger := NewInMemoryLogger().WithErrorPrefix("Error").WithDebugPrefix("Debug")
+
+	before := time.Now()
+
+	logger.InfoF("info message")
+	logger.WarnF("warn message")
+	logger.DebugF("debug message")
+
+	err := logger.Process(ProcessBootstrap, "Bootstrap", func() error {
+		logger.ErrorF("error message")
+		logger.WithField("node", "master-0").InfoF("node message")
+		return nil
+	})
+	require.NoError(t, err)
+
+	entries := logger.Entries()
+	require.Len(t, entries, 7)
+
+	for _, entry := range entries {
+		require.False(t, entry.Time.Before(before))
+	}
+
+	require.Equal(t, LevelInfo, entries[0].Level)
+	require.Equal(t, "info message\n", entries[0].Message)
+	require.Empty(t, entries[0].Process)
+
+	require.Equal(t, LevelWarn, entries[1].Level)
+
+	require.Equal(t, LevelDebug, entries[2].Level)
+	require.Equal(t, "debug message\n", entries[2].Message)
+	require.Equal(t, "Debug: debug message\n", entries[2].String())
+
+	require.Equal(t, "Bootstrap", entries[3].Process)
+	require.Equal(t, "Start process: bootstrap/Bootstrap", entries[3].Message)
+
+	require.Equal(t, LevelError, entries[4].Level)
+	require.Equal(t, "Bootstrap", entries[4].Process)
+	require.Equal(t, "Error: error message\n", entries[4].String())
+
+	require.Equal(t, map[string]any{"node": "master-0"}, entries[5].Fields)
+	require.Equal(t, "node message | fields: [node='master-0']\n", entries[5].String())
+
+	require.Empty(t, entries[6].Fields)
+
+	t.Run("match by levels", func(t *testing.T) {
+		matches, err := logger.AllMatches(&Match{Levels: []Level{LevelError, LevelWarn}})
+		require.NoError(t, err)
+		require.Equal(t, []string{"warn message\n", "Error: error message\n"}, matches)
+
+		matched, err := logger.MatchEntries(&Match{Prefix: []string{"Start process"}, Levels: []Level{LevelInfo}})
+		require.NoError(t, err)
+		require.Len(t, matched, 1)
+		require.Equal(t, "Bootstrap", matched[0].Process)
+
+		matches, err = logger.AllMatches(&Match{Prefix: []string{"info message"}, Levels: []Level{LevelDebug}})
+		require.NoError(t, err)
+		require.Empty(t, matches)
+	})
+
+	t.Run("invalid match", func(t *testing.T) {
+		_, err := logger.MatchEntries(&Match{})
+		require.Error(t, err)
+	})
+}
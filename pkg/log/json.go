@@ -14,6 +14,55 @@
 
 package log
 
+import "slices"
+
+// Stable fields of JSON logger records. Fields are not renamed between releases.
+// time, level and msg are written for every record,
+// process and action are written for process start (action=start) and end (action=end) records,
+// action is also written for progress records (progress_start, progress, progress_done),
+// status is written for Success (SUCCESS), Fail and FailRetry (FAIL) records,
+// operation is written for every record if LoggerOptions.OperationID is set or WithOperationID is used.
+// source and stacktrace are diagnostic fields, they can be changed without notice.
+const (
+	JSONFieldTime      = "time"
+	JSONFieldLevel     = "level"
+	JSONFieldMessage   = "msg"
+	JSONFieldProcess   = "process"
+	JSONFieldAction    = "action"
+	JSONFieldStatus    = "status"
+	JSONFieldOperation = "operation"
+)
+
+// reservedFieldPrefix
+// prefix for custom fields which names are equal to stable or diagnostic fields
+const reservedFieldPrefix = "field_"
+
+var reservedJSONFields = []string{
+	JSONFieldTime,
+	JSONFieldLevel,
+	JSONFieldMessage,
+	JSONFieldProcess,
+	JSONFieldAction,
+	JSONFieldStatus,
+	JSONFieldOperation,
+	"logger",
+	"source",
+	"stacktrace",
+}
+
+// NewJSONLogger
+// SimpleLogger with SimpleFormatJSON format
 func NewJSONLogger(opts LoggerOptions) *SimpleLogger {
+	opts.SimpleFormat = SimpleFormatJSON
 	return NewSimpleLogger(opts)
 }
+
+// jsonFieldKey
+// custom fields cannot override stable fields, so they are written with prefix like: field_status
+func jsonFieldKey(key string) string {
+	if slices.Contains(reservedJSONFields, key) {
+		return reservedFieldPrefix + key
+	}
+
+	return key
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONLoggerFields(t *testing.T) {
+	readRecords := func(t *testing.T, buf *bytes.Buffer) []map[string]any {
+		records := make([]map[string]any, 0)
+
+		scanner := bufio.NewScanner(buf)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+		for scanner.Scan() {
+			record := make(map[string]any)
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+			records = append(records, record)
+		}
+
+		require.NoError(t, scanner.Err())
+
+		return records
+	}
+
+	t.Run("stable fields", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := NewJSONLogger(LoggerOptions{
+			OutStream:   buf,
+			OperationID: "op-1",
+			Fields: map[string]any{
+				"cluster": "prod",
+				"run_id":  "42",
+			},
+		})
+
+		_ = logger.Process(ProcessBootstrap, "install", func() error {
+			logger.InfoF("message")
+			logger.Success("done")
+			return nil
+		})
+
+		records := readRecords(t, buf)
+		require.Len(t, records, 4)
+
+		for _, record := range records {
+			keys := slices.Sorted(maps.Keys(record))
+			for _, key := range []string{JSONFieldTime, JSONFieldLevel, JSONFieldMessage, JSONFieldOperation, "cluster", "run_id"} {
+				require.Contains(t, keys, key)
+			}
+
+			require.Equal(t, "op-1", record[JSONFieldOperation])
+			require.Equal(t, "prod", record["cluster"])
+		}
+
+		require.Equal(t, "start", records[0][JSONFieldAction])
+		require.Equal(t, string(ProcessBootstrap), records[0][JSONFieldProcess])
+		require.Equal(t, "message\n", records[1][JSONFieldMessage])
+		require.Equal(t, "info", records[1][JSONFieldLevel])
+		require.Equal(t, "SUCCESS", records[2][JSONFieldStatus])
+		require.Equal(t, "end", records[3][JSONFieldAction])
+	})
+
+	t.Run("custom fields cannot override stable fields", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := NewJSONLogger(LoggerOptions{
+			OutStream: buf,
+			Fields:    map[string]any{"level": "custom"},
+		})
+
+		logger.WithField("status", "custom").Fail("failed")
+
+		records := readRecords(t, buf)
+		require.Len(t, records, 1)
+
+		require.Equal(t, "error", records[0][JSONFieldLevel])
+		require.Equal(t, "FAIL", records[0][JSONFieldStatus])
+		require.Equal(t, "custom", records[0]["field_level"])
+		require.Equal(t, "custom", records[0]["field_status"])
+	})
+
+	t.Run("buffer logger keeps operation and fields", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := NewJSONLogger(LoggerOptions{
+			OperationID: "op-1",
+			Fields:      map[string]any{"cluster": "prod"},
+		})
+
+		logger.WithField("node", "master-0").BufferLogger(buf).InfoF("message")
+
+		records := readRecords(t, buf)
+		require.Len(t, records, 1)
+		require.Equal(t, "op-1", records[0][JSONFieldOperation])
+		require.Equal(t, "prod", records[0]["cluster"])
+		require.Equal(t, "master-0", records[0]["node"])
+	})
+}
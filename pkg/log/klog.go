@@ -17,7 +17,9 @@ package log
 import (
 	"flag"
 	"fmt"
-	"strings"
+	"regexp"
+	"strconv"
+	"time"
 
 	"github.com/name212/govalue"
 	"k8s.io/klog/v2"
@@ -39,6 +41,13 @@ type KlogOptions struct {
 	// verbose
 	// use defaultKeywords Sanitizer
 	sanitizer Sanitizer
+
+	// debugOnly
+	// write all klog messages with debug level
+	debugOnly bool
+
+	dedupWindow    time.Duration
+	linesPerSecond int
 }
 
 func WithKlogVerbose(v string) KlogOpt {
@@ -57,6 +66,15 @@ func WithKlogSanitizer(sanitizer Sanitizer) KlogOpt {
 	}
 }
 
+// WithKlogDebugOnly
+// write all klog messages with debug level (old behavior)
+// by default errors and warnings are written with error and warn level
+func WithKlogDebugOnly() KlogOpt {
+	return func(opts *KlogOptions) {
+		opts.debugOnly = true
+	}
+}
+
 func InitKlog(logger Logger, opts ...KlogOpt) error {
 	if govalue.IsNil(logger) {
 		return fmt.Errorf("logger is not provided to init klog")
@@ -82,6 +100,13 @@ func InitKlog(logger Logger, opts ...KlogOpt) error {
 		return flagSetError(logStdErrFlag, err)
 	}
 
+	// klog writes message to outputs of all lower severities,
+	// we use one output for all severities, so message will be routed multiple times
+	const oneOutputFlag = "one_output"
+	if err := flags.Set(oneOutputFlag, strconv.FormatBool(!optsForSet.debugOnly)); err != nil {
+		return flagSetError(oneOutputFlag, err)
+	}
+
 	if optsForSet.verbose != "" {
 		const vFlag = "v"
 		if err := flags.Set(vFlag, optsForSet.verbose); err != nil {
@@ -94,13 +119,20 @@ func InitKlog(logger Logger, opts ...KlogOpt) error {
 		klog.SetLogFilter(optsForSet.sanitizer)
 	}
 
-	klog.SetOutput(newKlogWriterWrapper(logger))
+	writer := newKlogWriterWrapper(logger, optsForSet.debugOnly)
+	writer.throttle = newKlogThrottle(logger, optsForSet.dedupWindow, optsForSet.linesPerSecond)
+
+	klog.SetOutput(writer)
 
 	return nil
 }
 
 type KeywordSanitizer struct {
-	keywords []string
+	keywords          []string
+	verbosityKeywords []verbosityKeyword
+	exceptions        []KeywordException
+	rules             []SanitizeRule
+	redactedFields    map[string]struct{}
 }
 
 func NewDummySanitizer() Sanitizer {
@@ -131,14 +163,31 @@ func (l *KeywordSanitizer) Filter(args []any) []any {
 			continue
 		}
 		if matchedKeyword := l.isSensitive(str); matchedKeyword != "" {
+			if redacted, ok := l.redactJSON(str); ok {
+				args[i] = l.applyRules(redacted)
+				continue
+			}
+
 			args[i] = filteredMsg(matchedKeyword)
+			continue
 		}
+
+		args[i] = l.applyRules(str)
 	}
 	return args
 }
 
+// FilterF
+// renders message before filtering, because secret can be split between format and args,
+// for example "https://user:%s@registry" or "Bearer %s"
+// returns "%s" format with one filtered message argument
 func (l *KeywordSanitizer) FilterF(format string, args []any) (string, []any) {
-	return format, l.Filter(args)
+	msg := format
+	if len(args) > 0 {
+		msg = fmt.Sprintf(format, args...)
+	}
+
+	return "%s", l.Filter([]any{msg})
 }
 
 func (l *KeywordSanitizer) FilterS(msg string, keysAndValues []any) (string, []any) {
@@ -148,10 +197,17 @@ func (l *KeywordSanitizer) FilterS(msg string, keysAndValues []any) (string, []a
 // isSensitive - returns empty if is not sensitive
 func (l *KeywordSanitizer) isSensitive(msg string) string {
 	for _, keyword := range l.keywords {
-		if strings.Contains(msg, keyword) {
+		if l.matchKeyword(keyword, msg) {
 			return keyword
 		}
 	}
+
+	for _, k := range l.verbosityKeywords {
+		if klog.V(k.level).Enabled() && l.matchKeyword(k.keyword, msg) {
+			return k.keyword
+		}
+	}
+
 	return ""
 }
 
@@ -159,20 +215,58 @@ func flagSetError(key string, err error) error {
 	return fmt.Errorf("Failed to set klog falg '%s': %w", key, err)
 }
 
+// klogHeaderRegex
+// klog line header: Lmmdd hh:mm:ss.uuuuuu threadid file:line]
+var klogHeaderRegex = regexp.MustCompile(`^([IWEF])\d{4} \d{2}:\d{2}:\d{2}\.\d{6}\s+\d+ [^\]]+\] `)
+
 type klogWriterWrapper struct {
-	logger Logger
+	logger    Logger
+	debugOnly bool
+	throttle  *klogThrottle
 }
 
-func newKlogWriterWrapper(logger Logger) *klogWriterWrapper {
-	return &klogWriterWrapper{logger: logger}
+func newKlogWriterWrapper(logger Logger, debugOnly bool) *klogWriterWrapper {
+	return &klogWriterWrapper{
+		logger:    logger,
+		debugOnly: debugOnly,
+	}
 }
 
+// Write
+// routes message by severity from klog header: E and F to error, W to warn
+// info messages are written with debug level, because klog with maximal verbose
+// writes a lot of info messages (requests and responses for example)
 func (l *klogWriterWrapper) Write(p []byte) (int, error) {
-	l.logger.DebugFWithoutLn("klog: %s", string(p))
+	var write klogWriteFunc = l.logger.DebugFWithoutLn
+
+	if !l.debugOnly {
+		switch klogSeverity(p) {
+		case "E", "F":
+			write = l.logger.ErrorFWithoutLn
+		case "W":
+			write = l.logger.WarnFWithoutLn
+		}
+	}
+
+	if l.throttle != nil {
+		l.throttle.write(write, string(p))
+		return len(p), nil
+	}
+
+	write("klog: %s", string(p))
 
 	return len(p), nil
 }
 
+func klogSeverity(p []byte) string {
+	match := klogHeaderRegex.FindSubmatch(p)
+	if len(match) < 2 {
+		return ""
+	}
+
+	return string(match[1])
+}
+
 var defaultSensitiveKeywords = []string{
 	`"name":"d8-cluster-terraform-state"`,
 	`"name":"d8-provider-cluster-configuration"`,
@@ -103,6 +103,68 @@ func TestInitKlogWithDummySanitizerAndVerbose(t *testing.T) {
 	doKlogTests(t, "dummy sanitizer and verbose", tests, logger)
 }
 
+func TestKlogSeverityRouting(t *testing.T) {
+	const (
+		infoLine    = "I0912 10:00:00.000000    1 main.go:10] info message\n"
+		warnLine    = "W0912 10:00:00.000000    1 warnings.go:70] v1beta1 Ingress is deprecated\n"
+		errorLine   = "E0912 10:00:00.000000    1 reflector.go:138] failed to list\n"
+		fatalLine   = "F0912 10:00:00.000000    1 main.go:10] fatal\n"
+		invalidLine = "W0912 without header\n"
+	)
+
+	writeAll := func(t *testing.T, debugOnly bool) []Entry {
+		logger := NewInMemoryLogger()
+		writer := newKlogWriterWrapper(logger, debugOnly)
+
+		for _, line := range []string{infoLine, warnLine, errorLine, fatalLine, invalidLine} {
+			n, err := writer.Write([]byte(line))
+			require.NoError(t, err)
+			require.Equal(t, len(line), n)
+		}
+
+		entries := logger.Entries()
+		require.Len(t, entries, 5)
+
+		return entries
+	}
+
+	t.Run("route by severity", func(t *testing.T) {
+		entries := writeAll(t, false)
+
+		require.Equal(t, LevelDebug, entries[0].Level)
+		require.Equal(t, LevelWarn, entries[1].Level)
+		require.Equal(t, "klog: "+warnLine, entries[1].Message)
+		require.Equal(t, LevelError, entries[2].Level)
+		require.Equal(t, LevelError, entries[3].Level)
+		require.Equal(t, LevelDebug, entries[4].Level)
+	})
+
+	t.Run("debug only", func(t *testing.T) {
+		for _, entry := range writeAll(t, true) {
+			require.Equal(t, LevelDebug, entry.Level)
+		}
+	})
+
+	t.Run("init klog", func(t *testing.T) {
+		logger := NewInMemoryLoggerWithParent(NewSimpleLogger(LoggerOptions{IsDebug: false})).WithNoDebug(true)
+		require.NoError(t, InitKlog(logger))
+
+		klog.Warning("deprecated api warning")
+		klog.Info("info message")
+
+		matches, err := logger.AllMatches(&Match{
+			Regex:  []*regexp.Regexp{regexp.MustCompile(`deprecated api warning`)},
+			Levels: []Level{LevelWarn},
+		})
+		require.NoError(t, err)
+		require.Len(t, matches, 1)
+
+		matches, err = logger.AllMatches(&Match{Regex: []*regexp.Regexp{regexp.MustCompile(`info message`)}})
+		require.NoError(t, err)
+		require.Empty(t, matches)
+	})
+}
+
 func testInitKlogLogger(t *testing.T, opts ...KlogOpt) *InMemoryLogger {
 	logger := NewInMemoryLoggerWithParent(NewSimpleLogger(LoggerOptions{IsDebug: true}))
 	err := InitKlog(logger, opts...)
@@ -0,0 +1,128 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// WithKlogDedupWindow
+// identical klog messages (without header) repeated within window
+// are collapsed into one message: …message repeated N times
+// disabled if window <= 0
+func WithKlogDedupWindow(window time.Duration) KlogOpt {
+	return func(opts *KlogOptions) {
+		opts.dedupWindow = window
+	}
+}
+
+// WithKlogRateLimit
+// write not more than linesPerSecond klog messages per second,
+// count of dropped messages is written with warn level
+// disabled if linesPerSecond <= 0
+func WithKlogRateLimit(linesPerSecond int) KlogOpt {
+	return func(opts *KlogOptions) {
+		opts.linesPerSecond = linesPerSecond
+	}
+}
+
+type klogWriteFunc func(format string, a ...any)
+
+type klogThrottle struct {
+	mu sync.Mutex
+
+	logger         Logger
+	linesPerSecond int
+	now            func() time.Time
+
+	dedup *dedupState
+
+	// rate limit state
+	secondStart   time.Time
+	linesInSecond int
+	dropped       int
+}
+
+func newKlogThrottle(logger Logger, window time.Duration, linesPerSecond int) *klogThrottle {
+	if window <= 0 && linesPerSecond <= 0 {
+		return nil
+	}
+
+	t := &klogThrottle{
+		logger:         logger,
+		linesPerSecond: linesPerSecond,
+		now:            time.Now,
+		dedup:          newDedupState(window),
+	}
+
+	t.dedup.now = func() time.Time {
+		return t.now()
+	}
+
+	return t
+}
+
+func (t *klogThrottle) write(write klogWriteFunc, line string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// message body is dedup key, because header contains time
+	body := klogMessageBody(line)
+
+	t.dedup.write(body, func() {
+		if t.allow(t.now()) {
+			write("klog: %s", line)
+		}
+	}, func(count int) {
+		write("klog: …message repeated %d times: %s\n", count, strings.TrimRight(body, "\n"))
+	})
+}
+
+func (t *klogThrottle) allow(now time.Time) bool {
+	if t.linesPerSecond <= 0 {
+		return true
+	}
+
+	if now.Sub(t.secondStart) >= time.Second {
+		if t.dropped > 0 {
+			t.logger.WarnF("klog: %d messages dropped by rate limit %d lines per second", t.dropped, t.linesPerSecond)
+		}
+
+		t.secondStart = now
+		t.linesInSecond = 0
+		t.dropped = 0
+	}
+
+	if t.linesInSecond >= t.linesPerSecond {
+		t.dropped++
+		return false
+	}
+
+	t.linesInSecond++
+
+	return true
+}
+
+// klogMessageBody
+// returns message without klog header, because header contains time
+func klogMessageBody(line string) string {
+	if loc := klogHeaderRegex.FindStringIndex(line); loc != nil {
+		return line[loc[1]:]
+	}
+
+	return line
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKlogThrottle(t *testing.T) {
+	const (
+		reflectorLine = "E0912 10:00:0%d.000000    1 reflector.go:138] failed to list *v1.Node: connection refused\n"
+		otherLine     = "W0912 10:00:00.000000    1 warnings.go:70] v1beta1 Ingress is deprecated\n"
+	)
+
+	newWriter := func(window time.Duration, linesPerSecond int) (*klogWriterWrapper, *InMemoryLogger, *time.Time) {
+		logger := NewInMemoryLogger()
+		now := time.Now()
+
+		writer := newKlogWriterWrapper(logger, false)
+		writer.throttle = newKlogThrottle(logger, window, linesPerSecond)
+		writer.throttle.now = func() time.Time {
+			return now
+		}
+
+		return writer, logger, &now
+	}
+
+	messages := func(logger *InMemoryLogger) []string {
+		res := make([]string, 0)
+		for _, entry := range logger.Entries() {
+			res = append(res, fmt.Sprintf("%s: %s", entry.Level, entry.Message))
+		}
+
+		return res
+	}
+
+	write := func(t *testing.T, writer *klogWriterWrapper, line string) {
+		_, err := writer.Write([]byte(line))
+		require.NoError(t, err)
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		require.Nil(t, newKlogThrottle(NewInMemoryLogger(), 0, 0))
+	})
+
+	t.Run("dedup", func(t *testing.T) {
+		writer, logger, now := newWriter(time.Minute, 0)
+
+		for i := 0; i < 5; i++ {
+			write(t, writer, fmt.Sprintf(reflectorLine, i))
+			*now = now.Add(time.Second)
+		}
+
+		write(t, writer, otherLine)
+
+		require.Equal(t, []string{
+			"error: klog: " + fmt.Sprintf(reflectorLine, 0),
+			"error: klog: …message repeated 4 times: failed to list *v1.Node: connection refused\n",
+			"warn: klog: " + otherLine,
+		}, messages(logger))
+	})
+
+	t.Run("dedup window expired", func(t *testing.T) {
+		writer, logger, now := newWriter(10*time.Second, 0)
+
+		write(t, writer, fmt.Sprintf(reflectorLine, 0))
+		write(t, writer, fmt.Sprintf(reflectorLine, 1))
+		*now = now.Add(11 * time.Second)
+		write(t, writer, fmt.Sprintf(reflectorLine, 2))
+
+		require.Equal(t, []string{
+			"error: klog: " + fmt.Sprintf(reflectorLine, 0),
+			"error: klog: …message repeated 1 times: failed to list *v1.Node: connection refused\n",
+			"error: klog: " + fmt.Sprintf(reflectorLine, 2),
+		}, messages(logger))
+	})
+
+	t.Run("dedup flushed by timer", func(t *testing.T) {
+		writer, logger, _ := newWriter(50*time.Millisecond, 0)
+
+		write(t, writer, fmt.Sprintf(reflectorLine, 0))
+		write(t, writer, fmt.Sprintf(reflectorLine, 1))
+
+		require.Eventually(t, func() bool {
+			return len(logger.Entries()) == 2
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("rate limit", func(t *testing.T) {
+		writer, logger, now := newWriter(0, 2)
+
+		for i := 0; i < 5; i++ {
+			write(t, writer, fmt.Sprintf("I0912 10:00:00.000000    1 main.go:10] message %d\n", i))
+		}
+
+		*now = now.Add(time.Second)
+		write(t, writer, otherLine)
+
+		require.Equal(t, []string{
+			"debug: klog: I0912 10:00:00.000000    1 main.go:10] message 0\n",
+			"debug: klog: I0912 10:00:00.000000    1 main.go:10] message 1\n",
+			"warn: klog: 3 messages dropped by rate limit 2 lines per second\n",
+			"warn: klog: " + otherLine,
+		}, messages(logger))
+	})
+}
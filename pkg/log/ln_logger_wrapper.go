@@ -14,7 +14,10 @@
 
 package log
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+)
 
 // formatWithNewLineLogger
 // we often use *F function, but for pretty log we use "\n" in end of string
@@ -43,6 +46,17 @@ func (w *formatWithNewLineLoggerWrapper) WarnF(format string, a ...any) {
 	w.parent.WarnFWithoutLn(addLnToFormat(format), a...)
 }
 
+func (w *formatWithNewLineLoggerWrapper) FatalF(format string, a ...any) {
+	w.parent.ErrorFWithoutLn(addLnToFormat(format), a...)
+	defaultFatalHandler.fatal(w.parent, fmt.Sprintf(trimLn(format), a...))
+}
+
+// Spin
+// runs fn with Process, loggers with spinner support override it
+func (w *formatWithNewLineLoggerWrapper) Spin(title string, fn func() error) error {
+	return w.parent.Process(ProcessDefault, title, fn)
+}
+
 func addLnToFormat(format string) string {
 	// remove last new line to avoid add double new lines
 	return trimLn(format) + "\n"
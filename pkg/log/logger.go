@@ -143,6 +143,23 @@ type baseLogger interface {
 	Write([]byte) (int, error)
 
 	ProcessLogger() ProcessLogger
+
+	// ProgressLogger
+	// returns logger for reporting progress of long operations
+	ProgressLogger() ProgressLogger
+
+	// WithFields
+	// returns logger which attaches key-value fields to all messages.
+	// Simple and JSON loggers emit fields as structured fields,
+	// another loggers add fields as suffix to message like: msg | fields: [key='value']
+	WithFields(fields map[string]any) Logger
+	// WithField
+	// like WithFields for one field
+	WithField(key string, value any) Logger
+	// WithPrefix
+	// returns logger which adds prefix to all messages and process titles like: prefix: msg
+	// nested prefixes are joined like: parent: child: msg
+	WithPrefix(prefix string) Logger
 }
 
 // formatWithNewLineLogger
@@ -170,19 +187,78 @@ type formatWithNewLineLogger interface {
 	// If you do not have \n to end of message please use WarnFWithoutLn
 	// Also trim last new line from format
 	WarnF(format string, a ...any)
+	// FatalF
+	// like ErrorF, after writing message calls cleanup hooks (see OnFatal),
+	// flushes and closes logger and exits with code set by SetFatalExitCode.
+	// panics with *FatalError instead of exit if panic mode is enabled (see SetFatalPanic)
+	FatalF(format string, a ...any)
 }
 
 type Logger interface {
 	formatWithNewLineLogger
 	baseLogger
+
+	// Spin
+	// runs fn as default process with title for steps without intermediate output
+	// like waiting for SSH or API availability.
+	// PrettyLogger shows animated spinner with elapsed time while fn is running if output is terminal,
+	// another loggers run fn with Process
+	Spin(title string, fn func() error) error
 }
 
 type LoggerOptions struct {
-	OutStream   io.Writer
+	OutStream io.Writer
+	// ErrStream
+	// used by PrettyLogger and SimpleLogger, errors, warnings and fails are written to ErrStream
+	// and another messages to OutStream, for example for keeping stdout clean for machine-readable data.
+	// if not set and OutStream is set, all messages are written to OutStream
+	ErrStream io.Writer
+	// Width
+	// used by PrettyLogger. If not set, width detected from terminal and updated on resize
+	// if OutStream is not terminal DefaultTerminalWidth used
 	Width       int
 	IsDebug     bool
 	DebugStream io.Writer
 
+	// ColorMode
+	// used by PrettyLogger and SimpleLogger with SimpleFormatText, ColorModeAuto by default
+	ColorMode ColorMode
+	// Theme
+	// used by PrettyLogger, default theme with emoji and frames if not set
+	Theme Theme
+	// SymbolMode
+	// used by PrettyLogger, SymbolModeAuto by default.
+	// with SymbolModeASCII emoji and frames are disabled in Theme
+	SymbolMode SymbolMode
+
+	// SimpleFormat
+	// used by SimpleLogger, SimpleFormatJSON by default
+	SimpleFormat SimpleFormat
+	// OperationID
+	// used by SimpleLogger, written to every record as operation field
+	OperationID string
+	// Fields
+	// used by SimpleLogger, static fields written to every record, for example cluster name or run id
+	Fields map[string]any
+
+	// WithTimestamps
+	// used by PrettyLogger and DummyLogger, add timestamp to start of every line like tee file does
+	WithTimestamps bool
+	// TimestampFormat
+	// time layout of timestamps, DefaultTimestampFormat if not set
+	TimestampFormat string
+
+	// WriteOptions
+	// used by PrettyLogger, configures level and splitting of content passed to Write
+	WriteOptions WriteOptions
+
+	// ConfigureLogboek
+	// used by PrettyLogger, called with logboek sub-logger after applying default settings
+	// for changing stream settings (styles, proxy stream data formatting, accepted level, etc.),
+	// for example for aligning output with werf-based tools.
+	// width is updated by PrettyLogger on terminal resize
+	ConfigureLogboek func(logger types.LoggerInterface)
+
 	AdditionalProcesses Processes
 }
 
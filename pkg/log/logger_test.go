@@ -0,0 +1,81 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrStream(t *testing.T) {
+	write := func(logger Logger) {
+		logger.InfoF("info message")
+		logger.Success("success message")
+		logger.WarnF("warn message")
+		logger.ErrorF("error message")
+		logger.Fail("fail message")
+		logger.FailRetry("retry message")
+	}
+
+	outMessages := []string{"info message", "success message"}
+	errMessages := []string{"warn message", "error message", "fail message", "retry message"}
+
+	for _, loggerType := range []Type{Pretty, Simple, JSON} {
+		t.Run(fmt.Sprintf("%s separated streams", loggerType), func(t *testing.T) {
+			out := &bytes.Buffer{}
+			errOut := &bytes.Buffer{}
+
+			logger, err := NewLoggerWithOptions(loggerType, LoggerOptions{
+				OutStream: out,
+				ErrStream: errOut,
+				ColorMode: ColorModeNever,
+			})
+			require.NoError(t, err)
+
+			write(logger.WithField("node", "master-0"))
+
+			for _, msg := range outMessages {
+				require.Contains(t, out.String(), msg)
+				require.NotContains(t, errOut.String(), msg)
+			}
+
+			for _, msg := range errMessages {
+				require.Contains(t, errOut.String(), msg)
+				require.NotContains(t, out.String(), msg)
+			}
+
+			require.Contains(t, errOut.String(), "master-0")
+		})
+
+		t.Run(fmt.Sprintf("%s one stream", loggerType), func(t *testing.T) {
+			out := &bytes.Buffer{}
+
+			logger, err := NewLoggerWithOptions(loggerType, LoggerOptions{
+				OutStream: out,
+				ColorMode: ColorModeNever,
+			})
+			require.NoError(t, err)
+
+			write(logger)
+
+			for _, msg := range append(outMessages, errMessages...) {
+				require.Contains(t, out.String(), msg)
+			}
+		})
+	}
+}
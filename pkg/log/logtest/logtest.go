@@ -0,0 +1,239 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logtest
+// contains InMemoryLogger wrapper with expectations api for tests:
+//
+//	logger := logtest.New()
+//	run(logger)
+//	logger.Expect(t, &log.Match{Prefix: []string{"Start"}}).Times(2)
+//	logger.ExpectNoMatch(t, &log.Match{Levels: []log.Level{log.LevelError}})
+//	logger.ExpectInOrder(t, startMatch, endMatch)
+package logtest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/deckhouse/lib-dhctl/pkg/log"
+)
+
+// Logger
+// InMemoryLogger with expectations, can be passed everywhere where log.Logger is expected
+type Logger struct {
+	*log.InMemoryLogger
+}
+
+func New() *Logger {
+	return Wrap(log.NewInMemoryLogger())
+}
+
+// Wrap
+// adds expectations to existing logger, for example logger with parent
+func Wrap(logger *log.InMemoryLogger) *Logger {
+	return &Logger{
+		InMemoryLogger: logger,
+	}
+}
+
+// Expectation
+// checks count of entries matched with Match, entries are got on Expect call
+type Expectation struct {
+	t       testing.TB
+	match   *log.Match
+	entries []log.Entry
+	matched []bool
+}
+
+// Expect
+// returns expectation for entries matched with m,
+// test is failed immediately if m is invalid
+func (l *Logger) Expect(t testing.TB, m *log.Match) *Expectation {
+	t.Helper()
+
+	if err := m.IsValid(); err != nil {
+		t.Fatalf("Invalid expectation: %v", err)
+		return nil
+	}
+
+	entries := l.Entries()
+	matched := make([]bool, len(entries))
+	for i, entry := range entries {
+		matched[i] = m.Matches(entry)
+	}
+
+	return &Expectation{
+		t:       t,
+		match:   m,
+		entries: entries,
+		matched: matched,
+	}
+}
+
+// ExpectNoMatch
+// fails test if any entry is matched with m
+func (l *Logger) ExpectNoMatch(t testing.TB, m *log.Match) {
+	t.Helper()
+
+	if e := l.Expect(t, m); e != nil {
+		e.Times(0)
+	}
+}
+
+// ExpectInOrder
+// fails test if entries matched with matches are not found in passed order,
+// every match should be matched with entry written after entry matched with previous match,
+// another entries between matched entries are allowed
+func (l *Logger) ExpectInOrder(t testing.TB, matches ...*log.Match) {
+	t.Helper()
+
+	for i, m := range matches {
+		if err := m.IsValid(); err != nil {
+			t.Fatalf("Invalid expectation %d: %v", i, err)
+			return
+		}
+	}
+
+	entries := l.Entries()
+	matched := make([]bool, len(entries))
+
+	next := 0
+	for i, m := range matches {
+		found := false
+
+		for ; next < len(entries); next++ {
+			if m.Matches(entries[next]) {
+				matched[next] = true
+				found = true
+				next++
+				break
+			}
+		}
+
+		if !found {
+			t.Fatalf(
+				"Expected entry matched with %d of %d expectations in order: %s\nafter previous expectations\n%s",
+				i+1,
+				len(matches),
+				describeMatch(m),
+				describeEntries(entries, matched),
+			)
+			return
+		}
+	}
+}
+
+// Times
+// fails test if count of matched entries is not equal n
+func (e *Expectation) Times(n int) {
+	e.t.Helper()
+
+	if count := e.count(); count != n {
+		e.fail(fmt.Sprintf("%d", n), count)
+	}
+}
+
+func (e *Expectation) Once() {
+	e.t.Helper()
+	e.Times(1)
+}
+
+// AtLeast
+// fails test if count of matched entries less than n
+func (e *Expectation) AtLeast(n int) {
+	e.t.Helper()
+
+	if count := e.count(); count < n {
+		e.fail(fmt.Sprintf("at least %d", n), count)
+	}
+}
+
+func (e *Expectation) count() int {
+	res := 0
+	for _, matched := range e.matched {
+		if matched {
+			res++
+		}
+	}
+
+	return res
+}
+
+func (e *Expectation) fail(expected string, count int) {
+	e.t.Helper()
+
+	e.t.Fatalf(
+		"Expected %s entries matched with %s, got %d\n%s",
+		expected,
+		describeMatch(e.match),
+		count,
+		describeEntries(e.entries, e.matched),
+	)
+}
+
+// describeMatch
+// returns match like: [levels=[error] prefix=["Start"]]
+func describeMatch(m *log.Match) string {
+	parts := make([]string, 0, 4)
+
+	if len(m.Levels) > 0 {
+		parts = append(parts, fmt.Sprintf("levels=%v", m.Levels))
+	}
+
+	if len(m.Prefix) > 0 {
+		parts = append(parts, fmt.Sprintf("prefix=%q", m.Prefix))
+	}
+
+	if len(m.Suffix) > 0 {
+		parts = append(parts, fmt.Sprintf("suffix=%q", m.Suffix))
+	}
+
+	if len(m.Regex) > 0 {
+		regexes := make([]string, 0, len(m.Regex))
+		for _, regex := range m.Regex {
+			regexes = append(regexes, regex.String())
+		}
+
+		parts = append(parts, fmt.Sprintf("regex=%q", regexes))
+	}
+
+	return fmt.Sprintf("[%s]", strings.Join(parts, " "))
+}
+
+// describeEntries
+// returns all entries, matched entries are marked with "+":
+//
+//	Entries:
+//	    0 info  "Start process: default/install"
+//	  + 1 error "failed\n"
+func describeEntries(entries []log.Entry, matched []bool) string {
+	if len(entries) == 0 {
+		return "Entries: <no entries>"
+	}
+
+	b := strings.Builder{}
+	b.WriteString("Entries:\n")
+
+	for i, entry := range entries {
+		marker := " "
+		if matched[i] {
+			marker = "+"
+		}
+
+		fmt.Fprintf(&b, "  %s %d %-5s %q\n", marker, i, entry.Level, entry.String())
+	}
+
+	return b.String()
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logtest
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/deckhouse/lib-dhctl/pkg/log"
+)
+
+type fakeT struct {
+	testing.TB
+
+	failures []string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Fatalf(format string, args ...any) {
+	t.failures = append(t.failures, fmt.Sprintf(format, args...))
+}
+
+func TestLogger(t *testing.T) {
+	logger := New()
+
+	_ = logger.Process(log.ProcessDefault, "install", func() error {
+		logger.InfoF("connecting to master-0")
+		logger.WarnF("slow connection")
+		logger.InfoF("connecting to master-1")
+		return nil
+	})
+
+	connecting := &log.Match{Prefix: []string{"connecting"}}
+	errors := &log.Match{Levels: []log.Level{log.LevelError}}
+
+	t.Run("expectations passed", func(t *testing.T) {
+		logger.Expect(t, connecting).Times(2)
+		logger.Expect(t, connecting).AtLeast(1)
+		logger.Expect(t, &log.Match{Regex: []*regexp.Regexp{regexp.MustCompile("slow")}}).Once()
+		logger.ExpectNoMatch(t, errors)
+		logger.ExpectInOrder(t,
+			&log.Match{Prefix: []string{"Start process"}},
+			connecting,
+			connecting,
+			&log.Match{Prefix: []string{"End process"}},
+		)
+	})
+
+	t.Run("times failed", func(t *testing.T) {
+		fake := &fakeT{}
+		logger.Expect(fake, connecting).Times(3)
+
+		require.Len(t, fake.failures, 1)
+		require.Equal(t, `Expected 3 entries matched with [prefix=["connecting"]], got 2
+Entries:
+    0 info  "Start process: default/install"
+  + 1 info  "connecting to master-0\n"
+    2 warn  "slow connection\n"
+  + 3 info  "connecting to master-1\n"
+    4 info  "End process: default/install"
+`, fake.failures[0])
+	})
+
+	t.Run("no match failed", func(t *testing.T) {
+		fake := &fakeT{}
+		logger.ExpectNoMatch(fake, &log.Match{Levels: []log.Level{log.LevelWarn}})
+
+		require.Len(t, fake.failures, 1)
+		require.Contains(t, fake.failures[0], "Expected 0 entries matched with [levels=[warn]], got 1")
+		require.Contains(t, fake.failures[0], `  + 2 warn  "slow connection\n"`)
+	})
+
+	t.Run("order failed", func(t *testing.T) {
+		fake := &fakeT{}
+		logger.ExpectInOrder(fake,
+			&log.Match{Suffix: []string{"slow connection\n"}},
+			&log.Match{Suffix: []string{"master-0\n"}},
+		)
+
+		require.Len(t, fake.failures, 1)
+		require.Contains(t, fake.failures[0], `Expected entry matched with 2 of 2 expectations in order: [suffix=["master-0\n"]]`)
+		require.Contains(t, fake.failures[0], `  + 2 warn  "slow connection\n"`)
+	})
+
+	t.Run("invalid match", func(t *testing.T) {
+		fake := &fakeT{}
+		logger.ExpectNoMatch(fake, &log.Match{})
+
+		require.Len(t, fake.failures, 1)
+		require.Contains(t, fake.failures[0], "Invalid expectation")
+	})
+}
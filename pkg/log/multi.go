@@ -0,0 +1,352 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/name212/govalue"
+)
+
+var (
+	_ baseLogger              = &MultiLogger{}
+	_ formatWithNewLineLogger = &MultiLogger{}
+	_ Logger                  = &MultiLogger{}
+	_ io.Writer               = &MultiLogger{}
+)
+
+// MultiLogger
+// duplicates every call to all target loggers in order of passing.
+// targets are isolated: panic or error of one target does not prevent writing to another targets,
+// panics in methods without result are skipped, errors of FlushAndClose and Write are joined.
+// first target is primary: SilentLogger and BufferLogger are provided by it
+type MultiLogger struct {
+	*formatWithNewLineLoggerWrapper
+
+	targets []Logger
+}
+
+// NewMultiLogger
+// nil loggers are skipped, if all loggers are nil SilentLogger is used as target
+func NewMultiLogger(loggers ...Logger) *MultiLogger {
+	targets := make([]Logger, 0, len(loggers))
+	for _, logger := range loggers {
+		if !govalue.IsNil(logger) {
+			targets = append(targets, logger)
+		}
+	}
+
+	if len(targets) == 0 {
+		targets = append(targets, NewSilentLogger())
+	}
+
+	return newMultiLogger(targets)
+}
+
+func newMultiLogger(targets []Logger) *MultiLogger {
+	l := &MultiLogger{
+		targets: targets,
+	}
+
+	l.formatWithNewLineLoggerWrapper = newFormatWithNewLineLoggerWrapper(l)
+
+	return l
+}
+
+// Targets
+// returns copy of target loggers
+func (l *MultiLogger) Targets() []Logger {
+	return append([]Logger(nil), l.targets...)
+}
+
+func (l *MultiLogger) WithFields(fields map[string]any) Logger {
+	return l.derive(func(target Logger) Logger {
+		return target.WithFields(fields)
+	})
+}
+
+func (l *MultiLogger) WithField(key string, value any) Logger {
+	return l.WithFields(map[string]any{key: value})
+}
+
+func (l *MultiLogger) WithPrefix(prefix string) Logger {
+	return newPrefixLogger(l, prefix)
+}
+
+func (l *MultiLogger) ProcessLogger() ProcessLogger {
+	res := &multiProcessLogger{
+		targets: make([]ProcessLogger, 0, len(l.targets)),
+	}
+
+	for _, target := range l.targets {
+		res.targets = append(res.targets, target.ProcessLogger())
+	}
+
+	return res
+}
+
+func (l *MultiLogger) ProgressLogger() ProgressLogger {
+	res := &multiProgressLogger{
+		targets: make([]ProgressLogger, 0, len(l.targets)),
+	}
+
+	for _, target := range l.targets {
+		res.targets = append(res.targets, target.ProgressLogger())
+	}
+
+	return res
+}
+
+func (l *MultiLogger) SilentLogger() *SilentLogger {
+	return l.targets[0].SilentLogger()
+}
+
+// BufferLogger
+// returns buffer logger of primary target, because another targets
+// would duplicate messages in the same buffer
+func (l *MultiLogger) BufferLogger(buffer *bytes.Buffer) Logger {
+	return l.targets[0].BufferLogger(buffer)
+}
+
+func (l *MultiLogger) FlushAndClose() error {
+	errs := make([]error, 0)
+
+	for i, target := range l.targets {
+		err := callIsolated(func() error {
+			return target.FlushAndClose()
+		})
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("Cannot flush and close logger %d: %w", i, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Process
+// run is called once, processes of targets are nested in order of targets
+func (l *MultiLogger) Process(p Process, t string, run func() error) error {
+	action := run
+
+	for i := len(l.targets) - 1; i >= 0; i-- {
+		target := l.targets[i]
+		next := action
+
+		action = func() error {
+			return target.Process(p, t, next)
+		}
+	}
+
+	return action()
+}
+
+func (l *MultiLogger) InfoFWithoutLn(format string, a ...interface{}) {
+	l.each(func(target Logger) {
+		target.InfoFWithoutLn(format, a...)
+	})
+}
+
+// InfoLn
+// Deprecated:
+// Use InfoF(string) it add \n to end
+func (l *MultiLogger) InfoLn(a ...interface{}) {
+	l.each(func(target Logger) {
+		target.InfoLn(a...)
+	})
+}
+
+func (l *MultiLogger) ErrorFWithoutLn(format string, a ...interface{}) {
+	l.each(func(target Logger) {
+		target.ErrorFWithoutLn(format, a...)
+	})
+}
+
+// ErrorLn
+// Deprecated:
+// Use ErrorF(string) it add \n to end
+func (l *MultiLogger) ErrorLn(a ...interface{}) {
+	l.each(func(target Logger) {
+		target.ErrorLn(a...)
+	})
+}
+
+func (l *MultiLogger) DebugFWithoutLn(format string, a ...interface{}) {
+	l.each(func(target Logger) {
+		target.DebugFWithoutLn(format, a...)
+	})
+}
+
+// DebugLn
+// Deprecated:
+// Use DebugF(string) it add \n to end
+func (l *MultiLogger) DebugLn(a ...interface{}) {
+	l.each(func(target Logger) {
+		target.DebugLn(a...)
+	})
+}
+
+func (l *MultiLogger) WarnFWithoutLn(format string, a ...interface{}) {
+	l.each(func(target Logger) {
+		target.WarnFWithoutLn(format, a...)
+	})
+}
+
+// WarnLn
+// Deprecated:
+// Use WarnF(string) it add \n to end
+func (l *MultiLogger) WarnLn(a ...interface{}) {
+	l.each(func(target Logger) {
+		target.WarnLn(a...)
+	})
+}
+
+func (l *MultiLogger) Success(s string) {
+	l.each(func(target Logger) {
+		target.Success(s)
+	})
+}
+
+func (l *MultiLogger) Fail(s string) {
+	l.each(func(target Logger) {
+		target.Fail(s)
+	})
+}
+
+func (l *MultiLogger) FailRetry(s string) {
+	l.each(func(target Logger) {
+		target.FailRetry(s)
+	})
+}
+
+func (l *MultiLogger) JSON(content []byte) {
+	l.each(func(target Logger) {
+		target.JSON(content)
+	})
+}
+
+// Write
+// always returns len(content), errors of targets are joined
+func (l *MultiLogger) Write(content []byte) (int, error) {
+	errs := make([]error, 0)
+
+	for i, target := range l.targets {
+		err := callIsolated(func() error {
+			_, err := target.Write(content)
+			return err
+		})
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("Cannot write to logger %d: %w", i, err))
+		}
+	}
+
+	return len(content), errors.Join(errs...)
+}
+
+func (l *MultiLogger) each(f func(target Logger)) {
+	for _, target := range l.targets {
+		_ = callIsolated(func() error {
+			f(target)
+			return nil
+		})
+	}
+}
+
+func (l *MultiLogger) derive(f func(target Logger) Logger) *MultiLogger {
+	targets := make([]Logger, 0, len(l.targets))
+	for _, target := range l.targets {
+		targets = append(targets, f(target))
+	}
+
+	return newMultiLogger(targets)
+}
+
+// callIsolated
+// returns panic of f as error
+func callIsolated(f func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("Logger panicked: %v", r)
+		}
+	}()
+
+	return f()
+}
+
+type multiProcessLogger struct {
+	targets []ProcessLogger
+}
+
+func (l *multiProcessLogger) ProcessStart(name string) {
+	l.each(func(target ProcessLogger) {
+		target.ProcessStart(name)
+	})
+}
+
+func (l *multiProcessLogger) ProcessFail() {
+	l.each(func(target ProcessLogger) {
+		target.ProcessFail()
+	})
+}
+
+func (l *multiProcessLogger) ProcessEnd() {
+	l.each(func(target ProcessLogger) {
+		target.ProcessEnd()
+	})
+}
+
+func (l *multiProcessLogger) each(f func(target ProcessLogger)) {
+	for _, target := range l.targets {
+		_ = callIsolated(func() error {
+			f(target)
+			return nil
+		})
+	}
+}
+
+type multiProgressLogger struct {
+	targets []ProgressLogger
+}
+
+func (l *multiProgressLogger) StartProgress(total int) {
+	l.each(func(target ProgressLogger) {
+		target.StartProgress(total)
+	})
+}
+
+func (l *multiProgressLogger) Increment(msg string) {
+	l.each(func(target ProgressLogger) {
+		target.Increment(msg)
+	})
+}
+
+func (l *multiProgressLogger) Done() {
+	l.each(func(target ProgressLogger) {
+		target.Done()
+	})
+}
+
+func (l *multiProgressLogger) each(f func(target ProgressLogger)) {
+	for _, target := range l.targets {
+		_ = callIsolated(func() error {
+			f(target)
+			return nil
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiLogger(t *testing.T) {
+	t.Run("duplicates calls", func(t *testing.T) {
+		first := NewInMemoryLogger()
+		second := NewInMemoryLogger()
+		logger := NewMultiLogger(first, nil, second)
+
+		require.Len(t, logger.Targets(), 2)
+
+		logger.InfoF("info")
+		logger.WithField("node", "master-0").WarnF("warn")
+		logger.WithPrefix("ssh").ErrorF("error")
+
+		runs := 0
+		err := logger.Process(ProcessDefault, "process", func() error {
+			runs++
+			logger.Success("done")
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, runs)
+
+		expected := []string{
+			"info\n",
+			"warn | fields: [node='master-0']\n",
+			"ssh: error\n",
+			"Start process: default/process",
+			"Success: done",
+			"End process: default/process",
+		}
+
+		for _, target := range []*InMemoryLogger{first, second} {
+			entries := make([]string, 0)
+			for _, entry := range target.Entries() {
+				entries = append(entries, entry.String())
+			}
+
+			require.Equal(t, expected, entries)
+		}
+	})
+
+	t.Run("process error", func(t *testing.T) {
+		first := NewInMemoryLogger()
+		second := NewInMemoryLogger()
+
+		err := NewMultiLogger(first, second).Process(ProcessDefault, "process", func() error {
+			return errors.New("failed")
+		})
+		require.ErrorContains(t, err, "failed")
+	})
+
+	t.Run("failure isolation", func(t *testing.T) {
+		target := NewInMemoryLogger()
+		logger := NewMultiLogger(&testPanicLogger{NewSilentLogger()}, target)
+
+		require.NotPanics(t, func() {
+			logger.InfoFWithoutLn("info\n")
+		})
+
+		_, err := target.FirstMatch(&Match{Prefix: []string{"info"}})
+		require.NoError(t, err)
+
+		err = logger.FlushAndClose()
+		require.ErrorContains(t, err, "Cannot flush and close logger 0: Logger panicked: flush")
+	})
+
+	t.Run("write errors are joined", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := NewMultiLogger(NewSimpleLogger(LoggerOptions{OutStream: buf}), &testPanicLogger{NewSilentLogger()})
+
+		n, err := logger.Write([]byte("content"))
+		require.Equal(t, 7, n)
+		require.ErrorContains(t, err, "Cannot write to logger 1")
+		require.Contains(t, buf.String(), "content")
+	})
+
+	t.Run("without targets", func(t *testing.T) {
+		logger := NewMultiLogger()
+		require.Len(t, logger.Targets(), 1)
+		require.NoError(t, logger.FlushAndClose())
+	})
+
+	t.Run("follow interfaces", func(t *testing.T) {
+		assertFollowAllInterfaces(t, NewMultiLogger(NewInMemoryLogger(), NewDummyLogger(true)))
+	})
+}
+
+func TestMultiProvider(t *testing.T) {
+	first := NewInMemoryLogger()
+	second := NewInMemoryLogger()
+
+	logger := MultiProvider(SimpleLoggerProvider(first), nil, SimpleLoggerProvider(nil), SimpleLoggerProvider(second))()
+	require.IsType(t, &MultiLogger{}, logger)
+	require.Len(t, logger.(*MultiLogger).Targets(), 2)
+
+	require.Same(t, first, MultiProvider(SimpleLoggerProvider(first), nil)())
+	require.Same(t, silentLoggerInstance, MultiProvider()())
+}
+
+type testPanicLogger struct {
+	Logger
+}
+
+func (l *testPanicLogger) InfoFWithoutLn(string, ...interface{}) {
+	panic("info")
+}
+
+func (l *testPanicLogger) FlushAndClose() error {
+	panic("flush")
+}
+
+func (l *testPanicLogger) Write([]byte) (int, error) {
+	return 0, errors.New("write")
+}
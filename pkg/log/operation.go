@@ -0,0 +1,63 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"maps"
+)
+
+// operationIDValue
+// operation id is passed through WithFields of wrapping loggers (tee, multi, stats, etc.)
+// as value of JSONFieldOperation field, loggers which support operations extract it from fields
+type operationIDValue string
+
+// WithOperationID
+// returns logger which stamps operation id on every record for separating concurrent operations in shared sinks:
+// operation field for SimpleLogger and EventLogger, Entry.OperationID for InMemoryLogger
+// and prefix for loggers without structured output like PrettyLogger.
+// new id is generated with NewOperationID if id is empty
+func WithOperationID(logger Logger, id string) Logger {
+	if id == "" {
+		id = NewOperationID()
+	}
+
+	return logger.WithFields(map[string]any{JSONFieldOperation: operationIDValue(id)})
+}
+
+// NewOperationID
+// returns random id like: 3f2a9c0d7b1e4a65
+func NewOperationID() string {
+	id := make([]byte, 8)
+	_, _ = rand.Read(id)
+
+	return hex.EncodeToString(id)
+}
+
+// extractOperationID
+// returns fields without operation id added by WithOperationID and operation id.
+// returns fields as is and empty id if fields do not contain operation id
+func extractOperationID(fields map[string]any) (map[string]any, string) {
+	id, ok := fields[JSONFieldOperation].(operationIDValue)
+	if !ok {
+		return fields, ""
+	}
+
+	res := maps.Clone(fields)
+	delete(res, JSONFieldOperation)
+
+	return res, string(id)
+}
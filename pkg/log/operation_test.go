@@ -0,0 +1,99 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithOperationID(t *testing.T) {
+	t.Run("concurrent operations in shared sinks", func(t *testing.T) {
+		jsonBuf := &bytes.Buffer{}
+		textBuf := &bytes.Buffer{}
+		memory := NewInMemoryLogger()
+
+		shared := NewMultiLogger(
+			NewJSONLogger(LoggerOptions{OutStream: jsonBuf}),
+			NewDummyLoggerWithOptions(LoggerOptions{OutStream: textBuf}),
+			memory,
+		)
+
+		wg := sync.WaitGroup{}
+		for _, id := range []string{"op-1", "op-2"} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				logger := WithOperationID(shared, id)
+				logger.WithField("node", "master-0").InfoF("message from %s", id)
+			}()
+		}
+
+		wg.Wait()
+
+		scanner := bufio.NewScanner(jsonBuf)
+		operations := make(map[string]string)
+		for scanner.Scan() {
+			record := make(map[string]any)
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+			require.Equal(t, "master-0", record["node"])
+			operations[record[JSONFieldOperation].(string)] = record[JSONFieldMessage].(string)
+		}
+
+		require.Equal(t, map[string]string{
+			"op-1": "message from op-1\n",
+			"op-2": "message from op-2\n",
+		}, operations)
+
+		require.Contains(t, textBuf.String(), "op-1: message from op-1 | fields: [node='master-0']\n")
+		require.Contains(t, textBuf.String(), "op-2: message from op-2 | fields: [node='master-0']\n")
+
+		entries := memory.Entries()
+		require.Len(t, entries, 2)
+		for _, entry := range entries {
+			require.Equal(t, "message from "+entry.OperationID+"\n", entry.Message)
+			require.Equal(t, map[string]any{"node": "master-0"}, entry.Fields)
+		}
+	})
+
+	t.Run("generate id if absent", func(t *testing.T) {
+		memory := NewInMemoryLogger()
+
+		WithOperationID(memory, "").InfoF("first")
+		WithOperationID(memory, "").InfoF("second")
+
+		entries := memory.Entries()
+		require.Len(t, entries, 2)
+		require.Len(t, entries[0].OperationID, 16)
+		require.NotEqual(t, entries[0].OperationID, entries[1].OperationID)
+	})
+
+	t.Run("json logger keeps operation field for buffer logger", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+
+		logger := WithOperationID(NewJSONLogger(LoggerOptions{OperationID: "parent"}), "child")
+		logger.BufferLogger(buf).InfoF("message")
+
+		record := make(map[string]any)
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+		require.Equal(t, "child", record[JSONFieldOperation])
+	})
+}
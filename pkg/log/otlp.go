@@ -0,0 +1,529 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	_ baseLogger              = &OTLPLogger{}
+	_ formatWithNewLineLogger = &OTLPLogger{}
+	_ Logger                  = &OTLPLogger{}
+	_ io.WriteCloser          = &OTLPLogger{}
+)
+
+const (
+	otlpLogsPath          = "/v1/logs"
+	otlpScopeName         = "github.com/deckhouse/lib-dhctl/pkg/log"
+	defaultOTLPBatchSize  = 512
+	defaultOTLPTimeout    = 10 * time.Second
+	otlpSeverityDebug     = 5
+	otlpSeverityInfo      = 9
+	otlpSeverityWarn      = 13
+	otlpSeverityError     = 17
+	otlpServiceNameKey    = "service.name"
+	defaultOTLPService    = "dhctl"
+	otlpProcessAttrKey    = "dhctl.process"
+	otlpProcessAttrErrKey = "error"
+
+	otlpProcessDurationAttrKey = "dhctl.process.duration_seconds"
+)
+
+type OTLPOptions struct {
+	// Endpoint
+	// OTLP/HTTP collector url like http://collector:4318
+	// if path is empty /v1/logs is used
+	Endpoint string
+	// Headers
+	// added to every export request, for example for authorization
+	Headers map[string]string
+	// ResourceAttributes
+	// attributes of resource, service.name is dhctl if not passed
+	ResourceAttributes map[string]string
+	// BatchSize
+	// records are exported when batch is full and in Flush and FlushAndClose
+	BatchSize int
+	// Client
+	// http client for export, client with 10s timeout is used if not passed
+	Client  *http.Client
+	IsDebug bool
+}
+
+// OTLPLogger
+// exports records with OTLP/HTTP protocol with json encoding to OpenTelemetry collector
+// records are batched and exported in caller goroutine,
+// wrap logger with NewAsyncLogger for not blocking caller
+// logger follows io.WriteCloser and can be used as TeeLogger output,
+// in this case every line is exported as info record
+// export errors are returned from Flush and FlushAndClose
+type OTLPLogger struct {
+	*formatWithNewLineLoggerWrapper
+
+	exporter *otlpExporter
+	fields   map[string]any
+	isDebug  bool
+}
+
+func NewOTLPLogger(opts OTLPOptions) (*OTLPLogger, error) {
+	exporter, err := newOTLPExporter(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newOTLPLoggerWithExporter(exporter, nil, opts.IsDebug), nil
+}
+
+func newOTLPLoggerWithExporter(exporter *otlpExporter, fields map[string]any, isDebug bool) *OTLPLogger {
+	l := &OTLPLogger{
+		exporter: exporter,
+		fields:   fields,
+		isDebug:  isDebug,
+	}
+
+	l.formatWithNewLineLoggerWrapper = newFormatWithNewLineLoggerWrapper(l)
+
+	return l
+}
+
+func (d *OTLPLogger) ProcessLogger() ProcessLogger {
+	return newWrappedProcessLogger(d)
+}
+
+func (d *OTLPLogger) ProgressLogger() ProgressLogger {
+	return newWrappedProgressLogger(d)
+}
+
+func (d *OTLPLogger) SilentLogger() *SilentLogger {
+	return NewSilentLogger()
+}
+
+func (d *OTLPLogger) BufferLogger(buffer *bytes.Buffer) Logger {
+	return NewSimpleLogger(LoggerOptions{OutStream: buffer, IsDebug: d.isDebug}).WithFields(d.fields)
+}
+
+// WithFields
+// fields are exported as record attributes
+func (d *OTLPLogger) WithFields(fields map[string]any) Logger {
+	return newOTLPLoggerWithExporter(d.exporter, mergeFields(d.fields, fields), d.isDebug)
+}
+
+func (d *OTLPLogger) WithField(key string, value any) Logger {
+	return d.WithFields(map[string]any{key: value})
+}
+
+func (d *OTLPLogger) WithPrefix(prefix string) Logger {
+	return newPrefixLogger(d, prefix)
+}
+
+// Flush
+// exports all batched records
+func (d *OTLPLogger) Flush() error {
+	return d.exporter.flush()
+}
+
+// FlushAndClose
+// exports all batched records, records added after close are dropped
+func (d *OTLPLogger) FlushAndClose() error {
+	return d.exporter.close()
+}
+
+// Close
+// for using as TeeLogger output
+func (d *OTLPLogger) Close() error {
+	return d.FlushAndClose()
+}
+
+func (d *OTLPLogger) Process(p Process, t string, run func() error) error {
+	fields := mergeFields(d.fields, map[string]any{otlpProcessAttrKey: string(p)})
+
+	d.exporter.add(otlpSeverityInfo, fmt.Sprintf("Start process %s", t), fields)
+
+	duration, err := runProcess(p, t, run)
+	fields[otlpProcessDurationAttrKey] = duration.Seconds()
+
+	if err != nil {
+		fields[otlpProcessAttrErrKey] = err.Error()
+		d.exporter.add(otlpSeverityError, fmt.Sprintf("Process %s failed %s", t, formatProcessDuration(duration)), fields)
+		return err
+	}
+
+	d.exporter.add(otlpSeverityInfo, fmt.Sprintf("End process %s %s", t, formatProcessDuration(duration)), fields)
+
+	return nil
+}
+
+func (d *OTLPLogger) InfoFWithoutLn(format string, a ...interface{}) {
+	d.exporter.add(otlpSeverityInfo, sprintf(format, a...), d.fields)
+}
+
+// InfoLn
+// Deprecated:
+// Use InfoF(string) it add \n to end
+func (d *OTLPLogger) InfoLn(a ...interface{}) {
+	d.exporter.add(otlpSeverityInfo, fmt.Sprintln(a...), d.fields)
+}
+
+func (d *OTLPLogger) ErrorFWithoutLn(format string, a ...interface{}) {
+	d.exporter.add(otlpSeverityError, sprintf(format, a...), d.fields)
+}
+
+// ErrorLn
+// Deprecated:
+// Use ErrorF(string) it add \n to end
+func (d *OTLPLogger) ErrorLn(a ...interface{}) {
+	d.exporter.add(otlpSeverityError, fmt.Sprintln(a...), d.fields)
+}
+
+func (d *OTLPLogger) DebugFWithoutLn(format string, a ...interface{}) {
+	if d.isDebug {
+		d.exporter.add(otlpSeverityDebug, sprintf(format, a...), d.fields)
+	}
+}
+
+// DebugLn
+// Deprecated:
+// Use DebugF(string) it add \n to end
+func (d *OTLPLogger) DebugLn(a ...interface{}) {
+	if d.isDebug {
+		d.exporter.add(otlpSeverityDebug, fmt.Sprintln(a...), d.fields)
+	}
+}
+
+func (d *OTLPLogger) WarnFWithoutLn(format string, a ...interface{}) {
+	d.exporter.add(otlpSeverityWarn, sprintf(format, a...), d.fields)
+}
+
+// WarnLn
+// Deprecated:
+// Use WarnF(string) it add \n to end
+func (d *OTLPLogger) WarnLn(a ...interface{}) {
+	d.exporter.add(otlpSeverityWarn, fmt.Sprintln(a...), d.fields)
+}
+
+func (d *OTLPLogger) Success(l string) {
+	d.exporter.add(otlpSeverityInfo, l, d.fields)
+}
+
+func (d *OTLPLogger) Fail(l string) {
+	d.exporter.add(otlpSeverityError, l, d.fields)
+}
+
+func (d *OTLPLogger) FailRetry(l string) {
+	d.exporter.add(otlpSeverityWarn, l, d.fields)
+}
+
+func (d *OTLPLogger) JSON(content []byte) {
+	d.exporter.add(otlpSeverityInfo, string(content), d.fields)
+}
+
+// Write
+// every line is exported as info record
+// last line without new line is kept until next Write or Flush
+func (d *OTLPLogger) Write(content []byte) (int, error) {
+	d.exporter.write(content, d.fields)
+	return len(content), nil
+}
+
+type otlpExporter struct {
+	mu sync.Mutex
+
+	url       string
+	headers   map[string]string
+	resource  []otlpAttribute
+	batchSize int
+	client    *http.Client
+
+	records []otlpLogRecord
+	partial []byte
+	closed  bool
+	err     error
+}
+
+func newOTLPExporter(opts OTLPOptions) (*otlpExporter, error) {
+	u, err := url.Parse(opts.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse OTLP endpoint: %w", err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("OTLP endpoint should be http or https url, got %q", opts.Endpoint)
+	}
+
+	if u.Path == "" || u.Path == "/" {
+		u.Path = otlpLogsPath
+	}
+
+	resource := map[string]any{otlpServiceNameKey: defaultOTLPService}
+	for k, v := range opts.ResourceAttributes {
+		resource[k] = v
+	}
+
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultOTLPBatchSize
+	}
+
+	client := opts.Client
+	if client == nil {
+		client = &http.Client{Timeout: defaultOTLPTimeout}
+	}
+
+	return &otlpExporter{
+		url:       u.String(),
+		headers:   opts.Headers,
+		resource:  otlpAttributes(resource),
+		batchSize: batchSize,
+		client:    client,
+		records:   make([]otlpLogRecord, 0, batchSize),
+	}, nil
+}
+
+func (e *otlpExporter) add(severity int, msg string, fields map[string]any) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.addRecord(severity, msg, fields)
+}
+
+func (e *otlpExporter) write(content []byte, fields map[string]any) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.partial = append(e.partial, content...)
+
+	for {
+		i := bytes.IndexByte(e.partial, '\n')
+		if i < 0 {
+			return
+		}
+
+		e.addRecord(otlpSeverityInfo, string(e.partial[:i]), fields)
+		e.partial = e.partial[i+1:]
+	}
+}
+
+func (e *otlpExporter) addRecord(severity int, msg string, fields map[string]any) {
+	msg = strings.TrimRight(maskSecrets(msg), "\n")
+	if e.closed || msg == "" {
+		return
+	}
+
+	e.records = append(e.records, otlpLogRecord{
+		TimeUnixNano:   strconv.FormatInt(time.Now().UnixNano(), 10),
+		SeverityNumber: severity,
+		SeverityText:   otlpSeverityText(severity),
+		Body:           otlpValue{StringValue: &msg},
+		Attributes:     otlpAttributes(fields),
+	})
+
+	if len(e.records) >= e.batchSize {
+		e.export()
+	}
+}
+
+func (e *otlpExporter) flush() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.flushLocked()
+}
+
+func (e *otlpExporter) close() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.closed {
+		return nil
+	}
+
+	err := e.flushLocked()
+	e.closed = true
+
+	return err
+}
+
+func (e *otlpExporter) flushLocked() error {
+	if len(e.partial) > 0 {
+		msg := string(e.partial)
+		e.partial = nil
+		e.addRecord(otlpSeverityInfo, msg, nil)
+	}
+
+	e.export()
+
+	err := e.err
+	e.err = nil
+
+	return err
+}
+
+// export
+// sends batched records, records are dropped on error
+// error is kept for returning in flush
+func (e *otlpExporter) export() {
+	if len(e.records) == 0 {
+		return
+	}
+
+	records := e.records
+	e.records = make([]otlpLogRecord, 0, e.batchSize)
+
+	if err := e.send(records); err != nil {
+		e.err = errors.Join(e.err, err)
+	}
+}
+
+func (e *otlpExporter) send(records []otlpLogRecord) error {
+	body, err := json.Marshal(otlpExportRequest{
+		ResourceLogs: []otlpResourceLogs{
+			{
+				Resource: otlpResource{Attributes: e.resource},
+				ScopeLogs: []otlpScopeLogs{
+					{
+						Scope:      otlpScope{Name: otlpScopeName},
+						LogRecords: records,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Cannot marshal OTLP request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("Cannot create OTLP request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range e.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("Cannot export %d records to OTLP collector: %w", len(records), err)
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("Cannot export %d records to OTLP collector: unexpected status %s", len(records), resp.Status)
+	}
+
+	return nil
+}
+
+func otlpSeverityText(severity int) string {
+	switch severity {
+	case otlpSeverityDebug:
+		return "DEBUG"
+	case otlpSeverityWarn:
+		return "WARN"
+	case otlpSeverityError:
+		return "ERROR"
+	default:
+		return "INFO"
+	}
+}
+
+func otlpAttributes(fields map[string]any) []otlpAttribute {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	res := make([]otlpAttribute, 0, len(fields))
+	for _, key := range sortedFieldsKeys(fields) {
+		res = append(res, otlpAttribute{Key: key, Value: newOTLPValue(fields[key])})
+	}
+
+	return res
+}
+
+func newOTLPValue(v any) otlpValue {
+	switch typed := v.(type) {
+	case bool:
+		return otlpValue{BoolValue: &typed}
+	case int:
+		s := strconv.Itoa(typed)
+		return otlpValue{IntValue: &s}
+	case int64:
+		s := strconv.FormatInt(typed, 10)
+		return otlpValue{IntValue: &s}
+	case float64:
+		return otlpValue{DoubleValue: &typed}
+	default:
+		s := maskSecrets(fmt.Sprint(v))
+		return otlpValue{StringValue: &s}
+	}
+}
+
+// types below are json representation of OTLP ExportLogsServiceRequest
+// https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding
+
+type otlpExportRequest struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+type otlpResourceLogs struct {
+	Resource  otlpResource    `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes,omitempty"`
+}
+
+type otlpScopeLogs struct {
+	Scope      otlpScope       `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano   string          `json:"timeUnixNano"`
+	SeverityNumber int             `json:"severityNumber"`
+	SeverityText   string          `json:"severityText"`
+	Body           otlpValue       `json:"body"`
+	Attributes     []otlpAttribute `json:"attributes,omitempty"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
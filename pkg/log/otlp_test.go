@@ -0,0 +1,192 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testOTLPCollector struct {
+	mu       sync.Mutex
+	requests []otlpExportRequest
+	headers  []http.Header
+	paths    []string
+	status   int
+}
+
+func newTestOTLPCollector(t *testing.T) (*testOTLPCollector, *httptest.Server) {
+	collector := &testOTLPCollector{status: http.StatusOK}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		collector.mu.Lock()
+		defer collector.mu.Unlock()
+
+		var req otlpExportRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		collector.requests = append(collector.requests, req)
+		collector.headers = append(collector.headers, r.Header.Clone())
+		collector.paths = append(collector.paths, r.URL.Path)
+
+		w.WriteHeader(collector.status)
+	}))
+
+	t.Cleanup(server.Close)
+
+	return collector, server
+}
+
+func (c *testOTLPCollector) records() []otlpLogRecord {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res := make([]otlpLogRecord, 0)
+	for _, req := range c.requests {
+		for _, rl := range req.ResourceLogs {
+			for _, sl := range rl.ScopeLogs {
+				res = append(res, sl.LogRecords...)
+			}
+		}
+	}
+
+	return res
+}
+
+func (c *testOTLPCollector) bodies() []string {
+	res := make([]string, 0)
+	for _, r := range c.records() {
+		res = append(res, testTookRegex.ReplaceAllString(*r.Body.StringValue, "(took X)"))
+	}
+
+	return res
+}
+
+func TestOTLPLoggerFollowInterfaces(t *testing.T) {
+	_, server := newTestOTLPCollector(t)
+
+	logger, err := NewOTLPLogger(OTLPOptions{Endpoint: server.URL, IsDebug: true})
+	require.NoError(t, err)
+
+	assertFollowAllInterfaces(t, logger)
+	require.NoError(t, logger.FlushAndClose())
+}
+
+func TestOTLPLogger(t *testing.T) {
+	t.Run("invalid endpoint", func(t *testing.T) {
+		_, err := NewOTLPLogger(OTLPOptions{Endpoint: "collector:4318"})
+		require.Error(t, err)
+	})
+
+	t.Run("export records", func(t *testing.T) {
+		collector, server := newTestOTLPCollector(t)
+
+		logger, err := NewOTLPLogger(OTLPOptions{
+			Endpoint:           server.URL,
+			Headers:            map[string]string{"Authorization": "Bearer token"},
+			ResourceAttributes: map[string]string{"host.name": "master-0"},
+			BatchSize:          3,
+		})
+		require.NoError(t, err)
+
+		logger.InfoF("info message")
+		logger.DebugF("debug message")
+		logger.WarnF("warn message")
+		logger.WithFields(map[string]any{"node": "master-0", "attempt": 2, "ok": true}).ErrorF("error message")
+
+		// batch is full
+		require.Len(t, collector.records(), 3)
+
+		err = logger.Process(ProcessBootstrap, "Bootstrap", func() error {
+			return nil
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, logger.FlushAndClose())
+
+		logger.InfoF("after close")
+		require.NoError(t, logger.Flush())
+
+		require.Equal(t, []string{
+			"info message",
+			"warn message",
+			"error message",
+			"Start process Bootstrap",
+			"End process Bootstrap (took X)",
+		}, collector.bodies())
+
+		records := collector.records()
+		require.Equal(t, otlpSeverityInfo, records[0].SeverityNumber)
+		require.Equal(t, "INFO", records[0].SeverityText)
+		require.NotEmpty(t, records[0].TimeUnixNano)
+		require.Equal(t, otlpSeverityWarn, records[1].SeverityNumber)
+		require.Equal(t, otlpSeverityError, records[2].SeverityNumber)
+
+		attrs := records[2].Attributes
+		require.Len(t, attrs, 3)
+		require.Equal(t, "attempt", attrs[0].Key)
+		require.Equal(t, "2", *attrs[0].Value.IntValue)
+		require.Equal(t, "node", attrs[1].Key)
+		require.Equal(t, "master-0", *attrs[1].Value.StringValue)
+		require.True(t, *attrs[2].Value.BoolValue)
+
+		require.Equal(t, "dhctl.process", records[3].Attributes[0].Key)
+
+		require.Equal(t, "/v1/logs", collector.paths[0])
+		require.Equal(t, "Bearer token", collector.headers[0].Get("Authorization"))
+		require.Equal(t, "application/json", collector.headers[0].Get("Content-Type"))
+
+		resource := collector.requests[0].ResourceLogs[0].Resource.Attributes
+		require.Len(t, resource, 2)
+		require.Equal(t, "host.name", resource[0].Key)
+		require.Equal(t, "service.name", resource[1].Key)
+		require.Equal(t, "dhctl", *resource[1].Value.StringValue)
+	})
+
+	t.Run("tee output", func(t *testing.T) {
+		collector, server := newTestOTLPCollector(t)
+
+		sink, err := NewOTLPLogger(OTLPOptions{Endpoint: server.URL})
+		require.NoError(t, err)
+
+		tee, err := NewTeeLogger(NewInMemoryLogger(), sink, 4)
+		require.NoError(t, err)
+
+		tee.InfoF("first line")
+		tee.InfoF("second line")
+		require.NoError(t, tee.FlushAndClose())
+
+		bodies := collector.bodies()
+		require.Len(t, bodies, 2)
+		require.Contains(t, bodies[0], "first line")
+		require.Contains(t, bodies[1], "second line")
+	})
+
+	t.Run("export error", func(t *testing.T) {
+		collector, server := newTestOTLPCollector(t)
+		collector.status = http.StatusInternalServerError
+
+		logger, err := NewOTLPLogger(OTLPOptions{Endpoint: server.URL})
+		require.NoError(t, err)
+
+		logger.InfoF("message")
+		require.ErrorContains(t, logger.FlushAndClose(), "unexpected status")
+	})
+}
@@ -0,0 +1,284 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"fmt"
+)
+
+var (
+	_ baseLogger              = &prefixLogger{}
+	_ formatWithNewLineLogger = &prefixLogger{}
+	_ Logger                  = &prefixLogger{}
+)
+
+// addPrefix
+// returns message like: prefix: msg
+func addPrefix(prefix, msg string) string {
+	if prefix == "" {
+		return msg
+	}
+
+	return fmt.Sprintf("%s: %s", prefix, msg)
+}
+
+// prefixLogger
+// adds prefix to all messages and process titles and passes them to parent logger
+// messages are filtered with component levels (see ComponentLevels), processes are not filtered
+// raw prefix is added without separator and is not used as component name
+type prefixLogger struct {
+	*formatWithNewLineLoggerWrapper
+
+	parent Logger
+	prefix string
+	raw    bool
+}
+
+// newPrefixLogger
+// returns parent if prefix is empty
+func newPrefixLogger(parent Logger, prefix string) Logger {
+	return newPrefixLoggerWithMode(parent, prefix, false)
+}
+
+func newPrefixLoggerWithMode(parent Logger, prefix string, raw bool) Logger {
+	if prefix == "" {
+		return parent
+	}
+
+	l := &prefixLogger{
+		parent: parent,
+		prefix: prefix,
+		raw:    raw,
+	}
+
+	l.formatWithNewLineLoggerWrapper = newFormatWithNewLineLoggerWrapper(l)
+
+	return l
+}
+
+// WithRawPrefix
+// like Logger.WithPrefix, but prefix is added as is: "prefix msg" instead of "prefix: msg"
+// raw prefix is not a component name, so messages are not filtered with component levels.
+// Use it for unique prefixes like "[name][id]"
+// silent logger without tee is returned as is, like for WithPrefix
+func WithRawPrefix(parent Logger, prefix string) Logger {
+	if silent, ok := parent.(*SilentLogger); ok && silent.t == nil {
+		return parent
+	}
+
+	return newPrefixLoggerWithMode(parent, prefix, true)
+}
+
+// WithPrefix
+// prefixes are joined like: parent: child: msg
+func (l *prefixLogger) WithPrefix(prefix string) Logger {
+	return newPrefixLoggerWithMode(l.parent, l.addPrefix(prefix), l.raw)
+}
+
+// WithFields
+// fields are passed to parent, so structured loggers keep fields structured
+func (l *prefixLogger) WithFields(fields map[string]any) Logger {
+	return newPrefixLoggerWithMode(l.parent.WithFields(fields), l.prefix, l.raw)
+}
+
+func (l *prefixLogger) WithField(key string, value any) Logger {
+	return l.WithFields(map[string]any{key: value})
+}
+
+func (l *prefixLogger) ProcessLogger() ProcessLogger {
+	return l.parent.ProcessLogger()
+}
+
+// ProgressLogger
+// progress is reported with prefix
+func (l *prefixLogger) ProgressLogger() ProgressLogger {
+	return &filteredProgressLogger{
+		parent: l.parent.ProgressLogger(),
+		filter: func(msg string) string {
+			return l.addPrefix(msg)
+		},
+	}
+}
+
+func (l *prefixLogger) SilentLogger() *SilentLogger {
+	return l.parent.SilentLogger()
+}
+
+func (l *prefixLogger) BufferLogger(buffer *bytes.Buffer) Logger {
+	return newPrefixLoggerWithMode(l.parent.BufferLogger(buffer), l.prefix, l.raw)
+}
+
+func (l *prefixLogger) FlushAndClose() error {
+	return l.parent.FlushAndClose()
+}
+
+func (l *prefixLogger) Process(p Process, t string, run func() error) error {
+	return l.parent.Process(p, l.addPrefix(t), run)
+}
+
+func (l *prefixLogger) Spin(title string, fn func() error) error {
+	return l.parent.Spin(l.addPrefix(title), fn)
+}
+
+func (l *prefixLogger) InfoFWithoutLn(format string, a ...interface{}) {
+	if !l.enabled(LevelInfo) {
+		return
+	}
+
+	l.parent.InfoFWithoutLn("%s", l.format(format, a...))
+}
+
+// InfoLn
+// Deprecated:
+// Use InfoF(string) it add \n to end
+func (l *prefixLogger) InfoLn(a ...interface{}) {
+	if !l.enabled(LevelInfo) {
+		return
+	}
+
+	l.parent.InfoLn(l.formatLn(a...))
+}
+
+func (l *prefixLogger) ErrorFWithoutLn(format string, a ...interface{}) {
+	if !l.enabled(LevelError) {
+		return
+	}
+
+	l.parent.ErrorFWithoutLn("%s", l.format(format, a...))
+}
+
+// ErrorLn
+// Deprecated:
+// Use ErrorF(string) it add \n to end
+func (l *prefixLogger) ErrorLn(a ...interface{}) {
+	if !l.enabled(LevelError) {
+		return
+	}
+
+	l.parent.ErrorLn(l.formatLn(a...))
+}
+
+func (l *prefixLogger) DebugFWithoutLn(format string, a ...interface{}) {
+	if l.debugForced() {
+		l.parent.InfoFWithoutLn("%s", l.format(format, a...))
+		return
+	}
+
+	if !l.enabled(LevelDebug) {
+		return
+	}
+
+	l.parent.DebugFWithoutLn("%s", l.format(format, a...))
+}
+
+// DebugLn
+// Deprecated:
+// Use DebugF(string) it add \n to end
+func (l *prefixLogger) DebugLn(a ...interface{}) {
+	if l.debugForced() {
+		l.parent.InfoLn(l.formatLn(a...))
+		return
+	}
+
+	if !l.enabled(LevelDebug) {
+		return
+	}
+
+	l.parent.DebugLn(l.formatLn(a...))
+}
+
+func (l *prefixLogger) WarnFWithoutLn(format string, a ...interface{}) {
+	if !l.enabled(LevelWarn) {
+		return
+	}
+
+	l.parent.WarnFWithoutLn("%s", l.format(format, a...))
+}
+
+// WarnLn
+// Deprecated:
+// Use WarnF(string) it add \n to end
+func (l *prefixLogger) WarnLn(a ...interface{}) {
+	if !l.enabled(LevelWarn) {
+		return
+	}
+
+	l.parent.WarnLn(l.formatLn(a...))
+}
+
+func (l *prefixLogger) Success(s string) {
+	if !l.enabled(LevelInfo) {
+		return
+	}
+
+	l.parent.Success(l.addPrefix(s))
+}
+
+func (l *prefixLogger) Fail(s string) {
+	if !l.enabled(LevelError) {
+		return
+	}
+
+	l.parent.Fail(l.addPrefix(s))
+}
+
+func (l *prefixLogger) FailRetry(s string) {
+	if !l.enabled(LevelWarn) {
+		return
+	}
+
+	l.parent.FailRetry(l.addPrefix(s))
+}
+
+// JSON
+// content is passed as is for keeping it valid json
+func (l *prefixLogger) JSON(content []byte) {
+	l.parent.JSON(content)
+}
+
+// Write
+// content is passed as is, because it can be part of line
+func (l *prefixLogger) Write(content []byte) (int, error) {
+	return l.parent.Write(content)
+}
+
+func (l *prefixLogger) enabled(level Level) bool {
+	if l.raw {
+		return true
+	}
+
+	return componentLevelEnabled(l.prefix, level)
+}
+
+func (l *prefixLogger) debugForced() bool {
+	return !l.raw && componentDebugForced(l.prefix)
+}
+
+func (l *prefixLogger) addPrefix(msg string) string {
+	if l.raw {
+		return l.prefix + " " + msg
+	}
+
+	return addPrefix(l.prefix, msg)
+}
+
+func (l *prefixLogger) format(format string, a ...any) string {
+	return l.addPrefix(sprintf(format, a...))
+}
+
+func (l *prefixLogger) formatLn(a ...any) string {
+	return l.addPrefix(trimLn(fmt.Sprintln(a...)))
+}
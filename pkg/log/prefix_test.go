@@ -0,0 +1,140 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithPrefix(t *testing.T) {
+	t.Run("in memory logger", func(t *testing.T) {
+		parent := NewInMemoryLogger()
+		ssh := parent.WithPrefix("ssh")
+		client := ssh.WithPrefix("client")
+
+		parent.InfoF("parent")
+		ssh.InfoF("connected")
+		client.ErrorF("failed")
+		client.Success("done")
+		_ = client.Process(ProcessDefault, "upload", func() error {
+			return nil
+		})
+
+		matches, err := parent.AllMatches(&Match{Suffix: []string{"\n"}})
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"parent\n",
+			"ssh: connected\n",
+			"ssh: client: failed\n",
+		}, matches)
+
+		_, err = parent.FirstMatch(&Match{Prefix: []string{"Success: ssh: client: done"}})
+		require.NoError(t, err)
+
+		_, err = parent.FirstMatch(&Match{Prefix: []string{"Start process: default/ssh: client: upload"}})
+		require.NoError(t, err)
+	})
+
+	t.Run("prefix with fields", func(t *testing.T) {
+		parent := NewInMemoryLogger()
+
+		parent.WithPrefix("ssh").WithField("node", "master-0").InfoF("connected")
+		parent.WithField("node", "master-1").WithPrefix("kube").InfoF("connected")
+
+		matches, err := parent.AllMatches(&Match{Suffix: []string{"\n"}})
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"ssh: connected | fields: [node='master-0']\n",
+			"kube: connected | fields: [node='master-1']\n",
+		}, matches)
+	})
+
+	t.Run("simple logger keeps structured fields", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := NewSimpleLogger(LoggerOptions{OutStream: buf}).WithPrefix("ssh").WithField("node", "master-0")
+
+		logger.InfoF("connected")
+
+		record := make(map[string]any)
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+		require.Equal(t, "ssh: connected\n", record["msg"])
+		require.Equal(t, "master-0", record["node"])
+	})
+
+	t.Run("empty prefix", func(t *testing.T) {
+		logger := NewDummyLogger(false)
+		require.Same(t, logger, logger.WithPrefix(""))
+	})
+
+	t.Run("silent logger without tee", func(t *testing.T) {
+		logger := NewSilentLogger()
+		require.Same(t, logger, logger.WithPrefix("ssh"))
+	})
+
+	t.Run("buffer logger keeps prefix", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+
+		NewDummyLogger(false).WithPrefix("ssh").BufferLogger(buf).InfoF("connected")
+
+		require.Contains(t, buf.String(), "ssh: connected")
+	})
+
+	for _, logger := range []Logger{
+		NewPrettyLogger(LoggerOptions{OutStream: &bytes.Buffer{}, IsDebug: true}),
+		NewSimpleLogger(LoggerOptions{OutStream: &bytes.Buffer{}, IsDebug: true}),
+		NewDummyLogger(true),
+		NewInMemoryLogger(),
+	} {
+		t.Run(fmt.Sprintf("%T follow interfaces", logger), func(t *testing.T) {
+			assertFollowAllInterfaces(t, logger.WithPrefix("ssh"))
+		})
+	}
+}
+
+func TestWithRawPrefix(t *testing.T) {
+	SetComponentLevels(ComponentLevels{"[loop][1]": LevelError})
+	t.Cleanup(func() {
+		SetComponentLevels(nil)
+	})
+
+	parent := NewInMemoryLogger()
+	logger := WithRawPrefix(parent, "[loop 100%][1]")
+
+	logger.InfoF("attempt %d", 1)
+	logger.Success("Succeeded!")
+	logger.WithPrefix("child").WarnF("warn")
+	WithRawPrefix(parent, "[loop][1]").InfoF("not filtered")
+
+	matches, err := parent.AllMatches(&Match{Suffix: []string{"\n"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"[loop 100%][1] attempt 1\n",
+		"[loop 100%][1] child warn\n",
+		"[loop][1] not filtered\n",
+	}, matches)
+
+	_, err = parent.FirstMatch(&Match{Prefix: []string{"Success: [loop 100%][1] Succeeded!"}})
+	require.NoError(t, err)
+
+	silent := NewSilentLogger()
+	require.Same(t, silent, WithRawPrefix(silent, "[loop][1]"))
+
+	assertFollowAllInterfaces(t, logger)
+}
@@ -45,8 +45,18 @@ type PrettyLogger struct {
 
 	processTitles  Processes
 	isDebug        bool
+	colors         bool
+	theme          Theme
+	symbols        SymbolMode
 	logboekLogger  types.LoggerInterface
 	debugLogWriter *debugLogWriter
+	widthWatcher   *terminalWidthWatcher
+	timestamps     *timestampWriter
+	// spinnerOut
+	// terminal for rendering spinner, nil if output is not terminal
+	spinnerOut       io.Writer
+	writeOptions     WriteOptions
+	configureLogboek func(logger types.LoggerInterface)
 }
 
 func NewPrettyLogger(opts LoggerOptions) *PrettyLogger {
@@ -59,46 +69,123 @@ func NewPrettyLogger(opts LoggerOptions) *PrettyLogger {
 		}
 	}
 
+	symbols := resolveSymbolMode(opts.SymbolMode, opts.OutStream)
+
+	theme := opts.Theme
+	if symbols == SymbolModeASCII {
+		theme = theme.ascii()
+	}
+
 	res := &PrettyLogger{
-		processTitles: processes,
-		isDebug:       opts.IsDebug,
+		processTitles:    theme.processes(processes),
+		isDebug:          opts.IsDebug,
+		theme:            theme,
+		symbols:          symbols,
+		writeOptions:     opts.WriteOptions,
+		configureLogboek: opts.ConfigureLogboek,
 	}
 
 	res.formatWithNewLineLoggerWrapper = newFormatWithNewLineLoggerWrapper(res)
 
-	if opts.OutStream != nil {
-		res.logboekLogger = logboek.DefaultLogger().NewSubLogger(opts.OutStream, opts.OutStream)
-	} else {
+	switch {
+	case opts.WithTimestamps:
+		res.timestamps = newTimestampWriter(opts.OutStream, timestampFormat(opts))
+
+		var errStream io.Writer = res.timestamps
+		if !govalue.IsNil(opts.ErrStream) {
+			errStream = newTimestampWriter(opts.ErrStream, timestampFormat(opts))
+		}
+
+		res.logboekLogger = logboek.DefaultLogger().NewSubLogger(res.timestamps, errStream)
+	case opts.OutStream != nil || !govalue.IsNil(opts.ErrStream):
+		outStream := opts.OutStream
+		if outStream == nil {
+			outStream = os.Stdout
+		}
+
+		errStream := opts.ErrStream
+		if govalue.IsNil(errStream) {
+			errStream = outStream
+		}
+
+		res.logboekLogger = logboek.DefaultLogger().NewSubLogger(outStream, errStream)
+	default:
 		res.logboekLogger = logboek.DefaultLogger()
 	}
 
+	if f := terminalFile(opts.OutStream); f != nil && !opts.WithTimestamps {
+		res.spinnerOut = f
+	}
+
 	if !govalue.IsNil(opts.DebugStream) {
 		res.debugLogWriter = &debugLogWriter{DebugStream: opts.DebugStream}
 	}
 
 	res.logboekLogger.SetAcceptedLevel(level.Info)
 
-	if opts.Width != 0 {
-		res.logboekLogger.Streams().SetWidth(opts.Width)
+	res.colors = colorsEnabled(opts.ColorMode, opts.OutStream)
+	if res.colors {
+		if opts.ColorMode == ColorModeAlways {
+			forceColors()
+		}
+
+		res.logboekLogger.Streams().EnableStyle()
 	} else {
-		res.logboekLogger.Streams().SetWidth(140)
+		res.logboekLogger.Streams().DisableStyle()
 	}
 
+	res.logboekLogger.Streams().SetWidth(res.outputWidth(res.detectWidth(opts)))
+
 	if opts.IsDebug {
 		res.logboekLogger.Streams().DisableProxyStreamDataFormatting()
 	} else {
 		res.logboekLogger.Streams().EnableProxyStreamDataFormatting()
 	}
 
+	if res.configureLogboek != nil {
+		res.configureLogboek(res.logboekLogger)
+	}
+
 	return res
 }
 
+// Logboek
+// returns logboek sub-logger used for output,
+// use LoggerOptions.ConfigureLogboek for changing settings before first message
+func (d *PrettyLogger) Logboek() types.LoggerInterface {
+	return d.logboek()
+}
+
+func (d *PrettyLogger) WithFields(fields map[string]any) Logger {
+	return newFieldsLogger(d, fields)
+}
+
+func (d *PrettyLogger) WithField(key string, value any) Logger {
+	return d.WithFields(map[string]any{key: value})
+}
+
+func (d *PrettyLogger) WithPrefix(prefix string) Logger {
+	return newPrefixLogger(d, prefix)
+}
+
 func (d *PrettyLogger) FlushAndClose() error {
+	if d.widthWatcher != nil {
+		d.widthWatcher.Stop()
+	}
+
 	return nil
 }
 
 func (d *PrettyLogger) ProcessLogger() ProcessLogger {
-	return newPrettyProcessLogger(d.logboekLogger)
+	if d.theme.DisableFrames {
+		return newWrappedProcessLogger(d)
+	}
+
+	return newPrettyProcessLogger(d.logboek())
+}
+
+func (d *PrettyLogger) ProgressLogger() ProgressLogger {
+	return newPrettyProgressLogger(d.logboek())
 }
 
 func (d *PrettyLogger) SilentLogger() *SilentLogger {
@@ -106,42 +193,103 @@ func (d *PrettyLogger) SilentLogger() *SilentLogger {
 }
 
 func (d *PrettyLogger) BufferLogger(buffer *bytes.Buffer) Logger {
-	return NewPrettyLogger(LoggerOptions{OutStream: buffer, IsDebug: d.isDebug})
+	// buffer is not terminal, but content of buffer will be written to output of this logger
+	colorMode := ColorModeNever
+	if d.colors {
+		colorMode = ColorModeAlways
+	}
+
+	return NewPrettyLogger(LoggerOptions{
+		OutStream:        buffer,
+		IsDebug:          d.isDebug,
+		ColorMode:        colorMode,
+		Theme:            d.theme,
+		SymbolMode:       d.symbols,
+		WriteOptions:     d.writeOptions,
+		ConfigureLogboek: d.configureLogboek,
+	})
 }
 
 func (d *PrettyLogger) Process(p Process, t string, run func() error) error {
+	t = maskSecrets(t)
+
 	format, ok := d.processTitles[p]
 	if !ok {
 		format = d.processTitles["default"]
 	}
-	return d.logboekLogger.LogProcess(format.Title, t).Options(format.OptionsSetter).DoError(run)
+
+	if d.theme.DisableFrames {
+		return d.processWithoutFrames(p, format, t, run)
+	}
+
+	// logboek prints process duration itself
+	_, err := runProcess(p, t, func() error {
+		return d.logboek().LogProcess(format.Title, t).Options(format.OptionsSetter).DoError(run)
+	})
+
+	return err
+}
+
+// Spin
+// shows spinner with elapsed time under process title while fn is running.
+// spinner is not shown if output is not terminal or timestamps are enabled.
+// fn should not write to logger, because messages are mixed with spinner line
+func (d *PrettyLogger) Spin(title string, fn func() error) error {
+	if d.spinnerOut == nil {
+		return d.Process(ProcessDefault, title, fn)
+	}
+
+	frames := emojiSpinnerFrames
+	if d.theme.DisableEmoji {
+		frames = textSpinnerFrames
+	}
+
+	return d.Process(ProcessDefault, title, func() error {
+		s := newSpinner(d.spinnerOut, maskSecrets(title), frames, spinnerInterval)
+		s.start()
+		defer s.stop()
+
+		return fn()
+	})
 }
 
 func (d *PrettyLogger) InfoFWithoutLn(format string, a ...interface{}) {
-	d.logboekLogger.Info().LogF(format, a...)
+	format, a = maskSecretsF(format, a)
+
+	d.logboek().Info().LogF(format, a...)
 }
 
 // InfoLn
 // Deprecated:
 // Use InfoF(string) it add \n to end
 func (d *PrettyLogger) InfoLn(a ...interface{}) {
-	d.logboekLogger.Info().LogLn(a...)
+	a = maskSecretsLn(a)
+
+	d.logboek().Info().LogLn(a...)
 }
 
+// ErrorFWithoutLn
+// long lines are wrapped by words with hanging indent (see softWrap)
 func (d *PrettyLogger) ErrorFWithoutLn(format string, a ...interface{}) {
-	d.logboekLogger.Error().LogF(format, a...)
+	format, a = maskSecretsF(format, a)
+
+	d.logboek().Error().LogF("%s", d.wrap(sprintf(format, a...)))
 }
 
 // ErrorLn
 // Deprecated:
 // Use ErrorF(string) it add \n to end
 func (d *PrettyLogger) ErrorLn(a ...interface{}) {
-	d.logboekLogger.Error().LogLn(a...)
+	a = maskSecretsLn(a)
+
+	d.logboek().Error().LogF("%s", d.wrap(fmt.Sprintln(a...)))
 }
 
 func (d *PrettyLogger) DebugFWithoutLn(format string, a ...interface{}) {
+	format, a = maskSecretsF(format, a)
+
 	if d.debugLogWriter != nil {
-		o := fmt.Sprintf(format, a...)
+		o := sprintf(format, a...)
 		_, err := d.debugLogWriter.DebugStream.Write([]byte(o))
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "cannot write debug log (%s): %v", o, err)
@@ -149,7 +297,7 @@ func (d *PrettyLogger) DebugFWithoutLn(format string, a ...interface{}) {
 	}
 
 	if d.isDebug {
-		d.logboekLogger.Info().LogF(format, a...)
+		d.logboek().Info().LogF(format, a...)
 	}
 }
 
@@ -157,28 +305,36 @@ func (d *PrettyLogger) DebugFWithoutLn(format string, a ...interface{}) {
 // Deprecated:
 // Use DebugF(string) it add \n to end
 func (d *PrettyLogger) DebugLn(a ...interface{}) {
+	a = maskSecretsLn(a)
+
 	if d.debugLogWriter != nil {
 		o := fmt.Sprintln(a...)
 		_, err := d.debugLogWriter.DebugStream.Write([]byte(o))
 		if err != nil {
-			d.logboekLogger.Info().LogF("cannot write debug log (%s): %v", o, err)
+			d.logboek().Info().LogF("cannot write debug log (%s): %v", o, err)
 		}
 	}
 
 	if d.isDebug {
-		d.logboekLogger.Info().LogLn(a...)
+		d.logboek().Info().LogLn(a...)
 	}
 }
 
 func (d *PrettyLogger) Success(l string) {
-	d.InfoF("🎉 %s", trimLn(l))
+	l = maskSecrets(l)
+
+	d.InfoF("%s", d.style(d.theme.SuccessStyle, d.theme.successPrefix()+trimLn(l)))
 }
 
 func (d *PrettyLogger) Fail(l string) {
-	d.InfoFWithoutLn("️⛱️️ %s", l)
+	l = maskSecrets(l)
+
+	d.logboek().Error().LogF("%s", d.style(d.theme.FailStyle, d.theme.failPrefix()+l))
 }
 
 func (d *PrettyLogger) FailRetry(l string) {
+	l = maskSecrets(l)
+
 	d.Fail(l)
 }
 
@@ -186,24 +342,106 @@ func (d *PrettyLogger) FailRetry(l string) {
 // Deprecated:
 // Use WarnF(string) it add \n to end
 func (d *PrettyLogger) WarnLn(a ...interface{}) {
-	a = append([]interface{}{"❗ ~ "}, a...)
-	d.InfoLn(color.New(color.Bold).Sprint(a...))
+	a = maskSecretsLn(a)
+
+	a = append([]interface{}{d.theme.warnPrefix()}, a...)
+	d.logboek().Warn().LogLn(d.style(d.theme.warnStyle(), d.wrap(fmt.Sprint(a...))))
 }
 
+// WarnFWithoutLn
+// long lines are wrapped by words with hanging indent (see softWrap)
 func (d *PrettyLogger) WarnFWithoutLn(format string, a ...interface{}) {
-	line := color.New(color.Bold).Sprintf("❗ ~ "+format, a...)
-	d.InfoFWithoutLn(line)
+	format, a = maskSecretsF(format, a)
+
+	line := d.style(d.theme.warnStyle(), d.wrap(fmt.Sprintf(d.theme.warnPrefix()+format, a...)))
+	d.logboek().Warn().LogF("%s", line)
 }
 
 func (d *PrettyLogger) JSON(content []byte) {
 	d.InfoF(prettyJSON(content))
 }
 
+// Write
+// content is written with level and split on lines as configured with LoggerOptions.WriteOptions
 func (d *PrettyLogger) Write(content []byte) (int, error) {
-	d.InfoFWithoutLn(string(content))
+	writeRecords(d, d.writeOptions.records(content))
 	return len(content), nil
 }
 
+func (d *PrettyLogger) detectWidth(opts LoggerOptions) int {
+	if opts.Width != 0 {
+		return opts.Width
+	}
+
+	f := terminalFile(opts.OutStream)
+	if f == nil {
+		return DefaultTerminalWidth
+	}
+
+	d.widthWatcher = newTerminalWidthWatcher(f)
+
+	if width := terminalWidth(f); width > 0 {
+		return width
+	}
+
+	return DefaultTerminalWidth
+}
+
+// outputWidth
+// returns width available for logboek output, timestamps take part of line
+func (d *PrettyLogger) outputWidth(width int) int {
+	if d.timestamps == nil {
+		return width
+	}
+
+	return max(width-d.timestamps.prefixLen(), 1)
+}
+
+// wrap
+// wraps message by content width of logboek for preventing cutting words by logboek
+func (d *PrettyLogger) wrap(msg string) string {
+	return softWrap(msg, d.logboek().Streams().ContentWidth())
+}
+
+// processWithoutFrames
+// logboek cannot disable process borders, so process is rendered as start and end lines
+func (d *PrettyLogger) processWithoutFrames(p Process, format StyleEntry, t string, run func() error) error {
+	title := fmt.Sprintf(format.Title, t)
+	style := processStyle(format)
+
+	d.InfoF("%s", d.style(style, title))
+
+	duration, err := runProcess(p, t, run)
+	if err != nil {
+		d.InfoF("%s", d.style(style, fmt.Sprintf("%s FAILED %s", title, formatProcessDuration(duration))))
+		return err
+	}
+
+	d.InfoF("%s", d.style(style, fmt.Sprintf("%s %s", title, formatProcessDuration(duration))))
+
+	return nil
+}
+
+// logboek
+// returns logboek logger with applied terminal width if terminal was resized
+func (d *PrettyLogger) logboek() types.LoggerInterface {
+	if d.widthWatcher != nil {
+		if width := d.widthWatcher.pop(); width > 0 {
+			d.logboekLogger.Streams().SetWidth(d.outputWidth(width))
+		}
+	}
+
+	return d.logboekLogger
+}
+
+func (d *PrettyLogger) style(style color.Style, s string) string {
+	if !d.colors || len(style) == 0 {
+		return s
+	}
+
+	return style.Sprint(s)
+}
+
 func prettyJSON(content []byte) string {
 	result := &bytes.Buffer{}
 	if err := json.Indent(result, content, "", "  "); err != nil {
@@ -22,6 +22,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/werf/logboek/pkg/level"
+	"github.com/werf/logboek/pkg/types"
 )
 
 func TestPrettyDefault(t *testing.T) {
@@ -187,6 +189,9 @@ func testNewPretty(opts LoggerOptions) (*PrettyLogger, *InMemoryLogger) {
 	inMemoryLogger := NewInMemoryLoggerWithParent(NewDummyLogger(opts.IsDebug))
 
 	opts.OutStream = inMemoryLogger
+	if opts.SymbolMode == "" {
+		opts.SymbolMode = SymbolModeUnicode
+	}
 
 	return NewPrettyLogger(opts), inMemoryLogger
 }
@@ -259,3 +264,30 @@ func testPrettyLoggerDefaultProcesses(t *testing.T, tstPrefix string, logger *Pr
 		})
 	}
 }
+
+func TestPrettyConfigureLogboek(t *testing.T) {
+	buf := &bytes.Buffer{}
+	configured := 0
+
+	logger := NewPrettyLogger(LoggerOptions{
+		OutStream: buf,
+		ColorMode: ColorModeNever,
+		ConfigureLogboek: func(l types.LoggerInterface) {
+			configured++
+			l.SetAcceptedLevel(level.Error)
+		},
+	})
+
+	require.Equal(t, 1, configured)
+	require.Equal(t, level.Error, logger.Logboek().AcceptedLevel())
+
+	logger.InfoF("info message")
+	logger.ErrorF("error message")
+
+	require.NotContains(t, buf.String(), "info message")
+	require.Contains(t, buf.String(), "error message")
+
+	bufferLogger := logger.BufferLogger(&bytes.Buffer{}).(*PrettyLogger)
+	require.Equal(t, 2, configured)
+	require.Equal(t, level.Error, bufferLogger.Logboek().AcceptedLevel())
+}
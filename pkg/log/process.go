@@ -16,11 +16,89 @@ package log
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/werf/logboek/pkg/types"
 )
 
+// ProcessEndHook
+// called by all loggers after Process action finished
+// err is error returned from action
+type ProcessEndHook func(p Process, title string, duration time.Duration, err error)
+
+type processEndHooks struct {
+	mu     sync.RWMutex
+	lastID int
+	hooks  map[int]ProcessEndHook
+}
+
+var defaultProcessEndHooks = &processEndHooks{
+	hooks: make(map[int]ProcessEndHook),
+}
+
+// OnProcessEnd
+// register hook for all Process calls for all loggers, for example for collecting metrics
+// returns function for unregister hook
+func OnProcessEnd(hook ProcessEndHook) func() {
+	h := defaultProcessEndHooks
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.lastID++
+	id := h.lastID
+	h.hooks[id] = hook
+
+	return func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		delete(h.hooks, id)
+	}
+}
+
+func (h *processEndHooks) call(p Process, title string, duration time.Duration, err error) {
+	h.mu.RLock()
+	hooks := make([]ProcessEndHook, 0, len(h.hooks))
+	for id := 1; id <= h.lastID; id++ {
+		if hook, ok := h.hooks[id]; ok {
+			hooks = append(hooks, hook)
+		}
+	}
+	h.mu.RUnlock()
+
+	// call without lock for allow register hooks from hook
+	for _, hook := range hooks {
+		hook(p, title, duration, err)
+	}
+}
+
+// runProcess
+// runs process action, measures duration and calls process end hooks
+func runProcess(p Process, title string, run func() error) (time.Duration, error) {
+	start := time.Now()
+
+	err := run()
+
+	duration := time.Since(start)
+	defaultProcessEndHooks.call(p, title, duration, err)
+
+	return duration, err
+}
+
+// formatProcessDuration
+// returns duration like: (took 2m13s)
+func formatProcessDuration(duration time.Duration) string {
+	if duration >= time.Second {
+		duration = duration.Round(time.Second)
+	} else {
+		duration = duration.Round(time.Millisecond)
+	}
+
+	return fmt.Sprintf("(took %s)", duration)
+}
+
 type processStack struct {
 	activeProcesses []*logProcessDescriptor
 }
@@ -15,6 +15,8 @@
 package log
 
 import (
+	"bytes"
+	"errors"
 	"os"
 	"testing"
 	"time"
@@ -133,3 +135,55 @@ func TestProcessLoggers(t *testing.T) {
 		assertNewLine(t, expectedLoggerFail, 2)
 	})
 }
+
+func TestProcessEndHook(t *testing.T) {
+	type call struct {
+		process Process
+		title   string
+		err     error
+	}
+
+	calls := make([]call, 0)
+	unregister := OnProcessEnd(func(p Process, title string, duration time.Duration, err error) {
+		require.GreaterOrEqual(t, duration, 10*time.Millisecond)
+		calls = append(calls, call{process: p, title: title, err: err})
+	})
+
+	processErr := errors.New("process error")
+	buf := bytes.NewBuffer(nil)
+
+	loggers := []Logger{
+		NewSilentLogger(),
+		NewSimpleLogger(LoggerOptions{OutStream: buf}),
+		NewPrettyLogger(LoggerOptions{OutStream: buf}),
+		NewInMemoryLogger(),
+	}
+
+	for _, logger := range loggers {
+		err := logger.Process(ProcessBootstrap, "Bootstrap", func() error {
+			time.Sleep(10 * time.Millisecond)
+			return processErr
+		})
+		require.ErrorIs(t, err, processErr)
+	}
+
+	require.Len(t, calls, len(loggers))
+	for _, c := range calls {
+		require.Equal(t, call{process: ProcessBootstrap, title: "Bootstrap", err: processErr}, c)
+	}
+
+	require.Contains(t, buf.String(), "Bootstrap (took ")
+
+	unregister()
+
+	err := NewSilentLogger().Process(ProcessBootstrap, "Bootstrap", func() error {
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, calls, len(loggers))
+}
+
+func TestFormatProcessDuration(t *testing.T) {
+	require.Equal(t, "(took 2m13s)", formatProcessDuration(2*time.Minute+13*time.Second+300*time.Millisecond))
+	require.Equal(t, "(took 150ms)", formatProcessDuration(150*time.Millisecond+200*time.Microsecond))
+}
@@ -0,0 +1,215 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/werf/logboek/pkg/types"
+)
+
+// ProgressLogger
+// reports progress of long operations (images mirroring, nodes bootstrap, etc.)
+// without spamming InfoF. Increment can be called from multiple goroutines
+type ProgressLogger interface {
+	// StartProgress
+	// starts progress with total steps, total <= 0 means unknown total
+	StartProgress(total int)
+	// Increment
+	// increments progress by one step and reports msg
+	Increment(msg string)
+	// Done
+	// finishes progress
+	Done()
+}
+
+const (
+	progressBarWidth = 20
+
+	progressCurrentField = "progress_current"
+	progressTotalField   = "progress_total"
+)
+
+type progressCounter struct {
+	mu      sync.Mutex
+	total   int
+	current int
+}
+
+func (c *progressCounter) start(total int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.total = total
+	c.current = 0
+}
+
+func (c *progressCounter) increment() (int, int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.current++
+
+	return c.current, c.total
+}
+
+func (c *progressCounter) state() (int, int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.current, c.total
+}
+
+// formatProgress
+// returns progress like: [5/10]
+// or [5] if total is unknown
+func formatProgress(current, total int) string {
+	if total <= 0 {
+		return fmt.Sprintf("[%d]", current)
+	}
+
+	return fmt.Sprintf("[%d/%d]", current, total)
+}
+
+// formatProgressBar
+// returns progress like: [##########----------] 50% (5/10)
+// or (5) if total is unknown
+func formatProgressBar(current, total int) string {
+	if total <= 0 {
+		return fmt.Sprintf("(%d)", current)
+	}
+
+	filled := min(current, total) * progressBarWidth / total
+	percent := min(current, total) * 100 / total
+
+	return fmt.Sprintf(
+		"[%s%s] %3d%% (%d/%d)",
+		strings.Repeat("#", filled),
+		strings.Repeat("-", progressBarWidth-filled),
+		percent,
+		current,
+		total,
+	)
+}
+
+// wrappedProgressLogger
+// reports progress with InfoF like: [5/10] msg
+type wrappedProgressLogger struct {
+	logger  Logger
+	counter *progressCounter
+}
+
+func newWrappedProgressLogger(logger Logger) *wrappedProgressLogger {
+	return &wrappedProgressLogger{
+		logger:  logger,
+		counter: &progressCounter{},
+	}
+}
+
+func (l *wrappedProgressLogger) StartProgress(total int) {
+	l.counter.start(total)
+}
+
+func (l *wrappedProgressLogger) Increment(msg string) {
+	l.logger.InfoF("%s %s", formatProgress(l.counter.increment()), msg)
+}
+
+func (l *wrappedProgressLogger) Done() {
+	l.logger.InfoF("%s Done", formatProgress(l.counter.state()))
+}
+
+// prettyProgressLogger
+// renders progress bar on every step
+type prettyProgressLogger struct {
+	logboekLogger types.LoggerInterface
+	counter       *progressCounter
+}
+
+func newPrettyProgressLogger(logboekLogger types.LoggerInterface) *prettyProgressLogger {
+	return &prettyProgressLogger{
+		logboekLogger: logboekLogger,
+		counter:       &progressCounter{},
+	}
+}
+
+func (l *prettyProgressLogger) StartProgress(total int) {
+	l.counter.start(total)
+}
+
+func (l *prettyProgressLogger) Increment(msg string) {
+	l.logboekLogger.Info().LogF("%s %s\n", formatProgressBar(l.counter.increment()), maskSecrets(msg))
+}
+
+func (l *prettyProgressLogger) Done() {
+	l.logboekLogger.Info().LogF("%s Done\n", formatProgressBar(l.counter.state()))
+}
+
+// simpleProgressLogger
+// emits progress as structured events with action, progress_current and progress_total fields
+type simpleProgressLogger struct {
+	logger  *SimpleLogger
+	counter *progressCounter
+}
+
+func newSimpleProgressLogger(logger *SimpleLogger) *simpleProgressLogger {
+	return &simpleProgressLogger{
+		logger:  logger,
+		counter: &progressCounter{},
+	}
+}
+
+func (l *simpleProgressLogger) StartProgress(total int) {
+	l.counter.start(total)
+	l.event("progress_start", 0, total, "")
+}
+
+func (l *simpleProgressLogger) Increment(msg string) {
+	current, total := l.counter.increment()
+	l.event("progress", current, total, msg)
+}
+
+func (l *simpleProgressLogger) Done() {
+	current, total := l.counter.state()
+	l.event("progress_done", current, total, "")
+}
+
+func (l *simpleProgressLogger) event(action string, current, total int, msg string) {
+	l.logger.logger.
+		With(JSONFieldAction, action).
+		With(progressCurrentField, current).
+		With(progressTotalField, total).
+		Info(maskSecrets(msg))
+}
+
+// filteredProgressLogger
+// passes progress messages to parent through filter
+type filteredProgressLogger struct {
+	parent ProgressLogger
+	filter func(string) string
+}
+
+func (l *filteredProgressLogger) StartProgress(total int) {
+	l.parent.StartProgress(total)
+}
+
+func (l *filteredProgressLogger) Increment(msg string) {
+	l.parent.Increment(l.filter(msg))
+}
+
+func (l *filteredProgressLogger) Done() {
+	l.parent.Done()
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatProgressBar(t *testing.T) {
+	require.Equal(t, "[##########----------]  50% (5/10)", formatProgressBar(5, 10))
+	require.Equal(t, "[--------------------]   0% (0/3)", formatProgressBar(0, 3))
+	require.Equal(t, "[####################] 100% (4/3)", formatProgressBar(4, 3))
+	require.Equal(t, "(5)", formatProgressBar(5, 0))
+	require.Equal(t, "[5/10]", formatProgress(5, 10))
+	require.Equal(t, "[5]", formatProgress(5, -1))
+}
+
+func TestProgressLogger(t *testing.T) {
+	t.Run("in memory", func(t *testing.T) {
+		logger := NewInMemoryLogger()
+
+		progress := logger.ProgressLogger()
+		progress.StartProgress(2)
+		progress.Increment("image 1")
+		progress.Increment("image 2")
+		progress.Done()
+
+		entries := logger.Entries()
+		require.Len(t, entries, 4)
+
+		require.Equal(t, "Start progress", entries[0].Message)
+		require.Equal(t, "[1/2] image 1", entries[1].Message)
+		require.Equal(t, map[string]any{progressCurrentField: 1, progressTotalField: 2}, entries[1].Fields)
+		require.Equal(t, "[2/2] image 2", entries[2].Message)
+		require.Equal(t, "[2/2] Done", entries[3].Message)
+	})
+
+	t.Run("wrapped", func(t *testing.T) {
+		syslog := newTestSyslogWriter()
+		progress := NewSyslogLogger(syslog, false).ProgressLogger()
+		progress.StartProgress(0)
+		progress.Increment("node")
+		progress.Done()
+
+		require.Equal(t, []string{"info: [1] node", "info: [1] Done"}, syslog.records)
+	})
+
+	t.Run("pretty", func(t *testing.T) {
+		buf := bytes.NewBuffer(nil)
+		progress := NewPrettyLogger(LoggerOptions{OutStream: buf}).ProgressLogger()
+
+		progress.StartProgress(4)
+		progress.Increment("master-0")
+		progress.Done()
+
+		require.Contains(t, buf.String(), "[#####---------------]  25% (1/4) master-0")
+		require.Contains(t, buf.String(), "[#####---------------]  25% (1/4) Done")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		buf := bytes.NewBuffer(nil)
+		progress := NewJSONLogger(LoggerOptions{OutStream: buf}).WithField("node", "master-0").ProgressLogger()
+
+		progress.StartProgress(2)
+		progress.Increment("step")
+		progress.Done()
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 3)
+
+		events := make([]map[string]any, 0, len(lines))
+		for _, line := range lines {
+			event := make(map[string]any)
+			require.NoError(t, json.Unmarshal([]byte(line), &event))
+			events = append(events, event)
+		}
+
+		require.Equal(t, "progress_start", events[0]["action"])
+		require.Equal(t, "progress", events[1]["action"])
+		require.Equal(t, float64(1), events[1][progressCurrentField])
+		require.Equal(t, float64(2), events[1][progressTotalField])
+		require.Equal(t, "master-0", events[1]["node"])
+		require.Contains(t, events[1]["msg"], "step")
+		require.Equal(t, "progress_done", events[2]["action"])
+	})
+
+	t.Run("concurrent increments", func(t *testing.T) {
+		logger := NewInMemoryLogger()
+		progress := logger.ProgressLogger()
+		progress.StartProgress(50)
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				progress.Increment(fmt.Sprintf("node %d", i))
+			}(i)
+		}
+		wg.Wait()
+		progress.Done()
+
+		entries := logger.Entries()
+		require.Equal(t, "[50/50] Done", entries[len(entries)-1].Message)
+	})
+}
@@ -44,3 +44,28 @@ func ProvideSafe(provider LoggerProvider, defaultLogger Logger) Logger {
 
 	return defaultLogger
 }
+
+// MultiProvider
+// returns provider of logger which duplicates every call to loggers of all providers (see MultiLogger).
+// nil providers and providers returned nil logger are skipped,
+// if only one logger is provided it is returned as is, if no loggers - silent logger is returned
+func MultiProvider(providers ...LoggerProvider) LoggerProvider {
+	return func() Logger {
+		loggers := make([]Logger, 0, len(providers))
+		for _, provider := range providers {
+			logger := ProvideSafe(provider, nil)
+			if !govalue.IsNil(logger) {
+				loggers = append(loggers, logger)
+			}
+		}
+
+		switch len(loggers) {
+		case 0:
+			return silentLoggerInstance
+		case 1:
+			return loggers[0]
+		default:
+			return NewMultiLogger(loggers...)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"io"
+)
+
+var (
+	_ baseLogger              = &QuietLogger{}
+	_ formatWithNewLineLogger = &QuietLogger{}
+	_ Logger                  = &QuietLogger{}
+	_ io.Writer               = &QuietLogger{}
+)
+
+type QuietLogger struct {
+	*formatWithNewLineLoggerWrapper
+
+	parent Logger
+}
+
+// WrapWithQuiet
+// returns logger which passes to logger only processes, warnings, errors, fails and fail retries.
+// info, debug, success, json, raw content and progress are dropped.
+// wrap terminal logger and pass quiet logger as parent to TeeLogger or InMemoryLogger
+// for recording all messages to file or memory and showing only important messages in terminal:
+//
+//	logger, err := NewTeeLogger(WrapWithQuiet(NewPrettyLogger(opts)), file, bufSize)
+func WrapWithQuiet(logger Logger) *QuietLogger {
+	l := &QuietLogger{
+		parent: logger,
+	}
+
+	l.formatWithNewLineLoggerWrapper = newFormatWithNewLineLoggerWrapper(l)
+
+	return l
+}
+
+func (l *QuietLogger) WithFields(fields map[string]any) Logger {
+	return WrapWithQuiet(l.parent.WithFields(fields))
+}
+
+func (l *QuietLogger) WithField(key string, value any) Logger {
+	return l.WithFields(map[string]any{key: value})
+}
+
+func (l *QuietLogger) WithPrefix(prefix string) Logger {
+	return newPrefixLogger(l, prefix)
+}
+
+func (l *QuietLogger) ProcessLogger() ProcessLogger {
+	return l.parent.ProcessLogger()
+}
+
+func (l *QuietLogger) ProgressLogger() ProgressLogger {
+	return newWrappedProgressLogger(l)
+}
+
+func (l *QuietLogger) SilentLogger() *SilentLogger {
+	return l.parent.SilentLogger()
+}
+
+// BufferLogger
+// buffer contains all messages like buffer of another loggers
+func (l *QuietLogger) BufferLogger(buffer *bytes.Buffer) Logger {
+	return l.parent.BufferLogger(buffer)
+}
+
+func (l *QuietLogger) FlushAndClose() error {
+	return l.parent.FlushAndClose()
+}
+
+func (l *QuietLogger) Process(p Process, t string, run func() error) error {
+	return l.parent.Process(p, t, run)
+}
+
+func (l *QuietLogger) InfoFWithoutLn(string, ...interface{}) {}
+
+// InfoLn
+// Deprecated:
+// Use InfoF(string) it add \n to end
+func (l *QuietLogger) InfoLn(...interface{}) {}
+
+func (l *QuietLogger) ErrorFWithoutLn(format string, a ...interface{}) {
+	l.parent.ErrorFWithoutLn(format, a...)
+}
+
+// ErrorLn
+// Deprecated:
+// Use ErrorF(string) it add \n to end
+func (l *QuietLogger) ErrorLn(a ...interface{}) {
+	l.parent.ErrorLn(a...)
+}
+
+func (l *QuietLogger) DebugFWithoutLn(string, ...interface{}) {}
+
+// DebugLn
+// Deprecated:
+// Use DebugF(string) it add \n to end
+func (l *QuietLogger) DebugLn(...interface{}) {}
+
+func (l *QuietLogger) WarnFWithoutLn(format string, a ...interface{}) {
+	l.parent.WarnFWithoutLn(format, a...)
+}
+
+// WarnLn
+// Deprecated:
+// Use WarnF(string) it add \n to end
+func (l *QuietLogger) WarnLn(a ...interface{}) {
+	l.parent.WarnLn(a...)
+}
+
+func (l *QuietLogger) Success(string) {}
+
+func (l *QuietLogger) Fail(s string) {
+	l.parent.Fail(s)
+}
+
+func (l *QuietLogger) FailRetry(s string) {
+	l.parent.FailRetry(s)
+}
+
+func (l *QuietLogger) JSON([]byte) {}
+
+func (l *QuietLogger) Write(content []byte) (int, error) {
+	return len(content), nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuietLogger(t *testing.T) {
+	terminal := NewInMemoryLogger()
+	memory := NewInMemoryLoggerWithParent(WrapWithQuiet(terminal))
+
+	writer := newTestWriterCloser()
+	logger, err := NewTeeLogger(memory, writer, 1024)
+	require.NoError(t, err)
+
+	_ = logger.Process(ProcessDefault, "install", func() error {
+		logger.InfoF("info")
+		logger.DebugF("debug")
+		logger.Success("done")
+		logger.WithField("node", "master-0").WarnF("warn")
+		logger.ErrorF("error")
+		logger.FailRetry("retry")
+		return nil
+	})
+
+	require.NoError(t, logger.FlushAndClose())
+
+	terminalMessages := make([]string, 0)
+	for _, entry := range terminal.Entries() {
+		terminalMessages = append(terminalMessages, entry.String())
+	}
+
+	require.Equal(t, []string{
+		"Start process: default/install",
+		"warn | fields: [node='master-0']\n",
+		"error\n",
+		"Fail retry: retry",
+		"End process: default/install",
+	}, terminalMessages)
+
+	// all messages are recorded to memory and tee file
+	require.Len(t, memory.Entries(), 8)
+
+	for _, msg := range []string{"info", "debug", "done", "warn", "error", "retry"} {
+		require.Contains(t, writer.writer.String(), msg)
+	}
+
+	t.Run("follow interfaces", func(t *testing.T) {
+		assertFollowAllInterfaces(t, WrapWithQuiet(NewInMemoryLogger()))
+	})
+}
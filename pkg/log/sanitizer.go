@@ -0,0 +1,236 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/name212/govalue"
+)
+
+var (
+	_ baseLogger              = &sanitizingLogger{}
+	_ formatWithNewLineLogger = &sanitizingLogger{}
+	_ Logger                  = &sanitizingLogger{}
+)
+
+// WrapWithSanitizer
+// returns logger which filters all messages with sanitizer before passing them to logger,
+// so sensitive data never hits output and tee file. Should wrap tee logger for filtering tee file.
+// multi-line secrets (PEM private keys and yaml blocks with credentials) are redacted before sanitizer,
+// also when they are written with several Write calls, for example from subprocess output.
+// if sanitizer is nil, KeywordSanitizer with default keywords and default sanitize rules will be used
+func WrapWithSanitizer(logger Logger, sanitizer Sanitizer) Logger {
+	return newSanitizingLogger(logger, sanitizer, newSecretBlockFilter())
+}
+
+func newSanitizingLogger(logger Logger, sanitizer Sanitizer, blocks *secretBlockFilter) *sanitizingLogger {
+	if govalue.IsNil(sanitizer) {
+		sanitizer = NewKeywordSanitizer().WithDefaultSanitizeRules()
+	}
+
+	l := &sanitizingLogger{
+		parent:    logger,
+		sanitizer: sanitizer,
+		blocks:    blocks,
+	}
+
+	l.formatWithNewLineLoggerWrapper = newFormatWithNewLineLoggerWrapper(l)
+
+	return l
+}
+
+// sanitizeString
+// returns filtered message, keeps last new line if sanitizer replace full message
+func sanitizeString(sanitizer Sanitizer, msg string) string {
+	filtered := sanitizer.Filter([]any{msg})
+	if len(filtered) != 1 {
+		return msg
+	}
+
+	res, ok := filtered[0].(string)
+	if !ok {
+		return fmt.Sprintf("%v", filtered[0])
+	}
+
+	if res != msg && strings.HasSuffix(msg, "\n") && !strings.HasSuffix(res, "\n") {
+		res += "\n"
+	}
+
+	return res
+}
+
+type sanitizingLogger struct {
+	*formatWithNewLineLoggerWrapper
+
+	parent    Logger
+	sanitizer Sanitizer
+	// blocks
+	// state of multi-line secrets in content passed to Write
+	blocks *secretBlockFilter
+}
+
+func (l *sanitizingLogger) WithFields(fields map[string]any) Logger {
+	sanitized := maps.Clone(fields)
+	for key, value := range sanitized {
+		if str, ok := value.(string); ok {
+			sanitized[key] = sanitizeString(l.sanitizer, str)
+		}
+	}
+
+	return newSanitizingLogger(l.parent.WithFields(sanitized), l.sanitizer, l.blocks)
+}
+
+func (l *sanitizingLogger) WithField(key string, value any) Logger {
+	return l.WithFields(map[string]any{key: value})
+}
+
+func (l *sanitizingLogger) WithPrefix(prefix string) Logger {
+	return newPrefixLogger(l, prefix)
+}
+
+func (l *sanitizingLogger) ProcessLogger() ProcessLogger {
+	return l.parent.ProcessLogger()
+}
+
+func (l *sanitizingLogger) ProgressLogger() ProgressLogger {
+	return &filteredProgressLogger{
+		parent: l.parent.ProgressLogger(),
+		filter: l.sanitize,
+	}
+}
+
+// SilentLogger
+// silent logger can write to tee file, so it filters messages also
+func (l *sanitizingLogger) SilentLogger() *SilentLogger {
+	return l.parent.SilentLogger().withSanitizer(l.sanitizer)
+}
+
+func (l *sanitizingLogger) BufferLogger(buffer *bytes.Buffer) Logger {
+	return WrapWithSanitizer(l.parent.BufferLogger(buffer), l.sanitizer)
+}
+
+func (l *sanitizingLogger) FlushAndClose() error {
+	return l.parent.FlushAndClose()
+}
+
+func (l *sanitizingLogger) Process(p Process, t string, run func() error) error {
+	return l.parent.Process(p, l.sanitize(t), run)
+}
+
+func (l *sanitizingLogger) InfoFWithoutLn(format string, a ...interface{}) {
+	l.parent.InfoFWithoutLn("%s", l.format(format, a...))
+}
+
+// InfoLn
+// Deprecated:
+// Use InfoF(string) it add \n to end
+func (l *sanitizingLogger) InfoLn(a ...interface{}) {
+	l.parent.InfoLn(l.formatLn(a...))
+}
+
+func (l *sanitizingLogger) ErrorFWithoutLn(format string, a ...interface{}) {
+	l.parent.ErrorFWithoutLn("%s", l.format(format, a...))
+}
+
+// ErrorLn
+// Deprecated:
+// Use ErrorF(string) it add \n to end
+func (l *sanitizingLogger) ErrorLn(a ...interface{}) {
+	l.parent.ErrorLn(l.formatLn(a...))
+}
+
+func (l *sanitizingLogger) DebugFWithoutLn(format string, a ...interface{}) {
+	l.parent.DebugFWithoutLn("%s", l.format(format, a...))
+}
+
+// DebugLn
+// Deprecated:
+// Use DebugF(string) it add \n to end
+func (l *sanitizingLogger) DebugLn(a ...interface{}) {
+	l.parent.DebugLn(l.formatLn(a...))
+}
+
+func (l *sanitizingLogger) WarnFWithoutLn(format string, a ...interface{}) {
+	l.parent.WarnFWithoutLn("%s", l.format(format, a...))
+}
+
+// WarnLn
+// Deprecated:
+// Use WarnF(string) it add \n to end
+func (l *sanitizingLogger) WarnLn(a ...interface{}) {
+	l.parent.WarnLn(l.formatLn(a...))
+}
+
+func (l *sanitizingLogger) Success(s string) {
+	l.parent.Success(l.sanitize(s))
+}
+
+func (l *sanitizingLogger) Fail(s string) {
+	l.parent.Fail(l.sanitize(s))
+}
+
+func (l *sanitizingLogger) FailRetry(s string) {
+	l.parent.FailRetry(l.sanitize(s))
+}
+
+// JSON
+// if content was filtered, filtered message is passed as json string for keeping content valid json
+func (l *sanitizingLogger) JSON(content []byte) {
+	sanitized := l.sanitize(string(content))
+	if sanitized == string(content) {
+		l.parent.JSON(content)
+		return
+	}
+
+	encoded, err := json.Marshal(sanitized)
+	if err != nil {
+		l.parent.DebugF("Cannot marshal sanitized json: %v", err)
+		return
+	}
+
+	l.parent.JSON(encoded)
+}
+
+// Write
+// multi-line secrets are redacted also if they are split between several Write calls
+func (l *sanitizingLogger) Write(content []byte) (int, error) {
+	filtered := l.blocks.filter(string(content))
+	if filtered == "" {
+		return len(content), nil
+	}
+
+	if _, err := l.parent.Write([]byte(sanitizeString(l.sanitizer, filtered))); err != nil {
+		return 0, err
+	}
+
+	return len(content), nil
+}
+
+func (l *sanitizingLogger) sanitize(msg string) string {
+	return sanitizeString(l.sanitizer, redactSecretBlocks(msg))
+}
+
+func (l *sanitizingLogger) format(format string, a ...any) string {
+	return l.sanitize(sprintf(format, a...))
+}
+
+func (l *sanitizingLogger) formatLn(a ...any) string {
+	return trimLn(l.sanitize(fmt.Sprintln(a...)))
+}
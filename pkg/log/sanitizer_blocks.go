@@ -0,0 +1,187 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var (
+	pemPrivateKeyBeginRegexp = regexp.MustCompile(`-----BEGIN ([A-Z0-9 ]*)PRIVATE KEY-----`)
+	pemPrivateKeyEndRegexp   = regexp.MustCompile(`-----END ([A-Z0-9 ]*)PRIVATE KEY-----`)
+	// yamlBlockKeyRegexp
+	// matches yaml key with block scalar like: serviceAccountJSON: |
+	yamlBlockKeyRegexp = regexp.MustCompile(`^([ \t]*)(?:- )?["']?([\w.\-]+)["']?:[ \t]*[|>][-+0-9]*[ \t]*\r?\n?$`)
+)
+
+// secretBlockKeys
+// keys of yaml block scalars with credentials, compared case-insensitively
+var secretBlockKeys = []string{
+	"serviceAccountJSON",
+	"credentials",
+	"privateKey",
+	"sshPrivateKey",
+	"client-key-data",
+	"secretAccessKey",
+	"clientSecret",
+	"password",
+}
+
+// maxPendingSecretLine
+// PEM lines are short, longer partial lines are dropped without keeping
+const maxPendingSecretLine = 1024
+
+type secretBlockState int
+
+const (
+	secretBlockNone secretBlockState = iota
+	secretBlockPEM
+	secretBlockYAML
+)
+
+// secretBlockFilter
+// streaming state machine which redacts multi-line secrets in content split to several chunks:
+// PEM private keys blocks (BEGIN and END lines are kept)
+// and yaml block scalars with credentials like:
+//
+//	serviceAccountJSON: |
+//	  {"type": "service_account", ...}
+//
+// which are replaced with: serviceAccountJSON: [FILTERED]
+// BEGIN line and yaml key line should not be split between chunks
+type secretBlockFilter struct {
+	mu sync.Mutex
+
+	state secretBlockState
+	// indent
+	// indent of yaml key of current block
+	indent    int
+	lineStart bool
+	// pending
+	// last line without new line in PEM block, END line can be split between chunks
+	pending string
+}
+
+func newSecretBlockFilter() *secretBlockFilter {
+	return &secretBlockFilter{
+		lineStart: true,
+	}
+}
+
+// filter
+// returns chunk without secret blocks content, state is kept for next chunk
+func (f *secretBlockFilter) filter(chunk string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.state == secretBlockNone && !strings.Contains(chunk, "PRIVATE KEY-----") && !strings.ContainsAny(chunk, "|>") {
+		if chunk != "" {
+			f.lineStart = strings.HasSuffix(chunk, "\n")
+		}
+
+		return chunk
+	}
+
+	b := strings.Builder{}
+
+	for _, line := range strings.SplitAfter(chunk, "\n") {
+		if line == "" {
+			continue
+		}
+
+		b.WriteString(f.filterLine(line))
+		f.lineStart = strings.HasSuffix(line, "\n")
+	}
+
+	return b.String()
+}
+
+func (f *secretBlockFilter) filterLine(line string) string {
+	switch f.state {
+	case secretBlockPEM:
+		line = f.pending + line
+		f.pending = ""
+
+		loc := pemPrivateKeyEndRegexp.FindStringIndex(line)
+		if loc == nil {
+			if !strings.HasSuffix(line, "\n") && len(line) < maxPendingSecretLine {
+				f.pending = line
+			}
+
+			return ""
+		}
+
+		f.state = secretBlockNone
+
+		return line[loc[0]:]
+	case secretBlockYAML:
+		if !f.lineStart || strings.TrimSpace(line) == "" || lineIndent(line) > f.indent {
+			return ""
+		}
+
+		f.state = secretBlockNone
+	}
+
+	if loc := pemPrivateKeyBeginRegexp.FindStringIndex(line); loc != nil {
+		if !pemPrivateKeyEndRegexp.MatchString(line[loc[1]:]) {
+			f.state = secretBlockPEM
+			return line[:loc[1]] + filteredPlaceholder
+		}
+
+		return line
+	}
+
+	if !f.lineStart {
+		return line
+	}
+
+	match := yamlBlockKeyRegexp.FindStringSubmatch(line)
+	if match == nil || !isSecretBlockKey(match[2]) {
+		return line
+	}
+
+	f.state = secretBlockYAML
+	f.indent = lineIndent(line)
+
+	res := strings.TrimRight(line[:strings.Index(line, ":")+1], "\r\n") + " " + filteredPlaceholder
+	if strings.HasSuffix(line, "\n") {
+		res += "\n"
+	}
+
+	return res
+}
+
+func isSecretBlockKey(key string) bool {
+	return slices.ContainsFunc(secretBlockKeys, func(secretKey string) bool {
+		return strings.EqualFold(secretKey, key)
+	})
+}
+
+func lineIndent(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " \t"))
+}
+
+// redactSecretBlocks
+// redacts multi-line secrets in one message
+func redactSecretBlocks(msg string) string {
+	if !strings.Contains(msg, "\n") {
+		return msg
+	}
+
+	return newSecretBlockFilter().filter(msg)
+}
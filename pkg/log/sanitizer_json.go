@@ -0,0 +1,95 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+var defaultRedactedFields = []string{"data", "stringData", "token"}
+
+// WithJSONRedaction
+// if message with sensitive keyword contains json object (like klog response body),
+// only passed fields (at any level) are replaced with [FILTERED] instead of filtering whole message.
+// if fields are not passed, data, stringData and token are redacted.
+// messages without valid json are filtered fully.
+// Warning! use it only if passed fields cover all sensitive fields of resources with keywords,
+// for example SSHCredentials keeps private key in spec
+func (l *KeywordSanitizer) WithJSONRedaction(fields ...string) *KeywordSanitizer {
+	if len(fields) == 0 {
+		fields = defaultRedactedFields
+	}
+
+	l.redactedFields = make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		l.redactedFields[field] = struct{}{}
+	}
+
+	return l
+}
+
+// redactJSON
+// returns false if json redaction disabled or message does not contain json
+func (l *KeywordSanitizer) redactJSON(msg string) (string, bool) {
+	if len(l.redactedFields) == 0 {
+		return "", false
+	}
+
+	start := strings.IndexAny(msg, "{[")
+	if start < 0 {
+		return "", false
+	}
+
+	content := strings.TrimSpace(msg[start:])
+
+	var obj any
+	if err := json.Unmarshal([]byte(content), &obj); err != nil {
+		return "", false
+	}
+
+	redacted, err := json.Marshal(l.redactValue(obj))
+	if err != nil {
+		return "", false
+	}
+
+	res := msg[:start] + string(redacted)
+	// keep new line for log messages
+	if strings.HasSuffix(msg, "\n") {
+		res += "\n"
+	}
+
+	return res, true
+}
+
+func (l *KeywordSanitizer) redactValue(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, v := range typed {
+			if _, ok := l.redactedFields[key]; ok {
+				typed[key] = filteredPlaceholder
+				continue
+			}
+
+			typed[key] = l.redactValue(v)
+		}
+	case []any:
+		for i, v := range typed {
+			typed[i] = l.redactValue(v)
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeywordSanitizerJSONRedaction(t *testing.T) {
+	tests := []struct {
+		name      string
+		sanitizer *KeywordSanitizer
+		msg       string
+		expected  string
+	}{
+		{
+			name:      "secret",
+			sanitizer: NewKeywordSanitizer().WithJSONRedaction(),
+			msg:       `Response Body: {"kind":"Secret","metadata":{"name":"test"},"data":{"key":"dmFsdWU="},"stringData":{"a":"b"}}`,
+			expected:  `Response Body: {"data":"[FILTERED]","kind":"Secret","metadata":{"name":"test"},"stringData":"[FILTERED]"}`,
+		},
+		{
+			name:      "secret list",
+			sanitizer: NewKeywordSanitizer().WithJSONRedaction(),
+			msg:       `{"kind":"SecretList","items":[{"kind":"Secret","metadata":{"name":"a"},"data":{"key":"dmFsdWU="}}]}`,
+			expected:  `{"items":[{"data":"[FILTERED]","kind":"Secret","metadata":{"name":"a"}}],"kind":"SecretList"}`,
+		},
+		{
+			name:      "custom fields",
+			sanitizer: NewKeywordSanitizer().WithJSONRedaction("sshKey"),
+			msg:       `{"kind":"Secret","data":{"sshKey":"key","user":"ubuntu"}}`,
+			expected:  `{"data":{"sshKey":"[FILTERED]","user":"ubuntu"},"kind":"Secret"}`,
+		},
+		{
+			name:      "keep new line",
+			sanitizer: NewKeywordSanitizer().WithJSONRedaction(),
+			msg:       "{\"kind\":\"Secret\",\"data\":{}}\n",
+			expected:  "{\"data\":\"[FILTERED]\",\"kind\":\"Secret\"}\n",
+		},
+		{
+			name:      "not json",
+			sanitizer: NewKeywordSanitizer().WithJSONRedaction(),
+			msg:       `{"kind":"Secret", data: test`,
+			expected:  filteredMsg(`"kind":"Secret"`),
+		},
+		{
+			name:      "redaction disabled",
+			sanitizer: NewKeywordSanitizer(),
+			msg:       `{"kind":"Secret","data":{"key":"dmFsdWU="}}`,
+			expected:  filteredMsg(`"kind":"Secret"`),
+		},
+		{
+			name:      "not sensitive",
+			sanitizer: NewKeywordSanitizer().WithJSONRedaction(),
+			msg:       `{"kind":"ConfigMap","data":{"key":"value"}}`,
+			expected:  `{"kind":"ConfigMap","data":{"key":"value"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := test.sanitizer.Filter([]any{test.msg})
+			require.Len(t, res, 1)
+			require.Equal(t, test.expected, res[0])
+		})
+	}
+}
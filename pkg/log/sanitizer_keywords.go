@@ -0,0 +1,110 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"strings"
+
+	"k8s.io/klog/v2"
+)
+
+// KeywordException
+// allowlist exception for KeywordSanitizer keywords
+type KeywordException struct {
+	// Keyword
+	// exception is applied to messages matched this keyword only, empty keyword applies to all keywords
+	Keyword string
+	// Allow
+	// returns true if message matched keyword should not be filtered
+	Allow func(msg string) bool
+}
+
+func (e KeywordException) allows(keyword, msg string) bool {
+	if e.Allow == nil || (e.Keyword != "" && e.Keyword != keyword) {
+		return false
+	}
+
+	return e.Allow(msg)
+}
+
+// AllowWithoutFields
+// returns exception which allows messages matched keyword if they do not contain any of json fields,
+// for example for lists of secret names without content:
+//
+//	AllowWithoutFields(`"kind":"Secret"`, "data", "stringData")
+func AllowWithoutFields(keyword string, fields ...string) KeywordException {
+	return KeywordException{
+		Keyword: keyword,
+		Allow: func(msg string) bool {
+			for _, field := range fields {
+				if strings.Contains(msg, `"`+field+`":`) {
+					return false
+				}
+			}
+
+			return true
+		},
+	}
+}
+
+type verbosityKeyword struct {
+	keyword string
+	level   klog.Level
+}
+
+// WithExceptions
+// messages allowed by any exception for matched keyword are not filtered with this keyword,
+// but can be filtered with another keyword
+func (l *KeywordSanitizer) WithExceptions(exceptions ...KeywordException) *KeywordSanitizer {
+	l.exceptions = append(l.exceptions, exceptions...)
+	return l
+}
+
+// WithVerbosityKeywords
+// keywords are checked only if klog verbosity is level or higher,
+// for example klog writes request and response bodies with verbosity 8 and higher
+func (l *KeywordSanitizer) WithVerbosityKeywords(level klog.Level, keywords ...string) *KeywordSanitizer {
+	for _, keyword := range keywords {
+		l.verbosityKeywords = append(l.verbosityKeywords, verbosityKeyword{
+			keyword: keyword,
+			level:   level,
+		})
+	}
+
+	return l
+}
+
+// Test
+// returns true and matched keyword if message is filtered (or redacted with WithJSONRedaction) by keywords,
+// for testing keywords and exceptions against real payloads.
+// sanitize rules are not checked
+func (l *KeywordSanitizer) Test(msg string) (filtered bool, keyword string) {
+	keyword = l.isSensitive(msg)
+	return keyword != "", keyword
+}
+
+func (l *KeywordSanitizer) matchKeyword(keyword, msg string) bool {
+	if !strings.Contains(msg, keyword) {
+		return false
+	}
+
+	for _, exception := range l.exceptions {
+		if exception.allows(keyword, msg) {
+			return false
+		}
+	}
+
+	return true
+}
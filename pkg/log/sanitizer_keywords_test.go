@@ -0,0 +1,90 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/klog/v2"
+)
+
+func TestKeywordSanitizerExceptions(t *testing.T) {
+	sanitizer := NewKeywordSanitizer().WithExceptions(
+		AllowWithoutFields(`"kind":"Secret"`, "data", "stringData"),
+	)
+
+	namesOnly := `{"kind":"Secret","metadata":{"name":"a"}}`
+	filtered, keyword := sanitizer.Test(namesOnly)
+	require.False(t, filtered)
+	require.Empty(t, keyword)
+	require.Equal(t, []any{namesOnly}, sanitizer.Filter([]any{namesOnly}))
+
+	withData := `{"kind":"Secret","metadata":{"name":"a"},"data":{"key":"dmFsdWU="}}`
+	filtered, keyword = sanitizer.Test(withData)
+	require.True(t, filtered)
+	require.Equal(t, `"kind":"Secret"`, keyword)
+	require.Equal(t, []any{filteredMsg(`"kind":"Secret"`)}, sanitizer.Filter([]any{withData}))
+
+	// exception is applied only for its keyword
+	moduleConfig := `{"kind":"ModuleConfig","metadata":{"name":"a"}}`
+	filtered, keyword = sanitizer.Test(moduleConfig)
+	require.True(t, filtered)
+	require.Equal(t, `"kind":"ModuleConfig"`, keyword)
+
+	t.Run("exception for all keywords", func(t *testing.T) {
+		sanitizer := NewKeywordSanitizer().WithExceptions(KeywordException{
+			Allow: func(msg string) bool {
+				return msg == moduleConfig
+			},
+		})
+
+		filtered, _ := sanitizer.Test(moduleConfig)
+		require.False(t, filtered)
+
+		filtered, _ = sanitizer.Test(namesOnly)
+		require.True(t, filtered)
+	})
+}
+
+func TestKeywordSanitizerVerbosityKeywords(t *testing.T) {
+	setVerbosity := func(t *testing.T, v string) {
+		flags := &flag.FlagSet{}
+		klog.InitFlags(flags)
+		require.NoError(t, flags.Set("v", v))
+	}
+
+	t.Cleanup(func() {
+		setVerbosity(t, "0")
+	})
+
+	sanitizer := NewKeywordSanitizer().WithVerbosityKeywords(8, `"kind":"ConfigMap"`)
+	msg := `{"kind":"ConfigMap","data":{"a":"b"}}`
+
+	setVerbosity(t, "4")
+	filtered, _ := sanitizer.Test(msg)
+	require.False(t, filtered)
+
+	setVerbosity(t, "8")
+	filtered, keyword := sanitizer.Test(msg)
+	require.True(t, filtered)
+	require.Equal(t, `"kind":"ConfigMap"`, keyword)
+
+	// default keywords are checked with any verbosity
+	setVerbosity(t, "0")
+	filtered, _ = sanitizer.Test(`{"kind":"Secret"}`)
+	require.True(t, filtered)
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrapWithSanitizer(t *testing.T) {
+	const (
+		secret   = `{"kind":"Secret","data":{"token":"dG9rZW4="}}`
+		filtered = `[FILTERED - "kind":"Secret"]`
+	)
+
+	getLoggers := func(t *testing.T) (Logger, *InMemoryLogger, *testWriterCloser) {
+		parent := NewInMemoryLogger()
+		writer := newTestWriterCloser()
+
+		tee, err := NewTeeLogger(parent, writer, 1024)
+		require.NoError(t, err)
+
+		return WrapWithSanitizer(tee, nil), parent, writer
+	}
+
+	assertNotLeaked := func(t *testing.T, parent *InMemoryLogger, writer *testWriterCloser, logger Logger) {
+		require.NoError(t, logger.FlushAndClose())
+
+		assertInBuffer(t, writer.writer, "dG9rZW4=", false)
+		assertInBuffer(t, writer.writer, filtered, true)
+
+		for _, entry := range parent.Entries() {
+			require.NotContains(t, entry.String(), "dG9rZW4=")
+		}
+	}
+
+	t.Run("format functions", func(t *testing.T) {
+		logger, parent, writer := getLoggers(t)
+
+		logger.InfoF("got resource %s", secret)
+		logger.DebugF("got resource %s", secret)
+		logger.WarnLn("got resource", secret)
+		logger.ErrorFWithoutLn("got resource %s", secret)
+		logger.Success(secret)
+		logger.WithField("resource", secret).InfoF("with field")
+
+		match, err := parent.FirstMatch(&Match{Prefix: []string{filtered}})
+		require.NoError(t, err)
+		require.Equal(t, filtered+"\n", match, "last new line should be kept")
+
+		match, err = parent.FirstMatch(&Match{Prefix: []string{"with field"}})
+		require.NoError(t, err)
+		require.Contains(t, match, filtered)
+
+		assertNotLeaked(t, parent, writer, logger)
+	})
+
+	t.Run("json and write", func(t *testing.T) {
+		logger, parent, writer := getLoggers(t)
+
+		logger.JSON([]byte(secret))
+
+		n, err := logger.Write([]byte(secret))
+		require.NoError(t, err)
+		require.Equal(t, len(secret), n)
+
+		entries := parent.Entries()
+		require.Len(t, entries, 2)
+		require.True(t, json.Valid([]byte(entries[0].Message)), "json should be valid after filtering")
+
+		assertNotLeaked(t, parent, writer, logger)
+	})
+
+	t.Run("silent logger writes filtered messages to tee file", func(t *testing.T) {
+		logger, parent, writer := getLoggers(t)
+
+		logger.SilentLogger().InfoF("got resource %s", secret)
+
+		assertNotLeaked(t, parent, writer, logger)
+	})
+
+	t.Run("not sensitive messages are passed as is", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := WrapWithSanitizer(NewPrettyLogger(LoggerOptions{OutStream: buf}), NewKeywordSanitizer())
+
+		logger.InfoF("%d%% done", 50)
+		logger.JSON([]byte(`{"kind":"ConfigMap"}`))
+
+		assertInBuffer(t, buf, "50% done\n", true)
+		assertInBuffer(t, buf, `"kind": "ConfigMap"`, true)
+	})
+
+	t.Run("follow interfaces", func(t *testing.T) {
+		assertFollowAllInterfaces(t, WrapWithSanitizer(NewSimpleLogger(LoggerOptions{IsDebug: true}), nil))
+	})
+}
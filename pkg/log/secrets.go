@@ -0,0 +1,184 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+)
+
+const secretMask = "***"
+
+var (
+	_ Sanitizer = &SecretsRegistry{}
+
+	defaultSecretsRegistry = NewSecretsRegistry()
+)
+
+// SecretsRegistry
+// contains concrete secret values (sudo password, ssh passphrase, registry password)
+// all exact occurrences of registered values in messages are replaced with ***
+// values registered in DefaultSecretsRegistry are masked by all loggers in this package
+type SecretsRegistry struct {
+	m       sync.RWMutex
+	secrets map[string]struct{}
+	// sorted by length desc for replacing longest values first
+	sorted []string
+}
+
+func NewSecretsRegistry() *SecretsRegistry {
+	return &SecretsRegistry{
+		secrets: make(map[string]struct{}),
+		sorted:  make([]string, 0),
+	}
+}
+
+// DefaultSecretsRegistry
+// registry used by all loggers in this package
+func DefaultSecretsRegistry() *SecretsRegistry {
+	return defaultSecretsRegistry
+}
+
+// RegisterSecrets
+// register values in DefaultSecretsRegistry
+func RegisterSecrets(values ...string) {
+	defaultSecretsRegistry.Register(values...)
+}
+
+// Register
+// empty values are ignored
+func (r *SecretsRegistry) Register(values ...string) *SecretsRegistry {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+
+		r.secrets[value] = struct{}{}
+	}
+
+	r.sort()
+
+	return r
+}
+
+func (r *SecretsRegistry) Unregister(values ...string) *SecretsRegistry {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	for _, value := range values {
+		delete(r.secrets, value)
+	}
+
+	r.sort()
+
+	return r
+}
+
+// Reset
+// unregister all values
+func (r *SecretsRegistry) Reset() {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.secrets = make(map[string]struct{})
+	r.sort()
+}
+
+// Mask
+// replaces all registered values in msg with ***
+func (r *SecretsRegistry) Mask(msg string) string {
+	r.m.RLock()
+	defer r.m.RUnlock()
+
+	for _, secret := range r.sorted {
+		msg = strings.ReplaceAll(msg, secret, secretMask)
+	}
+
+	return msg
+}
+
+func (r *SecretsRegistry) Filter(args []any) []any {
+	if r.empty() {
+		return args
+	}
+
+	for i, arg := range args {
+		if str, ok := arg.(string); ok {
+			args[i] = r.Mask(str)
+		}
+	}
+
+	return args
+}
+
+func (r *SecretsRegistry) FilterF(format string, args []any) (string, []any) {
+	return r.Mask(format), r.Filter(args)
+}
+
+func (r *SecretsRegistry) FilterS(msg string, keysAndValues []any) (string, []any) {
+	return r.Mask(msg), r.Filter(keysAndValues)
+}
+
+func (r *SecretsRegistry) empty() bool {
+	r.m.RLock()
+	defer r.m.RUnlock()
+
+	return len(r.sorted) == 0
+}
+
+func (r *SecretsRegistry) sort() {
+	r.sorted = slices.SortedFunc(maps.Keys(r.secrets), func(a, b string) int {
+		if res := cmp.Compare(len(b), len(a)); res != 0 {
+			return res
+		}
+
+		return strings.Compare(a, b)
+	})
+}
+
+// maskSecrets
+// masks values registered in DefaultSecretsRegistry
+func maskSecrets(msg string) string {
+	if defaultSecretsRegistry.empty() {
+		return msg
+	}
+
+	return defaultSecretsRegistry.Mask(msg)
+}
+
+// maskSecretsF
+// returns format and args as is if no secrets registered for keeping formatting without changes
+func maskSecretsF(format string, a []any) (string, []any) {
+	if defaultSecretsRegistry.empty() {
+		return format, a
+	}
+
+	return "%s", []any{defaultSecretsRegistry.Mask(sprintf(format, a...))}
+}
+
+func maskSecretsLn(a []any) []any {
+	if defaultSecretsRegistry.empty() {
+		return a
+	}
+
+	return []any{defaultSecretsRegistry.Mask(trimLn(fmt.Sprintln(a...)))}
+}
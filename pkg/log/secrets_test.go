@@ -0,0 +1,93 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretsRegistry(t *testing.T) {
+	registry := NewSecretsRegistry().Register("pass", "password", "")
+
+	require.Equal(t, "sudo -S *** && echo ***", registry.Mask("sudo -S password && echo pass"))
+
+	format, args := registry.FilterF("login %s password", []any{"with pass", 1})
+	require.Equal(t, "login %s ***", format)
+	require.Equal(t, []any{"with ***", 1}, args)
+
+	registry.Unregister("password")
+	require.Equal(t, "***word", registry.Mask("password"))
+
+	registry.Reset()
+	require.Equal(t, "password", registry.Mask("password"))
+}
+
+func TestLoggersMaskRegisteredSecrets(t *testing.T) {
+	const secret = "Sup3rS3cret"
+
+	RegisterSecrets(secret)
+	t.Cleanup(DefaultSecretsRegistry().Reset)
+
+	t.Run("pretty", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := NewPrettyLogger(LoggerOptions{OutStream: buf, IsDebug: true})
+
+		logger.InfoF("echo %s | sudo -S true", secret)
+		logger.DebugLn("passphrase", secret)
+		logger.Success("password " + secret)
+		logger.WithField("password", secret).WarnF("with field")
+
+		assertInBuffer(t, buf, secret, false)
+		assertInBuffer(t, buf, "echo *** | sudo -S true\n", true)
+		assertInBuffer(t, buf, "passphrase ***\n", true)
+		assertInBuffer(t, buf, "fields: [password='***']", true)
+	})
+
+	t.Run("simple", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := NewSimpleLogger(LoggerOptions{OutStream: buf})
+
+		logger.InfoF("echo %s | sudo -S true", secret)
+		logger.WithField("password", secret).InfoF("with field")
+		_, err := logger.Write([]byte(secret))
+		require.NoError(t, err)
+
+		assertInBuffer(t, buf, secret, false)
+		assertInBuffer(t, buf, `"password":"***"`, true)
+	})
+
+	t.Run("tee file and in memory", func(t *testing.T) {
+		parent := NewInMemoryLogger()
+		writer := newTestWriterCloser()
+
+		tee, err := NewTeeLogger(parent, writer, 1024)
+		require.NoError(t, err)
+
+		tee.InfoF("echo %s", secret)
+		tee.SilentLogger().InfoF("silent %s", secret)
+		tee.JSON([]byte(`{"password":"` + secret + `"}`))
+		require.NoError(t, tee.FlushAndClose())
+
+		assertInBuffer(t, writer.writer, secret, false)
+		assertInBuffer(t, writer.writer, "silent ***", true)
+
+		for _, entry := range parent.Entries() {
+			require.NotContains(t, entry.String(), secret)
+		}
+	})
+}
@@ -0,0 +1,135 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+var _ io.Writer = &SeverityWriter{}
+
+// SeverityRule
+// if line matches Regex it will be written with Level
+type SeverityRule struct {
+	Regex *regexp.Regexp
+	Level Level
+}
+
+// DefaultSeverityRules
+// rules for klog headers (E0912, W0912), logfmt (level=warning),
+// terraform ([ERROR], Error:) and common (ERROR, WARN) output
+func DefaultSeverityRules() []SeverityRule {
+	return []SeverityRule{
+		{Regex: regexp.MustCompile(`^[EF]\d{4} `), Level: LevelError},
+		{Regex: regexp.MustCompile(`^W\d{4} `), Level: LevelWarn},
+		{Regex: regexp.MustCompile(`^I\d{4} `), Level: LevelInfo},
+		{Regex: regexp.MustCompile(`(?i)\blevel="?(error|err|fatal|panic)\b`), Level: LevelError},
+		{Regex: regexp.MustCompile(`(?i)\blevel="?(warning|warn)\b`), Level: LevelWarn},
+		{Regex: regexp.MustCompile(`(?i)\blevel="?(debug|trace)\b`), Level: LevelDebug},
+		{Regex: regexp.MustCompile(`(?i)\blevel="?info\b`), Level: LevelInfo},
+		{Regex: regexp.MustCompile(`\[(ERROR|FATAL|PANIC)\]|\b(ERROR|FATAL|PANIC)\b|(^|[\s│|])Error:`), Level: LevelError},
+		{Regex: regexp.MustCompile(`\[(WARN|WARNING)\]|\b(WARN|WARNING)\b|(^|[\s│|])Warning:`), Level: LevelWarn},
+		{Regex: regexp.MustCompile(`\[(DEBUG|TRACE)\]`), Level: LevelDebug},
+	}
+}
+
+// SeverityWriter
+// splits written content to lines and writes every line to logger
+// with level of first matched rule, lines without matched rules are written with info level
+// use it for routing output of external tools (terraform, kubeadm) to right logger level
+type SeverityWriter struct {
+	logger Logger
+	rules  []SeverityRule
+
+	mu      sync.Mutex
+	partial []byte
+}
+
+// NewSeverityWriter
+// if rules are nil, DefaultSeverityRules are used
+func NewSeverityWriter(logger Logger, rules []SeverityRule) *SeverityWriter {
+	if rules == nil {
+		rules = DefaultSeverityRules()
+	}
+
+	return &SeverityWriter{
+		logger: logger,
+		rules:  rules,
+	}
+}
+
+// Write
+// last line without new line is kept until next Write or Flush
+func (w *SeverityWriter) Write(content []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.partial = append(w.partial, content...)
+
+	for {
+		i := bytes.IndexByte(w.partial, '\n')
+		if i < 0 {
+			break
+		}
+
+		w.writeLine(string(w.partial[:i]))
+		w.partial = w.partial[i+1:]
+	}
+
+	return len(content), nil
+}
+
+// Flush
+// writes last line without new line
+func (w *SeverityWriter) Flush() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.partial) > 0 {
+		w.writeLine(string(w.partial))
+		w.partial = nil
+	}
+}
+
+// Level
+// returns level of first matched rule for line or LevelInfo
+func (w *SeverityWriter) Level(line string) Level {
+	for _, rule := range w.rules {
+		if rule.Regex != nil && rule.Regex.MatchString(line) {
+			return rule.Level
+		}
+	}
+
+	return LevelInfo
+}
+
+func (w *SeverityWriter) writeLine(line string) {
+	line = strings.TrimSuffix(line, "\r")
+
+	switch w.Level(line) {
+	case LevelError:
+		w.logger.ErrorF("%s", line)
+	case LevelWarn:
+		w.logger.WarnF("%s", line)
+	case LevelDebug:
+		w.logger.DebugF("%s", line)
+	default:
+		w.logger.InfoF("%s", line)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeverityWriterLevel(t *testing.T) {
+	writer := NewSeverityWriter(NewInMemoryLogger(), nil)
+
+	tests := []struct {
+		line  string
+		level Level
+	}{
+		{line: `E0912 10:00:00.000000    1 reflector.go:138] failed to list`, level: LevelError},
+		{line: `F0912 10:00:00.000000    1 main.go:10] fatal`, level: LevelError},
+		{line: `W0912 10:00:00.000000    1 warnings.go:70] v1beta1 is deprecated`, level: LevelWarn},
+		{line: `I0912 10:00:00.000000    1 main.go:10] ERROR in message of info line`, level: LevelInfo},
+		{line: `time="2024-01-01" level=warning msg="deprecated"`, level: LevelWarn},
+		{line: `level=error msg="failed"`, level: LevelError},
+		{line: `level=debug msg="details"`, level: LevelDebug},
+		{line: `2024-01-01T00:00:00.000Z [ERROR] provider: plugin failed`, level: LevelError},
+		{line: `2024-01-01T00:00:00.000Z [WARN]  unexpected data`, level: LevelWarn},
+		{line: `│ Error: Invalid provider configuration`, level: LevelError},
+		{line: `Warning: Argument is deprecated`, level: LevelWarn},
+		{line: `[preflight] Running pre-flight checks`, level: LevelInfo},
+		{line: `Apply complete! Resources: 0 added, 0 changed, 0 destroyed, no errors`, level: LevelInfo},
+	}
+
+	for _, test := range tests {
+		t.Run(test.line, func(t *testing.T) {
+			require.Equal(t, test.level, writer.Level(test.line))
+		})
+	}
+}
+
+func TestSeverityWriter(t *testing.T) {
+	t.Run("route lines", func(t *testing.T) {
+		logger := NewInMemoryLogger()
+		writer := NewSeverityWriter(logger, nil)
+
+		n, err := writer.Write([]byte("first line\n[ERROR] second"))
+		require.NoError(t, err)
+		require.Equal(t, 25, n)
+
+		_, err = writer.Write([]byte(" line\r\nWarning: third line\nlast"))
+		require.NoError(t, err)
+
+		writer.Flush()
+
+		entries := logger.Entries()
+		require.Len(t, entries, 4)
+
+		require.Equal(t, LevelInfo, entries[0].Level)
+		require.Equal(t, "first line\n", entries[0].Message)
+		require.Equal(t, LevelError, entries[1].Level)
+		require.Equal(t, "[ERROR] second line\n", entries[1].Message)
+		require.Equal(t, LevelWarn, entries[2].Level)
+		require.Equal(t, LevelInfo, entries[3].Level)
+		require.Equal(t, "last\n", entries[3].Message)
+	})
+
+	t.Run("custom rules", func(t *testing.T) {
+		logger := NewInMemoryLogger()
+		writer := NewSeverityWriter(logger, []SeverityRule{
+			{Regex: regexp.MustCompile(`^\[kubelet-check\]`), Level: LevelWarn},
+		})
+
+		_, err := writer.Write([]byte("[kubelet-check] It seems like the kubelet isn't running\nERROR other\n"))
+		require.NoError(t, err)
+
+		entries := logger.Entries()
+		require.Len(t, entries, 2)
+		require.Equal(t, LevelWarn, entries[0].Level)
+		require.Equal(t, LevelInfo, entries[1].Level)
+	})
+}
@@ -18,6 +18,8 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+
+	"github.com/name212/govalue"
 )
 
 var (
@@ -30,7 +32,8 @@ var (
 type SilentLogger struct {
 	*formatWithNewLineLoggerWrapper
 
-	t *TeeLogger
+	t         *TeeLogger
+	sanitizer Sanitizer
 }
 
 func NewSilentLogger() *SilentLogger {
@@ -47,10 +50,55 @@ func newSilentLoggerWithTee(t *TeeLogger) *SilentLogger {
 	return l
 }
 
+// withSanitizer
+// returns silent logger which filters messages before writing to tee file
+func (d *SilentLogger) withSanitizer(sanitizer Sanitizer) *SilentLogger {
+	l := newSilentLoggerWithTee(d.t)
+	l.sanitizer = sanitizer
+
+	return l
+}
+
+func (d *SilentLogger) writeToTee(content string) {
+	if d.t == nil {
+		return
+	}
+
+	if !govalue.IsNil(d.sanitizer) {
+		content = sanitizeString(d.sanitizer, content)
+	}
+
+	content = maskSecrets(content)
+
+	d.t.writeToFile(content)
+}
+
+// writeToTeeF
+// message is not formatted without tee
+func (d *SilentLogger) writeToTeeF(format string, a ...any) {
+	if d.t == nil {
+		return
+	}
+
+	d.writeToTee(sprintf(format, a...))
+}
+
+func (d *SilentLogger) writeToTeeLn(a ...any) {
+	if d.t == nil {
+		return
+	}
+
+	d.writeToTee(fmt.Sprintln(a...))
+}
+
 func (d *SilentLogger) ProcessLogger() ProcessLogger {
 	return newWrappedProcessLogger(d)
 }
 
+func (d *SilentLogger) ProgressLogger() ProgressLogger {
+	return newWrappedProgressLogger(d)
+}
+
 func (d *SilentLogger) SilentLogger() *SilentLogger {
 	return NewSilentLogger()
 }
@@ -59,8 +107,32 @@ func (d *SilentLogger) BufferLogger(buffer *bytes.Buffer) Logger {
 	return d
 }
 
-func (d *SilentLogger) Process(_ Process, t string, run func() error) error {
-	err := run()
+// WithFields
+// silent logger writes messages only to tee file, fields are added as suffix for them
+func (d *SilentLogger) WithFields(fields map[string]any) Logger {
+	if d.t == nil {
+		return d
+	}
+
+	return newFieldsLogger(d, fields)
+}
+
+func (d *SilentLogger) WithField(key string, value any) Logger {
+	return d.WithFields(map[string]any{key: value})
+}
+
+// WithPrefix
+// silent logger writes messages only to tee file, prefix is added for them
+func (d *SilentLogger) WithPrefix(prefix string) Logger {
+	if d.t == nil {
+		return d
+	}
+
+	return newPrefixLogger(d, prefix)
+}
+
+func (d *SilentLogger) Process(p Process, t string, run func() error) error {
+	_, err := runProcess(p, t, run)
 	return err
 }
 
@@ -69,92 +141,66 @@ func (d *SilentLogger) FlushAndClose() error {
 }
 
 func (d *SilentLogger) InfoFWithoutLn(format string, a ...interface{}) {
-	if d.t != nil {
-		d.t.writeToFile(fmt.Sprintf(format, a...))
-	}
+	d.writeToTeeF(format, a...)
 }
 
 // InfoLn
 // Deprecated:
 // Use InfoF(string) it add \n to end
 func (d *SilentLogger) InfoLn(a ...interface{}) {
-	if d.t != nil {
-		d.t.writeToFile(fmt.Sprintln(a...))
-	}
+	d.writeToTeeLn(a...)
 }
 
 func (d *SilentLogger) ErrorFWithoutLn(format string, a ...interface{}) {
-	if d.t != nil {
-		d.t.writeToFile(fmt.Sprintf(format, a...))
-	}
+	d.writeToTeeF(format, a...)
 }
 
 // ErrorLn
 // Deprecated:
 // Use ErrorF(string) it add \n to end
 func (d *SilentLogger) ErrorLn(a ...interface{}) {
-	if d.t != nil {
-		d.t.writeToFile(fmt.Sprintln(a...))
-	}
+	d.writeToTeeLn(a...)
 }
 
 func (d *SilentLogger) DebugFWithoutLn(format string, a ...interface{}) {
-	if d.t != nil {
-		d.t.writeToFile(fmt.Sprintf(format, a...))
-	}
+	d.writeToTeeF(format, a...)
 }
 
 // DebugLn
 // Deprecated:
 // Use DebugF(string) it add \n to end
 func (d *SilentLogger) DebugLn(a ...interface{}) {
-	if d.t != nil {
-		d.t.writeToFile(fmt.Sprintln(a...))
-	}
+	d.writeToTeeLn(a...)
 }
 
 func (d *SilentLogger) Success(l string) {
-	if d.t != nil {
-		d.t.writeToFile(l)
-	}
+	d.writeToTee(l)
 }
 
 func (d *SilentLogger) Fail(l string) {
-	if d.t != nil {
-		d.t.writeToFile(l)
-	}
+	d.writeToTee(l)
 }
 
 func (d *SilentLogger) FailRetry(l string) {
-	if d.t != nil {
-		d.t.writeToFile(l)
-	}
+	d.writeToTee(l)
 }
 
 // WarnLn
 // Deprecated:
 // Use WarnF(string) it add \n to end
 func (d *SilentLogger) WarnLn(a ...interface{}) {
-	if d.t != nil {
-		d.t.writeToFile(fmt.Sprintln(a...))
-	}
+	d.writeToTeeLn(a...)
 }
 
 func (d *SilentLogger) WarnFWithoutLn(format string, a ...interface{}) {
-	if d.t != nil {
-		d.t.writeToFile(fmt.Sprintf(format, a...))
-	}
+	d.writeToTeeF(format, a...)
 }
 
 func (d *SilentLogger) JSON(content []byte) {
-	if d.t != nil {
-		d.t.writeToFile(string(content))
-	}
+	d.writeToTee(string(content))
 }
 
 func (d *SilentLogger) Write(content []byte) (int, error) {
-	if d.t != nil {
-		d.t.writeToFile(string(content))
-	}
+	d.writeToTee(string(content))
 	return len(content), nil
 }
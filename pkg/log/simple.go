@@ -18,8 +18,10 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"os"
 
 	"github.com/deckhouse/deckhouse/pkg/log"
+	"github.com/name212/govalue"
 )
 
 var (
@@ -32,80 +34,223 @@ var (
 type SimpleLogger struct {
 	*formatWithNewLineLoggerWrapper
 
-	logger  *log.Logger
-	isDebug bool
+	logger *log.Logger
+	// errLogger
+	// logger for errors and warnings, same as logger if ErrStream is not set
+	errLogger *log.Logger
+	isDebug   bool
+	format    SimpleFormat
+	colors    bool
+	fields    map[string]any
+
+	operationID string
 }
 
+// NewSimpleLogger
+// uses OutStream, ErrStream, IsDebug, SimpleFormat, ColorMode, OperationID and Fields options
+// colors are used only with SimpleFormatText
+// records fields are described in JSONField* constants
 func NewSimpleLogger(opts LoggerOptions) *SimpleLogger {
-	//todo: now unused, need change formatter to text when our slog implementation will support it
-	l := log.NewLogger()
+	format := opts.SimpleFormat
+	if format != SimpleFormatText {
+		format = SimpleFormatJSON
+	}
 
-	if opts.OutStream != nil {
-		l.SetOutput(opts.OutStream)
+	colors := format == SimpleFormatText && colorsEnabled(opts.ColorMode, opts.OutStream)
+	if colors && opts.ColorMode == ColorModeAlways {
+		forceColors()
 	}
 
-	if opts.IsDebug {
-		l.SetLevel(log.LevelDebug)
+	l := newSimpleSlogLogger(opts, format, colors, opts.OutStream)
+
+	errLogger := l
+	if !govalue.IsNil(opts.ErrStream) {
+		errLogger = newSimpleSlogLogger(opts, format, colors, opts.ErrStream)
 	}
 
 	res := &SimpleLogger{
-		logger:  l,
-		isDebug: opts.IsDebug,
+		logger:      l,
+		errLogger:   errLogger,
+		isDebug:     opts.IsDebug,
+		format:      format,
+		colors:      colors,
+		operationID: opts.OperationID,
 	}
 
 	res.formatWithNewLineLoggerWrapper = newFormatWithNewLineLoggerWrapper(res)
 
+	if len(opts.Fields) > 0 {
+		return res.withFields(opts.Fields)
+	}
+
 	return res
 }
 
+func newSimpleSlogLogger(opts LoggerOptions, format SimpleFormat, colors bool, out io.Writer) *log.Logger {
+	handlerType := log.JSONHandlerType
+	if format == SimpleFormatText {
+		handlerType = log.TextHandlerType
+	}
+
+	l := log.NewLogger(log.WithHandlerType(handlerType))
+
+	if colors {
+		if out == nil {
+			out = os.Stdout
+		}
+
+		out = &levelColorWriter{out: out}
+	}
+
+	if out != nil {
+		l.SetOutput(out)
+	}
+
+	if opts.IsDebug {
+		l.SetLevel(log.LevelDebug)
+	}
+
+	if opts.OperationID != "" {
+		l = l.With(JSONFieldOperation, opts.OperationID)
+	}
+
+	return l
+}
+
 func (d *SimpleLogger) BufferLogger(buffer *bytes.Buffer) Logger {
-	return NewJSONLogger(LoggerOptions{OutStream: buffer, IsDebug: d.isDebug})
+	// buffer is not terminal, but content of buffer will be written to output of this logger
+	colorMode := ColorModeNever
+	if d.colors {
+		colorMode = ColorModeAlways
+	}
+
+	return NewSimpleLogger(LoggerOptions{
+		OutStream:    buffer,
+		IsDebug:      d.isDebug,
+		SimpleFormat: d.format,
+		ColorMode:    colorMode,
+		OperationID:  d.operationID,
+		Fields:       d.fields,
+	})
 }
 
 func (d *SimpleLogger) ProcessLogger() ProcessLogger {
 	return newWrappedProcessLogger(d)
 }
 
+func (d *SimpleLogger) ProgressLogger() ProgressLogger {
+	return newSimpleProgressLogger(d)
+}
+
 func (d *SimpleLogger) SilentLogger() *SilentLogger {
 	return NewSilentLogger()
 }
 
+// WithFields
+// fields are emitted as structured fields of log record,
+// fields with names of stable fields are emitted with prefix like: field_status,
+// operation id passed with WithOperationID is emitted in operation field
+func (d *SimpleLogger) WithFields(fields map[string]any) Logger {
+	return d.withFields(fields)
+}
+
+func (d *SimpleLogger) withFields(fields map[string]any) *SimpleLogger {
+	fields, operationID := extractOperationID(fields)
+
+	args := make([]any, 0, 2*len(fields)+2)
+	if operationID != "" {
+		args = append(args, JSONFieldOperation, operationID)
+	} else {
+		operationID = d.operationID
+	}
+
+	for _, key := range sortedFieldsKeys(fields) {
+		value := fields[key]
+		if str, ok := value.(string); ok {
+			value = maskSecrets(str)
+		}
+
+		args = append(args, jsonFieldKey(key), value)
+	}
+
+	l := d.logger.With(args...)
+
+	errLogger := l
+	if d.errLogger != d.logger {
+		errLogger = d.errLogger.With(args...)
+	}
+
+	res := &SimpleLogger{
+		logger:      l,
+		errLogger:   errLogger,
+		isDebug:     d.isDebug,
+		format:      d.format,
+		colors:      d.colors,
+		fields:      mergeFields(d.fields, fields),
+		operationID: operationID,
+	}
+
+	res.formatWithNewLineLoggerWrapper = newFormatWithNewLineLoggerWrapper(res)
+
+	return res
+}
+
+func (d *SimpleLogger) WithField(key string, value any) Logger {
+	return d.WithFields(map[string]any{key: value})
+}
+
+func (d *SimpleLogger) WithPrefix(prefix string) Logger {
+	return newPrefixLogger(d, prefix)
+}
+
 func (d *SimpleLogger) FlushAndClose() error {
 	return nil
 }
 
 func (d *SimpleLogger) Process(p Process, t string, run func() error) error {
-	d.logger.With("action", "start").With("process", string(p)).Info(t)
-	err := run()
-	d.logger.With("action", "end").With("process", string(p)).Info(t)
+	t = maskSecrets(t)
+
+	d.logger.With(JSONFieldAction, "start").With(JSONFieldProcess, string(p)).Info(d.message(t))
+	duration, err := runProcess(p, t, run)
+	d.logger.With(JSONFieldAction, "end").With(JSONFieldProcess, string(p)).Info(d.message(fmt.Sprintf("%s %s", t, formatProcessDuration(duration))))
 	return err
 }
 
 func (d *SimpleLogger) InfoFWithoutLn(format string, a ...interface{}) {
-	d.logger.Info(fmt.Sprintf(format, a...))
+	format, a = maskSecretsF(format, a)
+
+	d.logger.Info(d.message(sprintf(format, a...)))
 }
 
 // InfoLn
 // Deprecated:
 // Use InfoF(string) it add \n to end
 func (d *SimpleLogger) InfoLn(a ...interface{}) {
-	d.logger.Info(listToString(a))
+	a = maskSecretsLn(a)
+
+	d.logger.Info(d.message(listToString(a)))
 }
 
 func (d *SimpleLogger) ErrorFWithoutLn(format string, a ...interface{}) {
-	d.logger.Error(fmt.Sprintf(format, a...))
+	format, a = maskSecretsF(format, a)
+
+	d.errLogger.Error(d.message(sprintf(format, a...)))
 }
 
 // ErrorLn
 // Deprecated:
 // Use ErrorF(string) it add \n to end
 func (d *SimpleLogger) ErrorLn(a ...interface{}) {
-	d.logger.Error(listToString(a))
+	a = maskSecretsLn(a)
+
+	d.errLogger.Error(d.message(listToString(a)))
 }
 
 func (d *SimpleLogger) DebugFWithoutLn(format string, a ...interface{}) {
+	format, a = maskSecretsF(format, a)
+
 	if d.isDebug {
-		d.logger.Debug(fmt.Sprintf(format, a...))
+		d.logger.Debug(d.message(sprintf(format, a...)))
 	}
 }
 
@@ -113,40 +258,62 @@ func (d *SimpleLogger) DebugFWithoutLn(format string, a ...interface{}) {
 // Deprecated:
 // Use DebugF(string) it add \n to end
 func (d *SimpleLogger) DebugLn(a ...interface{}) {
+	a = maskSecretsLn(a)
+
 	if d.isDebug {
-		d.logger.Debug(listToString(a))
+		d.logger.Debug(d.message(listToString(a)))
 	}
 }
 
 func (d *SimpleLogger) Success(l string) {
-	d.logger.With("status", "SUCCESS").Info(l)
+	l = maskSecrets(l)
+
+	d.logger.With(JSONFieldStatus, "SUCCESS").Info(d.message(l))
 }
 
 func (d *SimpleLogger) Fail(l string) {
-	d.logger.With("status", "FAIL").Error(l)
+	l = maskSecrets(l)
+
+	d.errLogger.With(JSONFieldStatus, "FAIL").Error(d.message(l))
 }
 
 func (d *SimpleLogger) FailRetry(l string) {
+	l = maskSecrets(l)
+
 	// there used warn log level because in retry cycle we don't want to catch stacktraces which exist as default in Error and Fatal log level of slog logger
-	d.logger.With("status", "FAIL").Warn(l)
+	d.errLogger.With(JSONFieldStatus, "FAIL").Warn(d.message(l))
 }
 
 func (d *SimpleLogger) WarnFWithoutLn(format string, a ...interface{}) {
-	d.logger.Warn(fmt.Sprintf(format, a...))
+	format, a = maskSecretsF(format, a)
+
+	d.errLogger.Warn(d.message(sprintf(format, a...)))
 }
 
 // WarnLn
 // Deprecated:
 // Use WarnF(string) it add \n to end
 func (d *SimpleLogger) WarnLn(a ...interface{}) {
-	d.logger.Warn(listToString(a))
+	a = maskSecretsLn(a)
+
+	d.errLogger.Warn(d.message(listToString(a)))
 }
 
 func (d *SimpleLogger) JSON(content []byte) {
-	d.logger.Info(string(content))
+	d.logger.Info(d.message(maskSecrets(string(content))))
 }
 
 func (d *SimpleLogger) Write(content []byte) (int, error) {
-	d.logger.Info(string(content))
+	d.logger.Info(d.message(maskSecrets(string(content))))
 	return len(content), nil
 }
+
+// message
+// text records are single line, so last new line is trimmed
+func (d *SimpleLogger) message(msg string) string {
+	if d.format != SimpleFormatText {
+		return msg
+	}
+
+	return trimLn(msg)
+}
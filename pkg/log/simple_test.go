@@ -14,8 +14,64 @@
 
 package log
 
-import "testing"
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
 
 func TestSimpleLoggerFollowInterfaces(t *testing.T) {
 	assertFollowAllInterfaces(t, NewSimpleLogger(LoggerOptions{IsDebug: true}))
+	assertFollowAllInterfaces(t, NewSimpleLogger(LoggerOptions{IsDebug: true, SimpleFormat: SimpleFormatText}))
+}
+
+func TestSimpleLoggerTextFormat(t *testing.T) {
+	t.Run("text", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := NewSimpleLogger(LoggerOptions{OutStream: buf, SimpleFormat: SimpleFormatText})
+
+		logger.WithField("node", "master-0").InfoF("connected\n")
+		logger.Fail("failed")
+
+		lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+		require.Len(t, lines, 2)
+		require.Regexp(t, `^\S+ INFO msg='connected' node='master-0' $`, lines[0])
+		require.Regexp(t, `^\S+ ERROR msg='failed' status='FAIL' `, lines[1])
+	})
+
+	t.Run("colored level", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := NewSimpleLogger(LoggerOptions{
+			OutStream:    buf,
+			SimpleFormat: SimpleFormatText,
+			ColorMode:    ColorModeAlways,
+		})
+
+		logger.WarnF("warning")
+
+		require.Contains(t, buf.String(), levelColors["WARN"].Sprint("WARN")+" msg='warning'")
+	})
+
+	t.Run("json logger ignores text format", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := NewJSONLogger(LoggerOptions{OutStream: buf, SimpleFormat: SimpleFormatText})
+
+		logger.InfoF("connected")
+
+		record := make(map[string]any)
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+		require.Equal(t, "connected\n", record["msg"])
+	})
+
+	t.Run("buffer logger keeps format", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := NewSimpleLogger(LoggerOptions{SimpleFormat: SimpleFormatText})
+
+		logger.BufferLogger(buf).InfoF("connected")
+
+		require.Contains(t, buf.String(), "INFO msg='connected'")
+	})
 }
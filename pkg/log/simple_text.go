@@ -0,0 +1,79 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/gookit/color"
+)
+
+type SimpleFormat string
+
+const (
+	// SimpleFormatJSON
+	// every record is json object
+	SimpleFormatJSON SimpleFormat = "json"
+	// SimpleFormatText
+	// every record is line like: 2006-01-02T15:04:05Z INFO msg='message' key='value'
+	// level is colored if colors are enabled (see LoggerOptions.ColorMode)
+	SimpleFormatText SimpleFormat = "text"
+)
+
+var levelColors = map[string]color.Style{
+	"DEBUG": color.New(color.FgGray),
+	"INFO":  color.New(color.FgGreen),
+	"WARN":  color.New(color.FgYellow),
+	"ERROR": color.New(color.FgRed),
+	"FATAL": color.New(color.FgRed, color.Bold),
+}
+
+// levelColorWriter
+// colors level of text records, record is written with one Write call
+// and level is second word in record
+type levelColorWriter struct {
+	out io.Writer
+}
+
+func (w *levelColorWriter) Write(p []byte) (int, error) {
+	start := bytes.IndexByte(p, ' ') + 1
+	if start == 0 {
+		return w.out.Write(p)
+	}
+
+	end := bytes.IndexByte(p[start:], ' ')
+	if end < 0 {
+		return w.out.Write(p)
+	}
+
+	end += start
+
+	style, ok := levelColors[string(p[start:end])]
+	if !ok {
+		return w.out.Write(p)
+	}
+
+	res := make([]byte, 0, len(p)+16)
+	res = append(res, p[:start]...)
+	res = append(res, style.Sprint(string(p[start:end]))...)
+	res = append(res, p[end:]...)
+
+	if _, err := w.out.Write(res); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
@@ -16,17 +16,23 @@ package log
 
 import (
 	"context"
-	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 )
 
+// SLogHandler
+// passes slog records to logger from provider
+// attributes are passed as structured fields with WithFields,
+// attributes in groups have keys qualified by groups names like: group.subgroup.key
 type SLogHandler struct {
 	loggerProvider LoggerProvider
 
-	attrs       []slog.Attr
-	attrsString string
-	group       string
+	fields map[string]any
+	groups []string
+	// groupsPrefix
+	// prefix of attributes keys built from groups once on WithGroup, not on every record
+	groupsPrefix string
 
 	prefix  string
 	isDebug bool
@@ -79,55 +85,75 @@ func (h *SLogHandler) WithDebug(d bool) *SLogHandler {
 func copyHandler(h *SLogHandler) *SLogHandler {
 	return &SLogHandler{
 		loggerProvider: h.loggerProvider,
-		attrsString:    h.attrsString,
-		group:          h.group,
+		fields:         mergeFields(h.fields, nil),
+		groups:         slices.Clone(h.groups),
+		groupsPrefix:   h.groupsPrefix,
 		prefix:         h.prefix,
 		isDebug:        h.isDebug,
 	}
 }
 
-func attrsToString(attrs []slog.Attr) string {
-	if len(attrs) == 0 {
+// buildGroupsPrefix
+// returns prefix for attributes keys from groups like: group.subgroup.
+func buildGroupsPrefix(groups []string) string {
+	if len(groups) == 0 {
 		return ""
 	}
 
-	builder := strings.Builder{}
-	for _, attr := range attrs {
-		builder.WriteString(attr.Key)
-		builder.WriteString("=")
-		builder.WriteString(fmt.Sprintf(`'%s' `, attr.Value.String()))
+	return strings.Join(groups, ".") + "."
+}
+
+// addAttrToFields
+// attributes of group attribute are added with group key prefix,
+// attributes of group with empty key are inlined as slog handlers do
+func addAttrToFields(fields map[string]any, prefix string, attr slog.Attr) {
+	value := attr.Value.Resolve()
+
+	if value.Kind() == slog.KindGroup {
+		groupPrefix := prefix
+		if attr.Key != "" {
+			groupPrefix = prefix + attr.Key + "."
+		}
+
+		for _, a := range value.Group() {
+			addAttrToFields(fields, groupPrefix, a)
+		}
+
+		return
+	}
+
+	if attr.Key == "" {
+		return
 	}
 
-	return fmt.Sprintf(" | attributes: [%s]", strings.TrimRight(builder.String(), " "))
+	fields[prefix+attr.Key] = attrValue(value)
 }
 
-func copyAttrs(attrs []slog.Attr) []slog.Attr {
-	if len(attrs) == 0 {
-		return nil
+// attrValue
+// errors are passed as strings, because them do not marshal to json
+func attrValue(value slog.Value) any {
+	res := value.Any()
+	if err, ok := res.(error); ok {
+		return err.Error()
 	}
 
-	cpy := make([]slog.Attr, len(attrs))
-	copy(cpy, attrs)
-	return cpy
+	return res
 }
 
 func newHandlerWithAttrs(parent *SLogHandler, attrs []slog.Attr) *SLogHandler {
-	a := append(copyAttrs(parent.attrs), attrs...)
-
 	res := copyHandler(parent)
-	res.attrsString = attrsToString(a)
+
+	for _, attr := range attrs {
+		addAttrToFields(res.fields, parent.groupsPrefix, attr)
+	}
 
 	return res
 }
 
 func newHandlerWithGroup(parent *SLogHandler, group string) *SLogHandler {
-	g := group
-	if parent.group != "" {
-		g = parent.group + "/" + group
-	}
-
 	res := copyHandler(parent)
-	res.group = g
+	res.groups = append(res.groups, group)
+	res.groupsPrefix = buildGroupsPrefix(res.groups)
 
 	return res
 }
@@ -143,6 +169,23 @@ func (h *SLogHandler) Enabled(_ context.Context, lvl slog.Level) bool {
 
 func (h *SLogHandler) Handle(_ context.Context, record slog.Record) error {
 	logger := SafeProvideLogger(h.loggerProvider)
+
+	// fields of handler are not changed by loggers, so they are copied only for adding record attributes
+	fields := h.fields
+	if record.NumAttrs() > 0 {
+		fields = mergeFields(h.fields, nil)
+		record.Attrs(func(attr slog.Attr) bool {
+			addAttrToFields(fields, h.groupsPrefix, attr)
+			return true
+		})
+	}
+
+	if len(fields) > 0 {
+		logger = logger.WithFields(fields)
+	}
+
+	logger = logger.WithPrefix(h.prefix)
+
 	write := logger.DebugF
 	switch record.Level {
 	case slog.LevelDebug:
@@ -155,35 +198,23 @@ func (h *SLogHandler) Handle(_ context.Context, record slog.Record) error {
 		write = logger.ErrorF
 	}
 
-	write(h.message(record.Message))
+	write("%s", record.Message)
 
 	return nil
 }
 
 func (h *SLogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+
 	return newHandlerWithAttrs(h, attrs)
 }
 
 func (h *SLogHandler) WithGroup(name string) slog.Handler {
-	return newHandlerWithGroup(h, name)
-}
-
-func (h *SLogHandler) message(msg string) string {
-	totalMsg := strings.Builder{}
-	if h.prefix != "" {
-		totalMsg.WriteString(fmt.Sprintf("%s: %s", h.prefix, msg))
-	} else {
-		totalMsg.WriteString(msg)
-	}
-
-	if h.group != "" {
-		totalMsg.WriteString(fmt.Sprintf(" | groups: '%s'", h.group))
-	}
-
-	if h.attrsString != "" {
-		// h.attrs contains leading space and | before attributes
-		totalMsg.WriteString(h.attrsString)
+	if name == "" {
+		return h
 	}
 
-	return totalMsg.String()
+	return newHandlerWithGroup(h, name)
 }
@@ -15,7 +15,9 @@
 package log
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -201,9 +203,9 @@ func TestDebugLogger(t *testing.T) {
 				}
 
 				const msg = "some message"
-				logger.Info(msg)
+				logger.Info(msg, "key", "value")
 
-				expectedMsg := fmt.Sprintf(`%s | groups: '%s'`, msg, strings.Join(tst.groups, "/"))
+				expectedMsg := fmt.Sprintf(`%s | fields: [%s.key='value']`, msg, strings.Join(tst.groups, "."))
 
 				assertSimpleMessage(t, targetLogger, expectedMsg, true)
 			})
@@ -225,7 +227,7 @@ func TestDebugLogger(t *testing.T) {
 			{
 				name:        "with multiple attributes with different kinds",
 				attrs:       []any{"key", "value with space", "err", fmt.Errorf("error"), "int", 42},
-				attrsSuffix: "[key='value with space' err='error' int='42']",
+				attrsSuffix: "[err='error' int='42' key='value with space']",
 			},
 		}
 
@@ -237,7 +239,7 @@ func TestDebugLogger(t *testing.T) {
 				const msg = "some message"
 				logger.Info(msg)
 
-				expectedMsg := fmt.Sprintf(`%s | attributes: %s`, msg, tst.attrsSuffix)
+				expectedMsg := fmt.Sprintf(`%s | fields: %s`, msg, tst.attrsSuffix)
 
 				assertSimpleMessage(t, targetLogger, expectedMsg, true)
 			})
@@ -247,13 +249,39 @@ func TestDebugLogger(t *testing.T) {
 	t.Run("all in", func(t *testing.T) {
 		logger, targetLogger := testCreateSLogLogger("ssh", true)
 
-		logger = logger.With("key", "value with spaces").WithGroup("my-group")
+		logger = logger.With("key", "value with spaces").WithGroup("my-group").With("attempt", 1)
 
-		logger.Debug("my message")
+		logger.Debug("my message", slog.Group("node", "name", "master-0"), slog.Group("", "inlined", true))
 
-		expectedMsg := `ssh: my message | groups: 'my-group' | attributes: [key='value with spaces']`
+		expectedMsg := `ssh: my message | fields: [key='value with spaces' my-group.attempt='1' my-group.inlined='true' my-group.node.name='master-0']`
 		assertSimpleMessage(t, targetLogger, expectedMsg, true)
 	})
+
+	t.Run("empty group is omitted", func(t *testing.T) {
+		logger, targetLogger := testCreateSLogLogger("", false)
+
+		logger.WithGroup("my-group").Info("message without attrs")
+
+		matches, err := targetLogger.AllMatches(&Match{Prefix: []string{"message without attrs"}})
+		require.NoError(t, err)
+		require.Equal(t, []string{"message without attrs\n"}, matches)
+	})
+
+	t.Run("json output contains structured fields", func(t *testing.T) {
+		buf := bytes.NewBuffer(nil)
+		provider := SimpleLoggerProvider(NewJSONLogger(LoggerOptions{OutStream: buf}))
+		logger := NewSLogWithPrefix(context.TODO(), provider, "")
+
+		logger.WithGroup("ssh").Info("connected 100%", "host", "127.0.0.1", "port", 22, "err", fmt.Errorf("some error"))
+
+		record := make(map[string]any)
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+
+		require.Equal(t, "127.0.0.1", record["ssh.host"])
+		require.Equal(t, float64(22), record["ssh.port"])
+		require.Equal(t, "some error", record["ssh.err"])
+		require.Contains(t, record["msg"], "connected 100%")
+	})
 }
 
 func testCreateSLogLogger(prefix string, isDebug bool) (*slog.Logger, *InMemoryLogger) {
@@ -0,0 +1,92 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+const spinnerInterval = 100 * time.Millisecond
+
+var (
+	emojiSpinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+	textSpinnerFrames  = []string{"|", "/", "-", "\\"}
+)
+
+// spinner
+// redraws terminal line like: ⠋ title (5s) until stop is called
+// line is cleared on stop, so spinner does not leave output
+type spinner struct {
+	out      io.Writer
+	title    string
+	frames   []string
+	interval time.Duration
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+}
+
+func newSpinner(out io.Writer, title string, frames []string, interval time.Duration) *spinner {
+	return &spinner{
+		out:      out,
+		title:    title,
+		frames:   frames,
+		interval: interval,
+		stopCh:   make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}
+}
+
+func (s *spinner) start() {
+	go func() {
+		defer close(s.doneCh)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		started := time.Now()
+
+		for frame := 0; ; frame++ {
+			s.render(s.frames[frame%len(s.frames)], time.Since(started))
+
+			select {
+			case <-s.stopCh:
+				s.clear()
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (s *spinner) stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+
+	<-s.doneCh
+}
+
+func (s *spinner) render(frame string, elapsed time.Duration) {
+	_, _ = fmt.Fprintf(s.out, "\r\033[K%s %s (%s)", frame, s.title, elapsed.Round(time.Second))
+}
+
+func (s *spinner) clear() {
+	_, _ = fmt.Fprint(s.out, "\r\033[K")
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpinner(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	s := newSpinner(buf, "Waiting for SSH", textSpinnerFrames, time.Millisecond)
+	s.start()
+	time.Sleep(20 * time.Millisecond)
+	s.stop()
+	// second stop does not panic
+	s.stop()
+
+	out := buf.String()
+
+	require.True(t, strings.HasPrefix(out, "\r\033[K| Waiting for SSH (0s)"), out)
+	require.Contains(t, out, "\r\033[K/ Waiting for SSH (0s)")
+	require.True(t, strings.HasSuffix(out, "\r\033[K"), out)
+}
+
+func TestSpin(t *testing.T) {
+	t.Run("pretty logger without terminal", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := NewPrettyLogger(LoggerOptions{OutStream: buf, ColorMode: ColorModeNever})
+
+		err := logger.Spin("Waiting for API", func() error {
+			return errors.New("timeout")
+		})
+
+		require.EqualError(t, err, "timeout")
+		require.Contains(t, buf.String(), "Waiting for API")
+		require.NotContains(t, buf.String(), "\r")
+	})
+
+	t.Run("pretty logger with terminal", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		spinnerOut := &bytes.Buffer{}
+
+		logger := NewPrettyLogger(LoggerOptions{OutStream: buf, ColorMode: ColorModeNever, SymbolMode: SymbolModeUnicode})
+		logger.spinnerOut = spinnerOut
+
+		err := logger.Spin("Waiting for API", func() error {
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Contains(t, spinnerOut.String(), "⠋ Waiting for API (0s)")
+		require.True(t, strings.HasSuffix(spinnerOut.String(), "\r\033[K"))
+		require.Contains(t, buf.String(), "Waiting for API")
+	})
+
+	t.Run("another loggers run process", func(t *testing.T) {
+		logger := NewInMemoryLogger()
+
+		called := false
+		err := logger.WithPrefix("master-0").Spin("Waiting for SSH", func() error {
+			called = true
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.True(t, called)
+
+		messages := make([]string, 0)
+		for _, entry := range logger.Entries() {
+			messages = append(messages, entry.String())
+		}
+
+		require.Equal(t, []string{
+			"Start process: default/master-0: Waiting for SSH",
+			"End process: default/master-0: Waiting for SSH",
+		}, messages)
+	})
+}
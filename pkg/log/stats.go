@@ -0,0 +1,314 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+)
+
+var (
+	_ baseLogger              = &StatsLogger{}
+	_ formatWithNewLineLogger = &StatsLogger{}
+	_ Logger                  = &StatsLogger{}
+)
+
+// Stats
+// counts of problems written to logger
+type Stats struct {
+	Errors      int
+	Warnings    int
+	Fails       int
+	FailRetries int
+}
+
+// HasProblems
+// returns true if any error, warning or fail was written
+func (s Stats) HasProblems() bool {
+	return s.Errors+s.Warnings+s.Fails+s.FailRetries > 0
+}
+
+// String
+// returns stats like: 2 errors, 3 warnings
+// or "no errors and warnings" if stats is empty
+func (s Stats) String() string {
+	parts := make([]string, 0, 4)
+
+	add := func(count int, single, plural string) {
+		switch {
+		case count == 1:
+			parts = append(parts, fmt.Sprintf("%d %s", count, single))
+		case count > 1:
+			parts = append(parts, fmt.Sprintf("%d %s", count, plural))
+		}
+	}
+
+	add(s.Errors, "error", "errors")
+	add(s.Warnings, "warning", "warnings")
+	add(s.Fails, "fail", "fails")
+	add(s.FailRetries, "fail retry", "fail retries")
+
+	if len(parts) == 0 {
+		return "no errors and warnings"
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func (s *Stats) add(o Stats) {
+	s.Errors += o.Errors
+	s.Warnings += o.Warnings
+	s.Fails += o.Fails
+	s.FailRetries += o.FailRetries
+}
+
+// LoggerStats
+// Processes contains stats for every process by title,
+// problems in nested processes are counted in all parent processes
+type LoggerStats struct {
+	Stats
+	Processes map[string]Stats
+}
+
+type statsCounter struct {
+	mu sync.Mutex
+
+	total     Stats
+	processes map[string]Stats
+	active    []string
+}
+
+func newStatsCounter() *statsCounter {
+	return &statsCounter{
+		processes: make(map[string]Stats),
+	}
+}
+
+func (c *statsCounter) count(s Stats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.total.add(s)
+
+	for _, p := range c.active {
+		ps := c.processes[p]
+		ps.add(s)
+		c.processes[p] = ps
+	}
+}
+
+func (c *statsCounter) startProcess(title string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.active = append(c.active, title)
+
+	if _, ok := c.processes[title]; !ok {
+		c.processes[title] = Stats{}
+	}
+}
+
+func (c *statsCounter) endProcess() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.active) > 0 {
+		c.active = c.active[:len(c.active)-1]
+	}
+}
+
+func (c *statsCounter) stats() LoggerStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return LoggerStats{
+		Stats:     c.total,
+		Processes: maps.Clone(c.processes),
+	}
+}
+
+// WrapWithStats
+// returns logger which counts errors, warnings, fails and fail retries passed to logger.
+// loggers returned from WithFields, BufferLogger and ProcessLogger share counters with returned logger.
+// messages of SilentLogger are not counted.
+// active processes are shared between goroutines, so problems written in parallel
+// are counted in all processes active in this moment
+func WrapWithStats(logger Logger) *StatsLogger {
+	return newStatsLogger(logger, newStatsCounter())
+}
+
+type StatsLogger struct {
+	*formatWithNewLineLoggerWrapper
+
+	parent  Logger
+	counter *statsCounter
+}
+
+func newStatsLogger(parent Logger, counter *statsCounter) *StatsLogger {
+	l := &StatsLogger{
+		parent:  parent,
+		counter: counter,
+	}
+
+	l.formatWithNewLineLoggerWrapper = newFormatWithNewLineLoggerWrapper(l)
+
+	return l
+}
+
+// Stats
+// returns copy of current stats
+func (l *StatsLogger) Stats() LoggerStats {
+	return l.counter.stats()
+}
+
+func (l *StatsLogger) WithFields(fields map[string]any) Logger {
+	return newStatsLogger(l.parent.WithFields(fields), l.counter)
+}
+
+func (l *StatsLogger) WithField(key string, value any) Logger {
+	return l.WithFields(map[string]any{key: value})
+}
+
+func (l *StatsLogger) WithPrefix(prefix string) Logger {
+	return newPrefixLogger(l, prefix)
+}
+
+func (l *StatsLogger) ProcessLogger() ProcessLogger {
+	return &statsProcessLogger{
+		parent:  l.parent.ProcessLogger(),
+		counter: l.counter,
+	}
+}
+
+func (l *StatsLogger) ProgressLogger() ProgressLogger {
+	return l.parent.ProgressLogger()
+}
+
+func (l *StatsLogger) SilentLogger() *SilentLogger {
+	return l.parent.SilentLogger()
+}
+
+func (l *StatsLogger) BufferLogger(buffer *bytes.Buffer) Logger {
+	return newStatsLogger(l.parent.BufferLogger(buffer), l.counter)
+}
+
+func (l *StatsLogger) FlushAndClose() error {
+	return l.parent.FlushAndClose()
+}
+
+func (l *StatsLogger) Process(p Process, t string, run func() error) error {
+	return l.parent.Process(p, t, func() error {
+		l.counter.startProcess(t)
+		defer l.counter.endProcess()
+
+		return run()
+	})
+}
+
+func (l *StatsLogger) InfoFWithoutLn(format string, a ...interface{}) {
+	l.parent.InfoFWithoutLn(format, a...)
+}
+
+// InfoLn
+// Deprecated:
+// Use InfoF(string) it add \n to end
+func (l *StatsLogger) InfoLn(a ...interface{}) {
+	l.parent.InfoLn(a...)
+}
+
+func (l *StatsLogger) ErrorFWithoutLn(format string, a ...interface{}) {
+	l.counter.count(Stats{Errors: 1})
+	l.parent.ErrorFWithoutLn(format, a...)
+}
+
+// ErrorLn
+// Deprecated:
+// Use ErrorF(string) it add \n to end
+func (l *StatsLogger) ErrorLn(a ...interface{}) {
+	l.counter.count(Stats{Errors: 1})
+	l.parent.ErrorLn(a...)
+}
+
+func (l *StatsLogger) DebugFWithoutLn(format string, a ...interface{}) {
+	l.parent.DebugFWithoutLn(format, a...)
+}
+
+// DebugLn
+// Deprecated:
+// Use DebugF(string) it add \n to end
+func (l *StatsLogger) DebugLn(a ...interface{}) {
+	l.parent.DebugLn(a...)
+}
+
+func (l *StatsLogger) WarnFWithoutLn(format string, a ...interface{}) {
+	l.counter.count(Stats{Warnings: 1})
+	l.parent.WarnFWithoutLn(format, a...)
+}
+
+// WarnLn
+// Deprecated:
+// Use WarnF(string) it add \n to end
+func (l *StatsLogger) WarnLn(a ...interface{}) {
+	l.counter.count(Stats{Warnings: 1})
+	l.parent.WarnLn(a...)
+}
+
+func (l *StatsLogger) Success(s string) {
+	l.parent.Success(s)
+}
+
+func (l *StatsLogger) Fail(s string) {
+	l.counter.count(Stats{Fails: 1})
+	l.parent.Fail(s)
+}
+
+func (l *StatsLogger) FailRetry(s string) {
+	l.counter.count(Stats{FailRetries: 1})
+	l.parent.FailRetry(s)
+}
+
+func (l *StatsLogger) JSON(content []byte) {
+	l.parent.JSON(content)
+}
+
+func (l *StatsLogger) Write(content []byte) (int, error) {
+	return l.parent.Write(content)
+}
+
+// statsProcessLogger
+// tracks processes started with ProcessLogger and counts failed processes as fails
+type statsProcessLogger struct {
+	parent  ProcessLogger
+	counter *statsCounter
+}
+
+func (l *statsProcessLogger) ProcessStart(name string) {
+	l.parent.ProcessStart(name)
+	l.counter.startProcess(name)
+}
+
+func (l *statsProcessLogger) ProcessFail() {
+	l.counter.count(Stats{Fails: 1})
+	l.counter.endProcess()
+	l.parent.ProcessFail()
+}
+
+func (l *statsProcessLogger) ProcessEnd() {
+	l.counter.endProcess()
+	l.parent.ProcessEnd()
+}
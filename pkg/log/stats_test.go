@@ -0,0 +1,74 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsLoggerFollowInterfaces(t *testing.T) {
+	assertFollowAllInterfaces(t, WrapWithStats(NewSimpleLogger(LoggerOptions{IsDebug: true})))
+}
+
+func TestStatsLogger(t *testing.T) {
+	parent := NewInMemoryLogger()
+	logger := WrapWithStats(parent)
+
+	require.False(t, logger.Stats().HasProblems())
+	require.Equal(t, "no errors and warnings", logger.Stats().String())
+
+	logger.InfoF("info")
+	logger.DebugF("debug")
+	logger.WarnF("warn before process")
+
+	err := logger.Process(ProcessBootstrap, "Bootstrap", func() error {
+		logger.WithField("node", "master-0").ErrorF("error in process")
+		logger.FailRetry("retry")
+
+		return logger.Process(ProcessCommon, "Nested", func() error {
+			logger.WarnLn("warn in nested process")
+			return nil
+		})
+	})
+	require.NoError(t, err)
+
+	processLogger := logger.ProcessLogger()
+	processLogger.ProcessStart("Failed process")
+	logger.BufferLogger(bytes.NewBuffer(nil)).ErrorF("error in buffer")
+	processLogger.ProcessFail()
+
+	logger.SilentLogger().ErrorF("silent error is not counted")
+	logger.Fail("fail")
+
+	stats := logger.Stats()
+
+	require.Equal(t, Stats{Errors: 2, Warnings: 2, Fails: 2, FailRetries: 1}, stats.Stats)
+	require.Equal(t, "2 errors, 2 warnings, 2 fails, 1 fail retry", stats.String())
+	require.True(t, stats.HasProblems())
+
+	require.Equal(t, map[string]Stats{
+		"Bootstrap":      {Errors: 1, Warnings: 1, FailRetries: 1},
+		"Nested":         {Warnings: 1},
+		"Failed process": {Errors: 1, Fails: 1},
+	}, stats.Processes)
+
+	// messages are passed to parent
+	matches, err := parent.AllMatches(&Match{Levels: []Level{LevelError}})
+	require.NoError(t, err)
+	require.Contains(t, matches, "error in process | fields: [node='master-0']\n")
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"io"
+	"os"
+	"strings"
+)
+
+type SymbolMode string
+
+const (
+	// SymbolModeAuto
+	// unicode symbols are used if output is terminal with UTF-8 locale, ascii symbols otherwise
+	SymbolModeAuto SymbolMode = "auto"
+	// SymbolModeUnicode
+	// emoji in process titles and markers and unicode process frames are used
+	SymbolModeUnicode SymbolMode = "unicode"
+	// SymbolModeASCII
+	// text markers like [OK], process titles without emoji and processes without frames are used,
+	// for example for CI consoles which show mojibake for emoji
+	SymbolModeASCII SymbolMode = "ascii"
+)
+
+var localeEnvs = []string{"LC_ALL", "LC_CTYPE", "LANG"}
+
+// resolveSymbolMode
+// empty mode is SymbolModeAuto, nil out is stdout.
+// returns SymbolModeUnicode or SymbolModeASCII
+func resolveSymbolMode(mode SymbolMode, out io.Writer) SymbolMode {
+	switch mode {
+	case SymbolModeUnicode, SymbolModeASCII:
+		return mode
+	}
+
+	if terminalFile(out) != nil && utf8Locale() {
+		return SymbolModeUnicode
+	}
+
+	return SymbolModeASCII
+}
+
+// utf8Locale
+// locale is got from first not empty env like libc does: LC_ALL, LC_CTYPE, LANG
+func utf8Locale() bool {
+	for _, env := range localeEnvs {
+		locale := os.Getenv(env)
+		if locale == "" {
+			continue
+		}
+
+		locale = strings.ToLower(locale)
+
+		return strings.Contains(locale, "utf-8") || strings.Contains(locale, "utf8")
+	}
+
+	return false
+}
+
+// ascii
+// returns theme without emoji and unicode frames
+func (t Theme) ascii() Theme {
+	t.DisableEmoji = true
+	t.DisableFrames = true
+
+	return t
+}
@@ -0,0 +1,110 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSymbolMode(t *testing.T) {
+	t.Run("resolve", func(t *testing.T) {
+		t.Setenv("LC_ALL", "")
+		t.Setenv("LC_CTYPE", "")
+		t.Setenv("LANG", "en_US.UTF-8")
+
+		buf := &bytes.Buffer{}
+
+		require.Equal(t, SymbolModeUnicode, resolveSymbolMode(SymbolModeUnicode, buf))
+		require.Equal(t, SymbolModeASCII, resolveSymbolMode(SymbolModeASCII, buf))
+		// buffer is not terminal
+		require.Equal(t, SymbolModeASCII, resolveSymbolMode(SymbolModeAuto, buf))
+		require.Equal(t, SymbolModeASCII, resolveSymbolMode("", buf))
+	})
+
+	t.Run("utf8 locale", func(t *testing.T) {
+		t.Setenv("LC_ALL", "")
+		t.Setenv("LC_CTYPE", "")
+		t.Setenv("LANG", "")
+		require.False(t, utf8Locale())
+
+		t.Setenv("LANG", "ru_RU.utf8")
+		require.True(t, utf8Locale())
+
+		t.Setenv("LC_ALL", "C")
+		require.False(t, utf8Locale())
+
+		t.Setenv("LC_ALL", "")
+		t.Setenv("LC_CTYPE", "en_US.UTF-8")
+		t.Setenv("LANG", "C")
+		require.True(t, utf8Locale())
+	})
+
+	t.Run("ascii output", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := NewPrettyLogger(LoggerOptions{
+			OutStream:  buf,
+			ColorMode:  ColorModeNever,
+			SymbolMode: SymbolModeASCII,
+		})
+
+		_ = logger.Process(ProcessCommon, "install", func() error {
+			logger.Success("done")
+			logger.Fail("failed")
+			return nil
+		})
+
+		out := buf.String()
+		require.Contains(t, out, "Common: install")
+		require.Contains(t, out, "[OK] done")
+		require.Contains(t, out, "[FAIL] failed")
+
+		for _, r := range out {
+			require.Less(t, r, rune(128), "non ascii symbol %q in output:\n%s", r, out)
+		}
+	})
+
+	t.Run("unicode output", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := NewPrettyLogger(LoggerOptions{
+			OutStream:  buf,
+			ColorMode:  ColorModeNever,
+			SymbolMode: SymbolModeUnicode,
+		})
+
+		_ = logger.Process(ProcessCommon, "install", func() error {
+			logger.Success("done")
+			return nil
+		})
+
+		require.Contains(t, buf.String(), "│")
+		require.Contains(t, buf.String(), "🎈")
+	})
+
+	t.Run("buffer logger keeps mode", func(t *testing.T) {
+		logger := NewPrettyLogger(LoggerOptions{
+			OutStream:  &bytes.Buffer{},
+			SymbolMode: SymbolModeUnicode,
+		})
+
+		buf := &bytes.Buffer{}
+		bufferLogger := logger.BufferLogger(buf).(*PrettyLogger)
+
+		require.Equal(t, SymbolModeUnicode, bufferLogger.symbols)
+		require.False(t, bufferLogger.theme.DisableEmoji)
+	})
+}
@@ -0,0 +1,205 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var (
+	_ baseLogger              = &SyslogLogger{}
+	_ formatWithNewLineLogger = &SyslogLogger{}
+	_ Logger                  = &SyslogLogger{}
+	_ io.Writer               = &SyslogLogger{}
+)
+
+// SyslogWriter
+// writes messages with priority to syslog or journald (journald listens syslog socket)
+// *syslog.Writer from log/syslog follows this interface:
+//
+//	w, err := syslog.New(syslog.LOG_INFO|syslog.LOG_DAEMON, "dhctl")
+//	logger := log.NewSyslogLogger(w, isDebug)
+type SyslogWriter interface {
+	Debug(m string) error
+	Info(m string) error
+	Warning(m string) error
+	Err(m string) error
+	Close() error
+}
+
+// SyslogLogger
+// writes messages to SyslogWriter with priorities mapped from logger methods:
+// Debug - debug, Info, Success and process start/end - info,
+// Warn and FailRetry - warning, Error and Fail - err
+// JSON and Write are written with info priority
+type SyslogLogger struct {
+	*formatWithNewLineLoggerWrapper
+
+	writer  SyslogWriter
+	isDebug bool
+}
+
+func NewSyslogLogger(writer SyslogWriter, isDebug bool) *SyslogLogger {
+	l := &SyslogLogger{
+		writer:  writer,
+		isDebug: isDebug,
+	}
+
+	l.formatWithNewLineLoggerWrapper = newFormatWithNewLineLoggerWrapper(l)
+
+	return l
+}
+
+func (d *SyslogLogger) ProcessLogger() ProcessLogger {
+	return newWrappedProcessLogger(d)
+}
+
+func (d *SyslogLogger) ProgressLogger() ProgressLogger {
+	return newWrappedProgressLogger(d)
+}
+
+func (d *SyslogLogger) SilentLogger() *SilentLogger {
+	return NewSilentLogger()
+}
+
+func (d *SyslogLogger) BufferLogger(buffer *bytes.Buffer) Logger {
+	return NewSimpleLogger(LoggerOptions{OutStream: buffer, IsDebug: d.isDebug})
+}
+
+func (d *SyslogLogger) WithFields(fields map[string]any) Logger {
+	return newFieldsLogger(d, fields)
+}
+
+func (d *SyslogLogger) WithField(key string, value any) Logger {
+	return d.WithFields(map[string]any{key: value})
+}
+
+func (d *SyslogLogger) WithPrefix(prefix string) Logger {
+	return newPrefixLogger(d, prefix)
+}
+
+// FlushAndClose
+// closes connection to syslog
+func (d *SyslogLogger) FlushAndClose() error {
+	return d.writer.Close()
+}
+
+func (d *SyslogLogger) Process(p Process, t string, run func() error) error {
+	t = maskSecrets(t)
+
+	d.write(d.writer.Info, fmt.Sprintf("Start process %s", t))
+
+	duration, err := runProcess(p, t, run)
+	if err != nil {
+		d.write(d.writer.Err, fmt.Sprintf("Process %s failed %s: %v", t, formatProcessDuration(duration), err))
+		return err
+	}
+
+	d.write(d.writer.Info, fmt.Sprintf("End process %s %s", t, formatProcessDuration(duration)))
+
+	return nil
+}
+
+func (d *SyslogLogger) InfoFWithoutLn(format string, a ...interface{}) {
+	d.write(d.writer.Info, sprintf(format, a...))
+}
+
+// InfoLn
+// Deprecated:
+// Use InfoF(string) it add \n to end
+func (d *SyslogLogger) InfoLn(a ...interface{}) {
+	d.write(d.writer.Info, fmt.Sprintln(a...))
+}
+
+func (d *SyslogLogger) ErrorFWithoutLn(format string, a ...interface{}) {
+	d.write(d.writer.Err, sprintf(format, a...))
+}
+
+// ErrorLn
+// Deprecated:
+// Use ErrorF(string) it add \n to end
+func (d *SyslogLogger) ErrorLn(a ...interface{}) {
+	d.write(d.writer.Err, fmt.Sprintln(a...))
+}
+
+func (d *SyslogLogger) DebugFWithoutLn(format string, a ...interface{}) {
+	if d.isDebug {
+		d.write(d.writer.Debug, sprintf(format, a...))
+	}
+}
+
+// DebugLn
+// Deprecated:
+// Use DebugF(string) it add \n to end
+func (d *SyslogLogger) DebugLn(a ...interface{}) {
+	if d.isDebug {
+		d.write(d.writer.Debug, fmt.Sprintln(a...))
+	}
+}
+
+func (d *SyslogLogger) WarnFWithoutLn(format string, a ...interface{}) {
+	d.write(d.writer.Warning, sprintf(format, a...))
+}
+
+// WarnLn
+// Deprecated:
+// Use WarnF(string) it add \n to end
+func (d *SyslogLogger) WarnLn(a ...interface{}) {
+	d.write(d.writer.Warning, fmt.Sprintln(a...))
+}
+
+func (d *SyslogLogger) Success(l string) {
+	d.write(d.writer.Info, l)
+}
+
+func (d *SyslogLogger) Fail(l string) {
+	d.write(d.writer.Err, l)
+}
+
+func (d *SyslogLogger) FailRetry(l string) {
+	d.write(d.writer.Warning, l)
+}
+
+func (d *SyslogLogger) JSON(content []byte) {
+	d.write(d.writer.Info, string(content))
+}
+
+func (d *SyslogLogger) Write(content []byte) (int, error) {
+	msg := strings.TrimRight(maskSecrets(string(content)), "\n")
+	if msg == "" {
+		return len(content), nil
+	}
+
+	if err := d.writer.Info(msg); err != nil {
+		return 0, err
+	}
+
+	return len(content), nil
+}
+
+// write
+// every message is separate syslog record, so trailing new lines are trimmed
+// errors are skipped like for writing to stdout in another loggers
+func (d *SyslogLogger) write(writeFunc func(string) error, msg string) {
+	msg = strings.TrimRight(maskSecrets(msg), "\n")
+	if msg == "" {
+		return
+	}
+
+	_ = writeFunc(msg)
+}
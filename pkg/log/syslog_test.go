@@ -0,0 +1,145 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testTookRegex = regexp.MustCompile(`\(took [^)]+\)`)
+
+func TestSyslogLoggerFollowInterfaces(t *testing.T) {
+	assertFollowAllInterfaces(t, NewSyslogLogger(newTestSyslogWriter(), true))
+}
+
+func TestSyslogLogger(t *testing.T) {
+	t.Run("priorities", func(t *testing.T) {
+		writer := newTestSyslogWriter()
+		logger := NewSyslogLogger(writer, true)
+
+		logger.InfoF("info %s", "message")
+		logger.WarnF("warn message")
+		logger.ErrorF("error message")
+		logger.DebugF("debug message")
+		logger.InfoLn("info", "ln")
+		logger.Success("success")
+		logger.Fail("fail")
+		logger.FailRetry("fail retry")
+		logger.WithField("node", "master-0").InfoF("with field")
+		logger.JSON([]byte(`{"a":"b"}`))
+		_, err := logger.Write([]byte("written\n"))
+		require.NoError(t, err)
+
+		require.NoError(t, logger.FlushAndClose())
+		require.True(t, writer.closed)
+
+		require.Equal(t, []string{
+			"info: info message",
+			"warning: warn message",
+			"err: error message",
+			"debug: debug message",
+			"info: info ln",
+			"info: success",
+			"err: fail",
+			"warning: fail retry",
+			"info: with field | fields: [node='master-0']",
+			`info: {"a":"b"}`,
+			"info: written",
+		}, writer.records)
+	})
+
+	t.Run("debug disabled", func(t *testing.T) {
+		writer := newTestSyslogWriter()
+		logger := NewSyslogLogger(writer, false)
+
+		logger.DebugF("debug message")
+		logger.DebugLn("debug message")
+		logger.InfoF("")
+
+		require.Empty(t, writer.records)
+	})
+
+	t.Run("process", func(t *testing.T) {
+		writer := newTestSyslogWriter()
+		logger := NewSyslogLogger(writer, false)
+
+		err := logger.Process(ProcessBootstrap, "Bootstrap", func() error {
+			logger.InfoF("inside")
+			return nil
+		})
+		require.NoError(t, err)
+
+		processErr := errors.New("process error")
+		err = logger.Process(ProcessConverge, "Converge", func() error {
+			return processErr
+		})
+		require.ErrorIs(t, err, processErr)
+
+		records := make([]string, 0, len(writer.records))
+		for _, r := range writer.records {
+			records = append(records, testTookRegex.ReplaceAllString(r, "(took X)"))
+		}
+
+		require.Equal(t, []string{
+			"info: Start process Bootstrap",
+			"info: inside",
+			"info: End process Bootstrap (took X)",
+			"info: Start process Converge",
+			"err: Process Converge failed (took X): process error",
+		}, records)
+	})
+}
+
+type testSyslogWriter struct {
+	records []string
+	closed  bool
+}
+
+func newTestSyslogWriter() *testSyslogWriter {
+	return &testSyslogWriter{
+		records: make([]string, 0),
+	}
+}
+
+func (w *testSyslogWriter) Debug(m string) error {
+	return w.write("debug", m)
+}
+
+func (w *testSyslogWriter) Info(m string) error {
+	return w.write("info", m)
+}
+
+func (w *testSyslogWriter) Warning(m string) error {
+	return w.write("warning", m)
+}
+
+func (w *testSyslogWriter) Err(m string) error {
+	return w.write("err", m)
+}
+
+func (w *testSyslogWriter) Close() error {
+	w.closed = true
+	return nil
+}
+
+func (w *testSyslogWriter) write(priority, m string) error {
+	w.records = append(w.records, fmt.Sprintf("%s: %s", priority, m))
+	return nil
+}
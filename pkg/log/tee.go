@@ -39,6 +39,11 @@ type TeeLogger struct {
 	bufMutex sync.Mutex
 	buf      *bufio.Writer
 	out      io.WriteCloser
+
+	// root
+	// logger created with WithFields writes to file of root logger
+	root   *TeeLogger
+	fields map[string]any
 }
 
 func newTeeLoggerWithParentAndBuf(l Logger, writer io.WriteCloser, buf *bufio.Writer) *TeeLogger {
@@ -75,7 +80,37 @@ func (d *TeeLogger) BufferLogger(buffer *bytes.Buffer) Logger {
 	return newTeeLoggerWithParentAndBuf(l, d.out, buf)
 }
 
+// WithFields
+// fields are passed to parent logger and added to file as messages suffix
+func (d *TeeLogger) WithFields(fields map[string]any) Logger {
+	res := newTeeLoggerWithParentAndBuf(d.l.WithFields(fields), d.out, nil)
+	res.root = d.rootLogger()
+	res.fields = mergeFields(d.fields, fields)
+
+	return res
+}
+
+func (d *TeeLogger) WithField(key string, value any) Logger {
+	return d.WithFields(map[string]any{key: value})
+}
+
+func (d *TeeLogger) WithPrefix(prefix string) Logger {
+	return newPrefixLogger(d, prefix)
+}
+
+func (d *TeeLogger) rootLogger() *TeeLogger {
+	if d.root != nil {
+		return d.root
+	}
+
+	return d
+}
+
 func (d *TeeLogger) FlushAndClose() error {
+	if d.root != nil {
+		return d.root.FlushAndClose()
+	}
+
 	if d.closed {
 		return nil
 	}
@@ -105,6 +140,10 @@ func (d *TeeLogger) ProcessLogger() ProcessLogger {
 	return d.l.ProcessLogger()
 }
 
+func (d *TeeLogger) ProgressLogger() ProgressLogger {
+	return d.l.ProgressLogger()
+}
+
 func (d *TeeLogger) SilentLogger() *SilentLogger {
 	return newSilentLoggerWithTee(d)
 }
@@ -112,9 +151,10 @@ func (d *TeeLogger) SilentLogger() *SilentLogger {
 func (d *TeeLogger) Process(p Process, t string, run func() error) error {
 	d.writeToFile(fmt.Sprintf("Start process %s\n", t))
 
+	start := time.Now()
 	err := d.l.Process(p, t, run)
 
-	d.writeToFile(fmt.Sprintf("End process %s\n", t))
+	d.writeToFile(fmt.Sprintf("End process %s %s\n", t, formatProcessDuration(time.Since(start))))
 
 	return err
 }
@@ -122,7 +162,7 @@ func (d *TeeLogger) Process(p Process, t string, run func() error) error {
 func (d *TeeLogger) InfoFWithoutLn(format string, a ...interface{}) {
 	d.l.InfoFWithoutLn(format, a...)
 
-	d.writeToFile(fmt.Sprintf(format, a...))
+	d.writeToFile(sprintf(format, a...))
 }
 
 // InfoLn
@@ -137,7 +177,7 @@ func (d *TeeLogger) InfoLn(a ...interface{}) {
 func (d *TeeLogger) ErrorFWithoutLn(format string, a ...interface{}) {
 	d.l.ErrorFWithoutLn(format, a...)
 
-	d.writeToFile(fmt.Sprintf(format, a...))
+	d.writeToFile(sprintf(format, a...))
 }
 
 // ErrorLn
@@ -152,7 +192,7 @@ func (d *TeeLogger) ErrorLn(a ...interface{}) {
 func (d *TeeLogger) DebugFWithoutLn(format string, a ...interface{}) {
 	d.l.DebugFWithoutLn(format, a...)
 
-	d.writeToFile(fmt.Sprintf(format, a...))
+	d.writeToFile(sprintf(format, a...))
 }
 
 // DebugLn
@@ -194,7 +234,7 @@ func (d *TeeLogger) WarnLn(a ...interface{}) {
 func (d *TeeLogger) WarnFWithoutLn(format string, a ...interface{}) {
 	d.l.WarnFWithoutLn(format, a...)
 
-	d.writeToFile(fmt.Sprintf(format, a...))
+	d.writeToFile(sprintf(format, a...))
 }
 
 func (d *TeeLogger) JSON(content []byte) {
@@ -215,6 +255,13 @@ func (d *TeeLogger) Write(content []byte) (int, error) {
 }
 
 func (d *TeeLogger) writeToFile(content string) {
+	content = maskSecrets(content)
+
+	if d.root != nil {
+		d.root.writeToFile(addFieldsSuffix(content, d.fields))
+		return
+	}
+
 	if d.closed {
 		return
 	}
@@ -226,7 +273,7 @@ func (d *TeeLogger) writeToFile(content string) {
 		return
 	}
 
-	timestamp := time.Now().Format(time.DateTime)
+	timestamp := time.Now().Format(DefaultTimestampFormat)
 	contentWithTimestamp := fmt.Sprintf("%s - %s", timestamp, content)
 
 	if _, err := d.buf.Write([]byte(contentWithTimestamp)); err != nil {
@@ -0,0 +1,104 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+var ErrTeeLoggerClosed = errors.New("TeeLogger is closed")
+
+// Reopen
+// flush buffer to current writer, close it and continue writing to writer
+// use it after external rotation (logrotate for example) moved or truncated file
+// if logger was closed with FlushAndClose returns ErrTeeLoggerClosed
+// error of closing current writer is returned, but writer is replaced anyway
+func (d *TeeLogger) Reopen(writer io.WriteCloser) error {
+	if d.root != nil {
+		return d.root.Reopen(writer)
+	}
+
+	d.bufMutex.Lock()
+	defer d.bufMutex.Unlock()
+
+	if d.closed || d.buf == nil {
+		return ErrTeeLoggerClosed
+	}
+
+	if err := d.buf.Flush(); err != nil {
+		d.l.WarnF("Cannot flush TeeLogger before reopen: %v", err)
+	}
+
+	var prev io.WriteCloser
+
+	if w, ok := d.out.(*rotatingWriteCloser); ok {
+		prev = w.reopen(writer)
+	} else {
+		prev = d.out
+		d.out = writer
+		d.buf.Reset(writer)
+	}
+
+	if err := prev.Close(); err != nil {
+		d.l.WarnF("Cannot close TeeLogger file before reopen: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// ReopenOnSignal
+// reopen logger with writer returned by open on every signal (SIGHUP if signals are not passed)
+// stops handling signals when ctx is done
+func (d *TeeLogger) ReopenOnSignal(ctx context.Context, open func() (io.WriteCloser, error), signals ...os.Signal) {
+	if len(signals) == 0 {
+		signals = []os.Signal{syscall.SIGHUP}
+	}
+
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, signals...)
+
+	go func() {
+		defer signal.Stop(ch)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ch:
+				d.reopenWith(open)
+			}
+		}
+	}()
+}
+
+func (d *TeeLogger) reopenWith(open func() (io.WriteCloser, error)) {
+	writer, err := open()
+	if err != nil {
+		d.l.WarnF("Cannot open new file for TeeLogger: %v", err)
+		return
+	}
+
+	if err := d.Reopen(writer); errors.Is(err, ErrTeeLoggerClosed) {
+		if err := writer.Close(); err != nil {
+			d.l.DebugF("Cannot close new TeeLogger file: %v", err)
+		}
+	}
+}
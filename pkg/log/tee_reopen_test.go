@@ -0,0 +1,76 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeeLoggerReopen(t *testing.T) {
+	newTee := func(t *testing.T) (*TeeLogger, *testWriterCloser) {
+		first := newTestWriterCloser()
+		tee, err := NewTeeLogger(NewInMemoryLoggerWithParent(NewSilentLogger()), first, 1024)
+		require.NoError(t, err)
+
+		return tee, first
+	}
+
+	t.Run("reopen", func(t *testing.T) {
+		tee, first := newTee(t)
+
+		tee.InfoF("before reopen")
+
+		second := newTestWriterCloser()
+		require.NoError(t, tee.Reopen(second))
+
+		require.True(t, first.closed)
+		require.Contains(t, first.writer.String(), "before reopen")
+
+		tee.WithField("key", "value").InfoF("after reopen")
+		require.NoError(t, tee.FlushAndClose())
+
+		require.NotContains(t, first.writer.String(), "after reopen")
+		require.Contains(t, second.writer.String(), "after reopen")
+		require.True(t, second.closed)
+	})
+
+	t.Run("reopen with rotation", func(t *testing.T) {
+		tee, first := newTee(t)
+		tee.WithMaxFileSize(1024*1024, func(int) (io.WriteCloser, error) {
+			return newTestWriterCloser(), nil
+		})
+
+		second := newTestWriterCloser()
+		require.NoError(t, tee.Reopen(second))
+		require.True(t, first.closed)
+
+		tee.InfoF("after reopen")
+		require.NoError(t, tee.FlushAndClose())
+
+		require.Contains(t, second.writer.String(), "after reopen")
+		require.True(t, second.closed)
+	})
+
+	t.Run("reopen after close", func(t *testing.T) {
+		tee, _ := newTee(t)
+		require.NoError(t, tee.FlushAndClose())
+
+		require.ErrorIs(t, tee.Reopen(newTestWriterCloser()), ErrTeeLoggerClosed)
+	})
+
+}
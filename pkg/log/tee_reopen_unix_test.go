@@ -0,0 +1,64 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+
+package log
+
+import (
+	"context"
+	"io"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeeLoggerReopenOnSignal(t *testing.T) {
+	first := newTestWriterCloser()
+	tee, err := NewTeeLogger(NewInMemoryLoggerWithParent(NewSilentLogger()), first, 1024)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	opened := make(chan *testWriterCloser, 1)
+	tee.ReopenOnSignal(ctx, func() (io.WriteCloser, error) {
+		w := newTestWriterCloser()
+		opened <- w
+		return w, nil
+	}, syscall.SIGUSR1)
+
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGUSR1))
+
+	var second *testWriterCloser
+	select {
+	case second = <-opened:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "logger was not reopened")
+	}
+
+	require.Eventually(t, func() bool {
+		tee.bufMutex.Lock()
+		defer tee.bufMutex.Unlock()
+
+		return first.closed
+	}, 5*time.Second, 10*time.Millisecond)
+
+	tee.InfoF("after signal")
+	require.NoError(t, tee.FlushAndClose())
+
+	require.Contains(t, second.writer.String(), "after signal")
+}
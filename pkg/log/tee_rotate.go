@@ -0,0 +1,177 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"io"
+	"sync"
+)
+
+// TeeFileFactory
+// opens next file for TeeLogger rotation
+// index is sequence number of file, file passed to NewTeeLogger has index 0
+// if max backups set, index is in [0, max backups] and files are reused by cycle,
+// so factory should truncate file (use os.Create for example)
+type TeeFileFactory func(index int) (io.WriteCloser, error)
+
+// WithMaxFileSize
+// enable rotation of tee output: if file size exceeds size, current file will be closed
+// and next file will be opened with factory
+// rotation is not applied if size <= 0 or factory is nil
+// call it before creating child loggers with BufferLogger
+func (d *TeeLogger) WithMaxFileSize(size int64, factory TeeFileFactory) *TeeLogger {
+	d.rotatingWriter(func(w *rotatingWriteCloser) {
+		w.maxSize = size
+		w.factory = factory
+	})
+
+	return d
+}
+
+// WithMaxBackups
+// keep only count rotated files besides current
+// count <= 0 means all files are kept
+func (d *TeeLogger) WithMaxBackups(count int) *TeeLogger {
+	d.rotatingWriter(func(w *rotatingWriteCloser) {
+		w.maxBackups = count
+	})
+
+	return d
+}
+
+func (d *TeeLogger) rotatingWriter(configure func(w *rotatingWriteCloser)) {
+	root := d.rootLogger()
+
+	root.bufMutex.Lock()
+	defer root.bufMutex.Unlock()
+
+	if w, ok := root.out.(*rotatingWriteCloser); ok {
+		w.configure(configure)
+		return
+	}
+
+	w := newRotatingWriteCloser(root.out, root.l)
+	configure(w)
+
+	if root.buf != nil {
+		if err := root.buf.Flush(); err != nil {
+			root.l.DebugF("Cannot flush TeeLogger: %v", err)
+		}
+
+		root.buf.Reset(w)
+	}
+
+	root.out = w
+}
+
+type rotatingWriteCloser struct {
+	mu sync.Mutex
+
+	out     io.WriteCloser
+	written int64
+	index   int
+
+	maxSize    int64
+	maxBackups int
+	factory    TeeFileFactory
+
+	logger Logger
+}
+
+func newRotatingWriteCloser(out io.WriteCloser, logger Logger) *rotatingWriteCloser {
+	return &rotatingWriteCloser{
+		out:    out,
+		logger: logger,
+	}
+}
+
+func (w *rotatingWriteCloser) configure(configure func(w *rotatingWriteCloser)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	configure(w)
+}
+
+// reopen
+// replace current file and returns previous
+func (w *rotatingWriteCloser) reopen(out io.WriteCloser) io.WriteCloser {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	prev := w.out
+
+	w.out = out
+	w.written = 0
+
+	return prev
+}
+
+func (w *rotatingWriteCloser) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.needRotate(len(p)) {
+		if err := w.rotate(); err != nil {
+			// continue writing to current file and try to rotate after next max size bytes
+			w.logger.WarnF("Cannot rotate TeeLogger file: %v", err)
+			w.written = 0
+		}
+	}
+
+	n, err := w.out.Write(p)
+	w.written += int64(n)
+
+	return n, err
+}
+
+func (w *rotatingWriteCloser) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.out.Close()
+}
+
+func (w *rotatingWriteCloser) needRotate(size int) bool {
+	if w.maxSize <= 0 || w.factory == nil {
+		return false
+	}
+
+	return w.written > 0 && w.written+int64(size) > w.maxSize
+}
+
+func (w *rotatingWriteCloser) rotate() error {
+	index := w.index + 1
+	if w.maxBackups > 0 {
+		index %= w.maxBackups + 1
+	}
+
+	next, err := w.factory(index)
+	if err != nil {
+		return fmt.Errorf("cannot open file %d: %w", index, err)
+	}
+
+	prev := w.out
+
+	w.out = next
+	w.index = index
+	w.written = 0
+
+	if err := prev.Close(); err != nil {
+		w.logger.WarnF("Cannot close rotated TeeLogger file: %v", err)
+	}
+
+	return nil
+}
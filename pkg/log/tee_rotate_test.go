@@ -0,0 +1,113 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeeLoggerRotation(t *testing.T) {
+	const msg = "rotated message with some length"
+
+	newTee := func(t *testing.T, factory TeeFileFactory) (*TeeLogger, *testWriterCloser) {
+		first := newTestWriterCloser()
+		tee, err := NewTeeLogger(NewInMemoryLoggerWithParent(NewSilentLogger()), first, 16)
+		require.NoError(t, err)
+
+		return tee.WithMaxFileSize(120, factory), first
+	}
+
+	t.Run("rotate by size", func(t *testing.T) {
+		files := make(map[int]*testWriterCloser)
+		indexes := make([]int, 0)
+
+		tee, first := newTee(t, func(index int) (io.WriteCloser, error) {
+			files[index] = newTestWriterCloser()
+			indexes = append(indexes, index)
+			return files[index], nil
+		})
+
+		for i := 0; i < 10; i++ {
+			tee.InfoF(msg)
+		}
+
+		require.NoError(t, tee.FlushAndClose())
+
+		require.Equal(t, []int{1, 2, 3, 4}, indexes)
+
+		all := []*testWriterCloser{first, files[1], files[2], files[3], files[4]}
+		count := 0
+		for _, f := range all {
+			require.True(t, f.closed)
+			require.LessOrEqual(t, f.writer.Len(), 120)
+			count += strings.Count(f.writer.String(), msg)
+		}
+
+		require.Equal(t, 10, count)
+	})
+
+	t.Run("max backups", func(t *testing.T) {
+		indexes := make([]int, 0)
+
+		tee, _ := newTee(t, func(index int) (io.WriteCloser, error) {
+			indexes = append(indexes, index)
+			return newTestWriterCloser(), nil
+		})
+		tee.WithMaxBackups(2)
+
+		for i := 0; i < 10; i++ {
+			tee.InfoF(msg)
+		}
+
+		require.NoError(t, tee.FlushAndClose())
+		require.Equal(t, []int{1, 2, 0, 1}, indexes)
+	})
+
+	t.Run("factory error", func(t *testing.T) {
+		tee, first := newTee(t, func(index int) (io.WriteCloser, error) {
+			return nil, errors.New("cannot open")
+		})
+
+		for i := 0; i < 10; i++ {
+			tee.InfoF(msg)
+		}
+
+		require.NoError(t, tee.FlushAndClose())
+		require.Equal(t, 10, strings.Count(first.writer.String(), msg))
+	})
+
+	t.Run("fields logger writes to rotated file", func(t *testing.T) {
+		var second *testWriterCloser
+
+		tee, _ := newTee(t, func(index int) (io.WriteCloser, error) {
+			second = newTestWriterCloser()
+			return second, nil
+		})
+
+		child := tee.WithField("node", "master-0")
+		for i := 0; i < 5; i++ {
+			child.InfoF(msg)
+		}
+
+		require.NoError(t, tee.FlushAndClose())
+		require.NotNil(t, second)
+		require.Contains(t, second.writer.String(), "fields: [node='master-0']")
+	})
+}
@@ -245,8 +245,9 @@ func TestTeeLoggerReturnCorrectProcessLogger(t *testing.T) {
 			name: "pretty",
 			provider: func(w *testWriterCloser) Logger {
 				return NewPrettyLogger(LoggerOptions{
-					IsDebug:   false,
-					OutStream: w,
+					IsDebug:    false,
+					OutStream:  w,
+					SymbolMode: SymbolModeUnicode,
 				})
 			},
 			messages: append(
@@ -0,0 +1,105 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"io"
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/term"
+)
+
+// DefaultTerminalWidth
+// used by PrettyLogger if output is not terminal or terminal width cannot be detected
+const DefaultTerminalWidth = 140
+
+// terminalFile
+// returns file for out if out is terminal, nil out is stdout
+func terminalFile(out io.Writer) *os.File {
+	if out == nil {
+		out = os.Stdout
+	}
+
+	f, ok := out.(*os.File)
+	if !ok || !term.IsTerminal(int(f.Fd())) {
+		return nil
+	}
+
+	return f
+}
+
+// terminalWidth
+// returns 0 if width cannot be detected
+func terminalWidth(f *os.File) int {
+	width, _, err := term.GetSize(int(f.Fd()))
+	if err != nil || width <= 0 {
+		return 0
+	}
+
+	return width
+}
+
+// terminalWidthWatcher
+// detects terminal width on resize signal (SIGWINCH) in background
+// logboek streams are not safe for concurrent use, so watcher only stores
+// new width and logger applies it before next write
+type terminalWidthWatcher struct {
+	width    atomic.Int64
+	stopOnce sync.Once
+	stopCh   chan struct{}
+}
+
+func newTerminalWidthWatcher(f *os.File) *terminalWidthWatcher {
+	w := &terminalWidthWatcher{
+		stopCh: make(chan struct{}),
+	}
+
+	signals := make(chan os.Signal, 1)
+	if !notifyTerminalResize(signals) {
+		return w
+	}
+
+	go func() {
+		defer signal.Stop(signals)
+
+		for {
+			select {
+			case <-w.stopCh:
+				return
+			case <-signals:
+				if width := terminalWidth(f); width > 0 {
+					w.width.Store(int64(width))
+				}
+			}
+		}
+	}()
+
+	return w
+}
+
+// pop
+// returns new width if terminal was resized since last call or 0
+func (w *terminalWidthWatcher) pop() int {
+	return int(w.width.Swap(0))
+}
+
+func (w *terminalWidthWatcher) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.stopCh)
+	})
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrettyWidth(t *testing.T) {
+	t.Run("not terminal", func(t *testing.T) {
+		logger := NewPrettyLogger(LoggerOptions{OutStream: &bytes.Buffer{}})
+
+		require.Nil(t, logger.widthWatcher)
+		require.Equal(t, DefaultTerminalWidth, logger.logboek().Streams().Width())
+	})
+
+	t.Run("override", func(t *testing.T) {
+		logger := NewPrettyLogger(LoggerOptions{OutStream: &bytes.Buffer{}, Width: 80})
+
+		require.Nil(t, logger.widthWatcher)
+		require.Equal(t, 80, logger.logboek().Streams().Width())
+	})
+
+	t.Run("apply resized width before write", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		logger := NewPrettyLogger(LoggerOptions{OutStream: out})
+		logger.widthWatcher = &terminalWidthWatcher{stopCh: make(chan struct{})}
+
+		logger.widthWatcher.width.Store(60)
+		logger.InfoF("resized")
+
+		require.Equal(t, 60, logger.logboekLogger.Streams().Width())
+		require.Equal(t, 0, logger.widthWatcher.pop())
+		require.Contains(t, out.String(), "resized")
+
+		require.NoError(t, logger.FlushAndClose())
+		// stop is idempotent
+		require.NoError(t, logger.FlushAndClose())
+	})
+}
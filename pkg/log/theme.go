@@ -0,0 +1,153 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/gookit/color"
+	"github.com/werf/logboek/pkg/types"
+)
+
+const (
+	emojiSuccessPrefix = "🎉 "
+	emojiFailPrefix    = "️⛱️️ "
+	emojiWarnPrefix    = "❗ ~ "
+
+	textSuccessPrefix = "[OK] "
+	textFailPrefix    = "[FAIL] "
+	textWarnPrefix    = "[WARN] "
+)
+
+// Theme
+// customizes PrettyLogger output. Zero value is default theme with emoji and frames
+type Theme struct {
+	// SuccessPrefix, FailPrefix, WarnPrefix
+	// if empty, emoji or text prefix used depending on DisableEmoji
+	SuccessPrefix string
+	FailPrefix    string
+	WarnPrefix    string
+
+	// SuccessStyle, FailStyle, WarnStyle
+	// applied to message with prefix if colors enabled
+	// by default success and fail messages are not styled, warnings are bold
+	SuccessStyle color.Style
+	FailStyle    color.Style
+	WarnStyle    color.Style
+
+	// DisableEmoji
+	// use text prefixes and remove emoji from process titles, for example
+	// "🎈 ~ Common: %s" becomes "Common: %s"
+	DisableEmoji bool
+
+	// DisableFrames
+	// do not draw frame borders around processes, process title printed on start and end
+	DisableFrames bool
+}
+
+func (t Theme) successPrefix() string {
+	return t.prefix(t.SuccessPrefix, emojiSuccessPrefix, textSuccessPrefix)
+}
+
+func (t Theme) failPrefix() string {
+	return t.prefix(t.FailPrefix, emojiFailPrefix, textFailPrefix)
+}
+
+func (t Theme) warnPrefix() string {
+	return t.prefix(t.WarnPrefix, emojiWarnPrefix, textWarnPrefix)
+}
+
+func (t Theme) warnStyle() color.Style {
+	if len(t.WarnStyle) == 0 {
+		return boldStyle()
+	}
+
+	return t.WarnStyle
+}
+
+func (t Theme) prefix(custom, emoji, text string) string {
+	switch {
+	case custom != "":
+		return custom
+	case t.DisableEmoji:
+		return text
+	default:
+		return emoji
+	}
+}
+
+// processes
+// returns copy of processes with applied theme
+func (t Theme) processes(processes Processes) Processes {
+	res := make(Processes, len(processes))
+	for process, style := range processes {
+		if t.DisableEmoji {
+			style.Title = trimEmojiPrefix(style.Title)
+		}
+
+		res[process] = style
+	}
+
+	return res
+}
+
+// trimEmojiPrefix
+// removes "<emoji> ~ " prefix from process title
+// prefix is not removed if it contains letters or digits
+func trimEmojiPrefix(title string) string {
+	prefix, rest, found := strings.Cut(title, "~ ")
+	if !found {
+		return title
+	}
+
+	hasText := strings.IndexFunc(prefix, func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	}) >= 0
+	if hasText {
+		return title
+	}
+
+	return rest
+}
+
+var _ types.LogProcessOptionsInterface = &processStyleCapture{}
+
+// processStyleCapture
+// captures style passed by StyleEntryOptionsSetter, other options are ignored
+type processStyleCapture struct {
+	style color.Style
+}
+
+func processStyle(entry StyleEntry) color.Style {
+	c := &processStyleCapture{}
+	if entry.OptionsSetter != nil {
+		entry.OptionsSetter(c)
+	}
+
+	return c.style
+}
+
+func (c *processStyleCapture) Style(style color.Style) {
+	c.style = style
+}
+
+func (c *processStyleCapture) DisableIfLevelNotAccepted()      {}
+func (c *processStyleCapture) Mute()                           {}
+func (c *processStyleCapture) WithIndent()                     {}
+func (c *processStyleCapture) WithoutLogOptionalLn()           {}
+func (c *processStyleCapture) WithoutElapsedTime()             {}
+func (c *processStyleCapture) InfoSectionFunc(func(err error)) {}
+func (c *processStyleCapture) SuccessInfoSectionFunc(func())   {}
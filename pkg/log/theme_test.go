@@ -0,0 +1,142 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gookit/color"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrettyTheme(t *testing.T) {
+	writeAll := func(logger *PrettyLogger) {
+		logger.Success("success message")
+		logger.Fail("fail message\n")
+		logger.WarnF("warn message\n")
+		_ = logger.Process(ProcessCommon, "my process", func() error {
+			logger.InfoF("in process\n")
+			return nil
+		})
+	}
+
+	t.Run("default", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		writeAll(NewPrettyLogger(LoggerOptions{OutStream: out, SymbolMode: SymbolModeUnicode}))
+
+		res := out.String()
+		require.Contains(t, res, "🎉 success message")
+		require.Contains(t, res, "⛱️️ fail message")
+		require.Contains(t, res, "❗ ~ warn message")
+		require.Contains(t, res, "🎈 ~ Common: my process")
+		require.Contains(t, res, "│ in process")
+	})
+
+	t.Run("without emoji and frames", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		writeAll(NewPrettyLogger(LoggerOptions{
+			OutStream: out,
+			Theme: Theme{
+				DisableEmoji:  true,
+				DisableFrames: true,
+			},
+		}))
+
+		res := out.String()
+		require.Contains(t, res, "[OK] success message")
+		require.Contains(t, res, "[FAIL] fail message")
+		require.Contains(t, res, "[WARN] warn message")
+		require.Contains(t, res, "Common: my process\nin process\nCommon: my process (took")
+		require.NotContains(t, res, "🎈")
+		require.NotContains(t, res, "│")
+	})
+
+	t.Run("process logger without frames", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		logger := NewPrettyLogger(LoggerOptions{
+			OutStream: out,
+			Theme:     Theme{DisableFrames: true},
+		})
+
+		processLogger := logger.ProcessLogger()
+		processLogger.ProcessStart("my process")
+		logger.InfoF("in process")
+		processLogger.ProcessEnd()
+
+		require.Contains(t, out.String(), "my process\nin process\nmy process (")
+		require.NotContains(t, out.String(), "│")
+	})
+
+	t.Run("custom prefixes", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		writeAll(NewPrettyLogger(LoggerOptions{
+			OutStream: out,
+			Theme: Theme{
+				SuccessPrefix: "+ ",
+				FailPrefix:    "- ",
+				WarnPrefix:    "! ",
+				DisableEmoji:  true,
+			},
+		}))
+
+		res := out.String()
+		require.Contains(t, res, "+ success message")
+		require.Contains(t, res, "- fail message")
+		require.Contains(t, res, "! warn message")
+	})
+
+	t.Run("styles", func(t *testing.T) {
+		enable := color.Enable
+		level := color.ForceOpenColor()
+
+		t.Cleanup(func() {
+			color.Enable = enable
+			color.ForceSetColorLevel(level)
+		})
+
+		successStyle := color.New(color.FgBlue)
+
+		out := &bytes.Buffer{}
+		logger := NewPrettyLogger(LoggerOptions{
+			OutStream:  out,
+			ColorMode:  ColorModeAlways,
+			SymbolMode: SymbolModeUnicode,
+			Theme:      Theme{SuccessStyle: successStyle},
+		})
+		logger.Success("success message")
+
+		require.Contains(t, out.String(), successStyle.Sprint("🎉 success message"))
+	})
+
+	t.Run("buffer logger inherits theme", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+		logger := NewPrettyLogger(LoggerOptions{
+			OutStream: &bytes.Buffer{},
+			Theme:     Theme{DisableEmoji: true},
+		})
+
+		logger.BufferLogger(buffer).Success("success message")
+
+		require.Contains(t, buffer.String(), "[OK] success message")
+	})
+}
+
+func TestTrimEmojiPrefix(t *testing.T) {
+	require.Equal(t, "Common: %s", trimEmojiPrefix("🎈 ~ Common: %s"))
+	require.Equal(t, "Attach to commander: %s", trimEmojiPrefix("⚓ ~ Attach to commander: %s"))
+	require.Equal(t, "%s", trimEmojiPrefix("%s"))
+	require.Equal(t, "Step 1 ~ Common: %s", trimEmojiPrefix("Step 1 ~ Common: %s"))
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// DefaultTimestampFormat
+// format of timestamps used by tee file and by loggers with LoggerOptions.WithTimestamps
+const DefaultTimestampFormat = time.DateTime
+
+func timestampFormat(opts LoggerOptions) string {
+	if opts.TimestampFormat != "" {
+		return opts.TimestampFormat
+	}
+
+	return DefaultTimestampFormat
+}
+
+// timestampWriter
+// adds timestamp like tee file does to start of every line: 2006-01-02 15:04:05 - line
+type timestampWriter struct {
+	mu sync.Mutex
+
+	out    io.Writer
+	format string
+	now    func() time.Time
+
+	lineStarted bool
+}
+
+// newTimestampWriter
+// if out is nil os.Stdout is used
+func newTimestampWriter(out io.Writer, format string) *timestampWriter {
+	if out == nil {
+		out = os.Stdout
+	}
+
+	return &timestampWriter{
+		out:    out,
+		format: format,
+		now:    time.Now,
+	}
+}
+
+// prefixLen
+// returns length of timestamp prefix, used for reducing width of pretty output
+func (w *timestampWriter) prefixLen() int {
+	return len(w.prefix())
+}
+
+func (w *timestampWriter) prefix() string {
+	return w.now().Format(w.format) + " - "
+}
+
+// Write
+// always returns len(content) if out returns no error
+func (w *timestampWriter) Write(content []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	res := make([]byte, 0, len(content)+w.prefixLen())
+	rest := content
+
+	for len(rest) > 0 {
+		if !w.lineStarted {
+			res = append(res, w.prefix()...)
+			w.lineStarted = true
+		}
+
+		i := bytes.IndexByte(rest, '\n')
+		if i < 0 {
+			res = append(res, rest...)
+			break
+		}
+
+		res = append(res, rest[:i+1]...)
+		rest = rest[i+1:]
+		w.lineStarted = false
+	}
+
+	if _, err := w.out.Write(res); err != nil {
+		return 0, err
+	}
+
+	return len(content), nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimestampWriter(t *testing.T) {
+	now := time.Date(2025, 9, 12, 10, 0, 0, 0, time.UTC)
+
+	buf := &bytes.Buffer{}
+	w := newTimestampWriter(buf, DefaultTimestampFormat)
+	w.now = func() time.Time {
+		return now
+	}
+
+	for _, content := range []string{"first ", "line\nsecond line\n", "", "third"} {
+		n, err := w.Write([]byte(content))
+		require.NoError(t, err)
+		require.Equal(t, len(content), n)
+	}
+
+	require.Equal(t, "2025-09-12 10:00:00 - first line\n"+
+		"2025-09-12 10:00:00 - second line\n"+
+		"2025-09-12 10:00:00 - third", buf.String())
+	require.Equal(t, len("2025-09-12 10:00:00 - "), w.prefixLen())
+}
+
+func TestLoggersWithTimestamps(t *testing.T) {
+	t.Run("dummy logger", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := NewDummyLoggerWithOptions(LoggerOptions{
+			OutStream:       buf,
+			WithTimestamps:  true,
+			TimestampFormat: time.TimeOnly,
+		})
+
+		logger.InfoF("first")
+		logger.WarnF("second")
+
+		lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+		require.Len(t, lines, 2)
+		require.Regexp(t, `^\d{2}:\d{2}:\d{2} - first$`, lines[0])
+		require.Regexp(t, `^\d{2}:\d{2}:\d{2} - second$`, lines[1])
+	})
+
+	t.Run("dummy logger without timestamps", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		NewDummyLoggerWithOptions(LoggerOptions{OutStream: buf}).InfoF("first")
+
+		require.Equal(t, "first\n", buf.String())
+	})
+
+	t.Run("pretty logger", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := NewPrettyLogger(LoggerOptions{
+			OutStream:      buf,
+			Width:          80,
+			WithTimestamps: true,
+		})
+
+		_ = logger.Process(ProcessDefault, "process", func() error {
+			logger.InfoF("message")
+			return nil
+		})
+
+		for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+			require.Regexp(t, `^\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2} - `, line)
+		}
+
+		require.Contains(t, buf.String(), "message")
+	})
+}
@@ -14,7 +14,10 @@
 
 package log
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 func listToString(l ...any) string {
 	switch len(l) {
@@ -26,3 +29,21 @@ func listToString(l ...any) string {
 		return fmt.Sprintf("%v", l)
 	}
 }
+
+// sprintf
+// like fmt.Sprintf, but returns format as is if there are no args and verbs,
+// because most of messages are passed without args
+func sprintf(format string, a ...any) string {
+	if len(a) == 0 && !strings.Contains(format, "%") {
+		return format
+	}
+
+	return fmt.Sprintf(format, a...)
+}
+
+// formattedAsFormat
+// returns formatted message as format without args for passing it to another logger
+// without formatting again and without allocating args
+func formattedAsFormat(msg string) string {
+	return strings.ReplaceAll(msg, "%", "%%")
+}
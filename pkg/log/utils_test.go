@@ -64,3 +64,37 @@ func TestPrepareList(t *testing.T) {
 		})
 	}
 }
+
+func TestSprintf(t *testing.T) {
+	require.Equal(t, "message without args\n", sprintf("message without args\n"))
+	require.Equal(t, "100%", sprintf("100%%"))
+	require.Equal(t, "message 42", sprintf("message %d", 42))
+}
+
+type countingStringer struct {
+	calls int
+}
+
+func (s *countingStringer) String() string {
+	s.calls++
+	return "value"
+}
+
+func TestInMemoryFormatsMessageOnce(t *testing.T) {
+	parent := NewInMemoryLogger()
+	logger := NewInMemoryLoggerWithParent(parent)
+
+	value := &countingStringer{}
+
+	logger.InfoF("info %s", value)
+	logger.WarnF("warn %s", value)
+	logger.ErrorF("error %s", value)
+	logger.DebugF("debug %s", value)
+
+	require.Equal(t, 4, value.calls)
+	require.Len(t, parent.Entries(), 4)
+	require.Equal(t, "warn value\n", parent.Entries()[1].Message)
+
+	logger.InfoF("progress %d%%", 50)
+	require.Equal(t, "progress 50%\n", parent.Entries()[4].Message)
+}
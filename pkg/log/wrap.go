@@ -0,0 +1,80 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// wrapHangingIndent
+// indent of continuation lines of wrapped line, added to own indent of line
+const wrapHangingIndent = "  "
+
+// softWrap
+// wraps lines of text longer than width by words,
+// continuation lines are indented with own indent of line and wrapHangingIndent:
+//
+//	Validation error: document
+//	  has invalid field
+//
+// existing line breaks and indents are kept for keeping embedded documents readable,
+// words longer than width are not broken
+func softWrap(text string, width int) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = softWrapLine(line, width)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func softWrapLine(line string, width int) string {
+	if utf8.RuneCountInString(line) <= width {
+		return line
+	}
+
+	content := strings.TrimLeft(line, " \t")
+	indent := line[:len(line)-len(content)]
+	hangingIndent := indent + wrapHangingIndent
+
+	if utf8.RuneCountInString(hangingIndent) >= width {
+		// too narrow output for wrapping
+		return line
+	}
+
+	wrapped := make([]string, 0)
+	current := indent
+	currentIndent := indent
+
+	for _, word := range strings.Fields(content) {
+		currentLen := utf8.RuneCountInString(current)
+
+		switch {
+		case current == currentIndent:
+			current += word
+		case currentLen+1+utf8.RuneCountInString(word) > width:
+			wrapped = append(wrapped, current)
+			current = hangingIndent + word
+			currentIndent = hangingIndent
+		default:
+			current += " " + word
+		}
+	}
+
+	wrapped = append(wrapped, current)
+
+	return strings.Join(wrapped, "\n")
+}
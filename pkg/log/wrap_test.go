@@ -0,0 +1,70 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSoftWrap(t *testing.T) {
+	t.Run("short lines are not changed", func(t *testing.T) {
+		require.Equal(t, "short line\n", softWrap("short line\n", 20))
+	})
+
+	t.Run("wrap by words with hanging indent", func(t *testing.T) {
+		require.Equal(t, "Validation error:\n  field is required\n  in document", softWrap("Validation error: field is required in document", 20))
+	})
+
+	t.Run("keep indents of embedded document", func(t *testing.T) {
+		text := "Invalid document:\n  apiVersion: deckhouse.io/v1\n  kind: ClusterConfiguration with very long value\n"
+		expected := "Invalid document:\n  apiVersion: deckhouse.io/v1\n  kind: ClusterConfiguration\n    with very long value\n"
+
+		require.Equal(t, expected, softWrap(text, 30))
+	})
+
+	t.Run("long words are not broken", func(t *testing.T) {
+		require.Equal(t, "a\n  verylongwordwithoutspaces\n  b", softWrap("a verylongwordwithoutspaces b", 10))
+	})
+
+	t.Run("too narrow width", func(t *testing.T) {
+		require.Equal(t, "  some long line", softWrap("  some long line", 4))
+	})
+}
+
+func TestPrettyWrapWarnAndError(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewPrettyLogger(LoggerOptions{
+		OutStream: buf,
+		Width:     40,
+		ColorMode: ColorModeNever,
+	})
+
+	msg := "cannot validate document: field spec.masterNodeGroup.replicas is required"
+
+	logger.WarnF("%s", msg)
+	logger.ErrorF("%s", msg)
+
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+		require.LessOrEqual(t, len([]rune(line)), 40, line)
+	}
+
+	for _, word := range strings.Fields(msg) {
+		require.Equal(t, 2, strings.Count(buf.String(), word), word)
+	}
+}
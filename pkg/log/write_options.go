@@ -0,0 +1,95 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import "bytes"
+
+// WriteOptions
+// configures recording of raw content passed to Write of PrettyLogger and InMemoryLogger,
+// for example output of subprocesses. Zero value records every chunk as one info message
+type WriteOptions struct {
+	// Level
+	// level of written content, LevelInfo if empty
+	Level Level
+	// SeverityRules
+	// level of content is detected with rules like in SeverityWriter,
+	// Level is used if no rule matched
+	SeverityRules []SeverityRule
+	// SplitLines
+	// every line of written content is recorded as separate message.
+	// lines are not buffered between Write calls, last line without new line is recorded as is
+	SplitLines bool
+}
+
+type writtenRecord struct {
+	level Level
+	msg   string
+}
+
+// records
+// returns messages with levels for written content
+func (o WriteOptions) records(content []byte) []writtenRecord {
+	if !o.SplitLines {
+		msg := string(content)
+		return []writtenRecord{{level: o.level(msg), msg: msg}}
+	}
+
+	lines := bytes.SplitAfter(content, []byte("\n"))
+	res := make([]writtenRecord, 0, len(lines))
+
+	for _, line := range lines {
+		if len(line) == 0 {
+			continue
+		}
+
+		msg := string(line)
+		res = append(res, writtenRecord{level: o.level(msg), msg: msg})
+	}
+
+	return res
+}
+
+func (o WriteOptions) level(msg string) Level {
+	for _, rule := range o.SeverityRules {
+		if rule.Regex != nil && rule.Regex.MatchString(msg) {
+			return rule.Level
+		}
+	}
+
+	if o.Level == "" {
+		return LevelInfo
+	}
+
+	return o.Level
+}
+
+// writeRecords
+// writes records of written content to logger with their levels
+func writeRecords(logger baseLogger, records []writtenRecord) {
+	for _, record := range records {
+		format := formattedAsFormat(record.msg)
+
+		switch record.level {
+		case LevelError:
+			logger.ErrorFWithoutLn(format)
+		case LevelWarn:
+			logger.WarnFWithoutLn(format)
+		case LevelDebug:
+			logger.DebugFWithoutLn(format)
+		default:
+			logger.InfoFWithoutLn(format)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteOptions(t *testing.T) {
+	entries := func(logger *InMemoryLogger) []string {
+		res := make([]string, 0)
+		for _, entry := range logger.Entries() {
+			res = append(res, fmt.Sprintf("%s: %s", entry.Level, entry.String()))
+		}
+
+		return res
+	}
+
+	content := []byte("Initializing provider\nError: cannot download 100% of plugin\nlast line")
+
+	t.Run("default records chunk as info", func(t *testing.T) {
+		logger := NewInMemoryLogger()
+
+		_, err := logger.Write(content)
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"info: " + string(content)}, entries(logger))
+	})
+
+	t.Run("level", func(t *testing.T) {
+		logger := NewInMemoryLogger().WithWriteOptions(WriteOptions{Level: LevelDebug})
+
+		_, err := logger.Write([]byte("raw output\n"))
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"debug: raw output\n"}, entries(logger))
+	})
+
+	t.Run("split lines with severity rules", func(t *testing.T) {
+		parent := NewInMemoryLogger()
+		logger := NewInMemoryLoggerWithParent(parent).WithWriteOptions(WriteOptions{
+			SplitLines:    true,
+			SeverityRules: DefaultSeverityRules(),
+		})
+
+		_, err := logger.WithField("step", "terraform").Write(content)
+		require.NoError(t, err)
+
+		require.Equal(t, []string{
+			"info: Initializing provider | fields: [step='terraform']\n",
+			"error: Error: cannot download 100% of plugin | fields: [step='terraform']\n",
+			"info: last line | fields: [step='terraform']",
+		}, entries(logger))
+
+		// parent gets content as is
+		require.Len(t, parent.Entries(), 1)
+	})
+
+	t.Run("pretty logger", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		logger := NewPrettyLogger(LoggerOptions{
+			OutStream: buf,
+			ColorMode: ColorModeNever,
+			Theme:     Theme{DisableEmoji: true},
+			WriteOptions: WriteOptions{
+				SplitLines:    true,
+				SeverityRules: []SeverityRule{{Regex: regexp.MustCompile(`^Warning`), Level: LevelWarn}},
+			},
+		})
+
+		_, err := logger.Write([]byte("line 100%\nWarning: deprecated\n"))
+		require.NoError(t, err)
+
+		require.Equal(t, "line 100%\n[WARN] Warning: deprecated\n", buf.String())
+	})
+}
@@ -0,0 +1,42 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"time"
+)
+
+// Attempt
+// metadata of current attempt passed to task by RunContextTask
+type Attempt struct {
+	// Number
+	// number of current attempt, starts from 1
+	Number int
+	// Total
+	// attempts quantity of loop
+	Total int
+	// LastError
+	// error returned by task on previous attempt, nil for first attempt
+	LastError error
+	// Elapsed
+	// time since start of first attempt
+	Elapsed time.Duration
+}
+
+// IsLast
+// returns true if loop will not retry task after current attempt
+func (a Attempt) IsLast() bool {
+	return a.Number >= a.Total
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoopRunContextTask(t *testing.T) {
+	t.Run("attempts metadata", func(t *testing.T) {
+		attempts := make([]Attempt, 0)
+
+		err := NewLoopWithParams(testLoopParams()).RunContextTask(context.Background(), func(_ context.Context, attempt Attempt) error {
+			attempts = append(attempts, attempt)
+			return fmt.Errorf("error %d", attempt.Number)
+		})
+		require.Error(t, err)
+		require.Len(t, attempts, 3)
+
+		for i, attempt := range attempts {
+			require.Equal(t, i+1, attempt.Number)
+			require.Equal(t, 3, attempt.Total)
+			require.Equal(t, i == 2, attempt.IsLast())
+		}
+
+		require.NoError(t, attempts[0].LastError)
+		require.Less(t, attempts[0].Elapsed, 30*time.Millisecond)
+		require.EqualError(t, attempts[1].LastError, "error 1")
+		require.EqualError(t, attempts[2].LastError, "error 2")
+
+		// loop waits 30ms between attempts
+		require.GreaterOrEqual(t, attempts[2].Elapsed, 60*time.Millisecond)
+		require.Greater(t, attempts[2].Elapsed, attempts[1].Elapsed)
+	})
+
+	t.Run("context passed to task", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		attempt := 0
+		err := NewLoopWithParams(testLoopParams()).RunContextTask(ctx, func(ctx context.Context, _ Attempt) error {
+			attempt++
+			cancel()
+
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, 1, attempt)
+	})
+
+	t.Run("success on later attempt", func(t *testing.T) {
+		err := NewLoopWithParams(testLoopParams()).RunContextTask(context.Background(), func(_ context.Context, attempt Attempt) error {
+			if attempt.IsLast() {
+				return nil
+			}
+
+			return errors.New("error")
+		})
+
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// DefaultBackoffFactor
+// used if factor less than 1 passed to WithBackoff
+const DefaultBackoffFactor = 2.0
+
+type Jitter string
+
+const (
+	// JitterNone
+	// wait exactly computed delay
+	JitterNone Jitter = "none"
+	// JitterFull
+	// wait random duration between zero and computed delay
+	JitterFull Jitter = "full"
+	// JitterEqual
+	// wait half of computed delay and random duration between zero and another half
+	JitterEqual Jitter = "equal"
+)
+
+// Backoff
+// exponential DelayStrategy: Initial * Factor^(attempt-1), but not more than Max
+type Backoff struct {
+	Initial time.Duration
+	Max     time.Duration
+	Factor  float64
+}
+
+func (b *Backoff) Next(attempt int, _ error) time.Duration {
+	d := float64(b.Initial) * math.Pow(b.Factor, float64(attempt-1))
+	if d >= float64(b.Max) {
+		return b.Max
+	}
+
+	return time.Duration(d)
+}
+
+// WithBackoff
+// use exponential backoff instead of fixed wait between attempts.
+// ignored if initial is not positive, maxWait less than initial is replaced with initial,
+// factor less than 1 is replaced with DefaultBackoffFactor
+func WithBackoff(initial, maxWait time.Duration, factor float64) ParamsBuilderOpt {
+	return func(p Params) {
+		if backoff := newBackoff(initial, maxWait, factor); backoff != nil {
+			p.(*params).delayStrategy = backoff
+		}
+	}
+}
+
+// newBackoff
+// returns nil if initial is not positive
+func newBackoff(initial, maxWait time.Duration, factor float64) *Backoff {
+	if initial <= 0 {
+		return nil
+	}
+
+	if maxWait < initial {
+		maxWait = initial
+	}
+
+	if factor < 1 {
+		factor = DefaultBackoffFactor
+	}
+
+	return &Backoff{
+		Initial: initial,
+		Max:     maxWait,
+		Factor:  factor,
+	}
+}
+
+// WithJitter
+// randomize delay between attempts (fixed wait or backoff) for spreading retries of many clients
+func WithJitter(jitter Jitter) ParamsBuilderOpt {
+	return func(p Params) {
+		if jitter != "" {
+			p.(*params).jitter = jitter
+		}
+	}
+}
+
+// delay
+// returns duration for waiting after failed attempt (attempts start from 1),
+// fixed wait is used if strategy is nil
+func delay(wait time.Duration, strategy DelayStrategy, jitter Jitter, attempt int, lastErr error) time.Duration {
+	d := wait
+	if strategy != nil {
+		d = strategy.Next(attempt, lastErr)
+	}
+
+	if d <= 0 {
+		return d
+	}
+
+	switch jitter {
+	case JitterFull:
+		return time.Duration(rand.Int63n(int64(d) + 1))
+	case JitterEqual:
+		half := d / 2
+		return half + time.Duration(rand.Int63n(int64(d-half)+1))
+	default:
+		return d
+	}
+}
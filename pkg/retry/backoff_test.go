@@ -0,0 +1,137 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackoffDelay(t *testing.T) {
+	backoff := newBackoff(100*time.Millisecond, time.Second, 2)
+
+	expected := []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}
+
+	for i, e := range expected {
+		require.Equal(t, e, delay(time.Minute, backoff, JitterNone, i+1, nil), "attempt %d", i+1)
+	}
+
+	// without backoff fixed wait is used
+	require.Equal(t, time.Minute, delay(time.Minute, nil, JitterNone, 5, nil))
+
+	// big attempts do not overflow
+	require.Equal(t, time.Second, delay(0, backoff, JitterNone, 10000, nil))
+
+	t.Run("jitter", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			full := delay(time.Second, nil, JitterFull, 1, nil)
+			require.GreaterOrEqual(t, full, time.Duration(0))
+			require.LessOrEqual(t, full, time.Second)
+
+			equal := delay(time.Second, nil, JitterEqual, 1, nil)
+			require.GreaterOrEqual(t, equal, 500*time.Millisecond)
+			require.LessOrEqual(t, equal, time.Second)
+		}
+	})
+
+	t.Run("normalize", func(t *testing.T) {
+		require.Nil(t, newBackoff(0, time.Second, 2))
+		require.Equal(t, &Backoff{Initial: time.Second, Max: time.Second, Factor: DefaultBackoffFactor}, newBackoff(time.Second, 0, 0))
+	})
+}
+
+func TestParamsBackoff(t *testing.T) {
+	p := NewEmptyParams(
+		WithBackoff(10*time.Millisecond, 40*time.Millisecond, 3),
+		WithJitter(JitterEqual),
+	).(*params)
+
+	require.Equal(t, &Backoff{Initial: 10 * time.Millisecond, Max: 40 * time.Millisecond, Factor: 3}, p.Backoff())
+	require.Equal(t, JitterEqual, p.Jitter())
+
+	cloned := p.Clone(WithName("cloned")).(*params)
+	require.Equal(t, p.Backoff(), cloned.Backoff())
+	require.Equal(t, JitterEqual, cloned.Jitter())
+
+	empty := NewEmptyParams().(*params)
+	require.Nil(t, empty.Backoff())
+	require.Equal(t, JitterNone, empty.Jitter())
+}
+
+func TestParamsCloneWithFixedWait(t *testing.T) {
+	p := NewEmptyParams(
+		WithBackoff(10*time.Millisecond, 40*time.Millisecond, 3),
+		WithJitter(JitterEqual),
+	)
+
+	// WithWait does not reset backoff
+	cloned := p.Clone(WithWait(time.Second)).(*params)
+	require.Equal(t, time.Second, cloned.Wait())
+	require.NotNil(t, cloned.Backoff())
+
+	cloned = p.Clone(WithFixedWait(2 * time.Second)).(*params)
+	require.Equal(t, 2*time.Second, cloned.Wait())
+	require.Nil(t, cloned.DelayStrategy())
+	require.Equal(t, JitterEqual, cloned.Jitter())
+
+	loop := NewLoopWithParams(p.Clone(WithFixedWait(5*time.Millisecond), WithJitter(JitterNone)))
+	require.Equal(t, 5*time.Millisecond, delay(loop.waitTime, loop.delayStrategy, loop.jitter, 3, nil))
+
+	require.NotNil(t, p.(*params).Backoff(), "should not change source params")
+}
+
+func TestLoopWithBackoff(t *testing.T) {
+	p, logger := testLoopParamsWithLogger()
+	p = p.Clone(
+		WithAttempts(4),
+		WithBackoff(5*time.Millisecond, 10*time.Millisecond, 2),
+	)
+
+	attempts := make([]time.Time, 0)
+	err := NewLoopWithParams(p).Run(func() error {
+		attempts = append(attempts, time.Now())
+		return errors.New("error")
+	})
+	require.Error(t, err)
+	require.Len(t, attempts, 4)
+
+	require.GreaterOrEqual(t, attempts[1].Sub(attempts[0]), 5*time.Millisecond)
+	require.GreaterOrEqual(t, attempts[2].Sub(attempts[1]), 10*time.Millisecond)
+
+	for _, wait := range []string{"retry in 5ms", "retry in 10ms"} {
+		matches, err := logger.AllMatches(stringSubmatch(wait))
+		require.NoError(t, err)
+		require.NotEmpty(t, matches, wait)
+	}
+
+	t.Run("loop options", func(t *testing.T) {
+		loop := NewLoopWithParams(testLoopParams()).
+			WithBackoff(time.Second, time.Minute, 2).
+			WithJitter(JitterFull)
+
+		require.Equal(t, &Backoff{Initial: time.Second, Max: time.Minute, Factor: 2}, loop.delayStrategy)
+		require.Equal(t, JitterFull, loop.jitter)
+	})
+}
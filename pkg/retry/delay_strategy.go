@@ -0,0 +1,115 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"time"
+
+	"github.com/name212/govalue"
+)
+
+var (
+	_ DelayStrategy = ConstantDelay(0)
+	_ DelayStrategy = &Backoff{}
+	_ DelayStrategy = &fibonacciDelay{}
+	_ DelayStrategy = DelayStrategyFunc(nil)
+)
+
+// DelayStrategy
+// returns duration for waiting after failed attempt (attempts start from 1) with error of attempt.
+// jitter passed with WithJitter is applied to returned duration
+type DelayStrategy interface {
+	Next(attempt int, lastErr error) time.Duration
+}
+
+// DelayStrategyFunc
+// custom strategy from function
+type DelayStrategyFunc func(attempt int, lastErr error) time.Duration
+
+func (f DelayStrategyFunc) Next(attempt int, lastErr error) time.Duration {
+	return f(attempt, lastErr)
+}
+
+// ConstantDelay
+// same delay for all attempts, used if strategy is not set (see WithWait)
+type ConstantDelay time.Duration
+
+func (d ConstantDelay) Next(int, error) time.Duration {
+	return time.Duration(d)
+}
+
+// ExponentialDelay
+// returns Backoff strategy, see WithBackoff for arguments.
+// returns nil if initial is not positive
+func ExponentialDelay(initial, maxWait time.Duration, factor float64) DelayStrategy {
+	backoff := newBackoff(initial, maxWait, factor)
+	if backoff == nil {
+		return nil
+	}
+
+	return backoff
+}
+
+// FibonacciDelay
+// delay grows as fibonacci numbers: initial, initial, 2*initial, 3*initial, 5*initial, ... but not more than maxWait.
+// returns nil if initial is not positive, maxWait less than initial is replaced with initial
+func FibonacciDelay(initial, maxWait time.Duration) DelayStrategy {
+	if initial <= 0 {
+		return nil
+	}
+
+	return &fibonacciDelay{
+		initial: initial,
+		max:     max(initial, maxWait),
+	}
+}
+
+type fibonacciDelay struct {
+	initial time.Duration
+	max     time.Duration
+}
+
+func (d *fibonacciDelay) Next(attempt int, _ error) time.Duration {
+	prev, cur := time.Duration(0), d.initial
+	for i := 1; i < attempt; i++ {
+		prev, cur = cur, prev+cur
+		if cur >= d.max {
+			return d.max
+		}
+	}
+
+	return min(cur, d.max)
+}
+
+// WithDelayStrategy
+// use strategy for computing delay between attempts instead of fixed wait, nil strategy is ignored
+func WithDelayStrategy(strategy DelayStrategy) ParamsBuilderOpt {
+	return func(p Params) {
+		if !govalue.IsNil(strategy) {
+			p.(*params).delayStrategy = strategy
+		}
+	}
+}
+
+// WithFixedWait
+// use fixed wait between attempts and reset delay strategy (or backoff) set before,
+// for example for cloning params with backoff: p.Clone(WithFixedWait(time.Second))
+// not positive wait is ignored, but strategy is reset anyway
+func WithFixedWait(wait time.Duration) ParamsBuilderOpt {
+	return func(p Params) {
+		WithWait(wait)(p)
+		p.(*params).delayStrategy = nil
+	}
+}
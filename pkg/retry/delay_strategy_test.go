@@ -0,0 +1,114 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelayStrategies(t *testing.T) {
+	next := func(strategy DelayStrategy, attempts int) []time.Duration {
+		res := make([]time.Duration, 0, attempts)
+		for i := 1; i <= attempts; i++ {
+			res = append(res, strategy.Next(i, nil))
+		}
+
+		return res
+	}
+
+	ms := time.Millisecond
+
+	require.Equal(t, []time.Duration{5 * ms, 5 * ms, 5 * ms}, next(ConstantDelay(5*ms), 3))
+	require.Equal(t, []time.Duration{ms, 2 * ms, 4 * ms, 5 * ms}, next(ExponentialDelay(ms, 5*ms, 2), 4))
+	require.Equal(t, []time.Duration{ms, ms, 2 * ms, 3 * ms, 5 * ms, 8 * ms, 10 * ms, 10 * ms}, next(FibonacciDelay(ms, 10*ms), 8))
+
+	require.Nil(t, ExponentialDelay(0, time.Second, 2))
+	require.Nil(t, FibonacciDelay(0, time.Second))
+
+	// big attempts do not overflow
+	require.Equal(t, time.Hour, FibonacciDelay(time.Second, time.Hour).Next(10000, nil))
+
+	t.Run("custom", func(t *testing.T) {
+		errRateLimited := errors.New("rate limited")
+
+		strategy := DelayStrategyFunc(func(_ int, lastErr error) time.Duration {
+			if errors.Is(lastErr, errRateLimited) {
+				return time.Minute
+			}
+
+			return time.Second
+		})
+
+		require.Equal(t, time.Minute, strategy.Next(1, errRateLimited))
+		require.Equal(t, time.Second, strategy.Next(1, errors.New("error")))
+	})
+}
+
+func TestParamsDelayStrategy(t *testing.T) {
+	strategy := FibonacciDelay(time.Second, time.Minute)
+
+	p := NewEmptyParams(WithDelayStrategy(strategy)).(*params)
+	require.Equal(t, strategy, p.DelayStrategy())
+	require.Nil(t, p.Backoff())
+	require.Equal(t, strategy, p.Clone().(*params).DelayStrategy())
+
+	// nil strategy is ignored
+	p = NewEmptyParams(WithDelayStrategy(strategy), WithDelayStrategy(nil)).(*params)
+	require.Equal(t, strategy, p.DelayStrategy())
+
+	// backoff is strategy too
+	p = NewEmptyParams(WithBackoff(time.Second, time.Minute, 2)).(*params)
+	require.Equal(t, p.Backoff(), p.DelayStrategy())
+
+	require.Nil(t, NewEmptyParams().(*params).DelayStrategy())
+}
+
+func TestLoopWithDelayStrategy(t *testing.T) {
+	errRetry := errors.New("retry")
+
+	lastErrors := make([]error, 0)
+	strategy := DelayStrategyFunc(func(attempt int, lastErr error) time.Duration {
+		lastErrors = append(lastErrors, lastErr)
+		return time.Duration(attempt) * time.Millisecond
+	})
+
+	attempt := 0
+	err := NewLoopWithParams(testLoopParams()).
+		WithDelayStrategy(strategy).
+		Run(func() error {
+			attempt++
+			return errRetry
+		})
+
+	require.ErrorIs(t, err, errRetry)
+	require.Equal(t, 3, attempt)
+	require.Equal(t, []error{errRetry, errRetry, errRetry}, lastErrors)
+}
+
+type testForeignParams struct {
+	Params
+}
+
+func TestLoopWithForeignParams(t *testing.T) {
+	p := testForeignParams{Params: NewEmptyParams(WithDelayStrategy(ConstantDelay(time.Hour)))}
+
+	loop := NewLoopWithParams(p)
+	require.Nil(t, loop.delayStrategy, "should use fixed wait for foreign params")
+	require.Equal(t, JitterNone, loop.jitter)
+}
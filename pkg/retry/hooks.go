@@ -0,0 +1,43 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"time"
+)
+
+// OnRetryFunc
+// called after failed attempt before waiting nextWait for next attempt
+type OnRetryFunc func(attempt int, err error, nextWait time.Duration)
+
+// OnGiveUpFunc
+// called with error returned by loop if loop stops without success:
+// all attempts failed, loop was broken with BreakIf, canceled or interrupted
+type OnGiveUpFunc func(err error)
+
+// WithOnRetry
+// hook for emitting metrics, updating progress or cleaning up partial state between attempts.
+// hook is not called after last attempt
+func (l *Loop) WithOnRetry(hook OnRetryFunc) *Loop {
+	l.onRetry = hook
+	return l
+}
+
+// WithOnGiveUp
+// see OnGiveUpFunc
+func (l *Loop) WithOnGiveUp(hook OnGiveUpFunc) *Loop {
+	l.onGiveUp = hook
+	return l
+}
@@ -0,0 +1,100 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoopHooks(t *testing.T) {
+	type retryCall struct {
+		attempt  int
+		err      string
+		nextWait time.Duration
+	}
+
+	t.Run("give up after all attempts", func(t *testing.T) {
+		retries := make([]retryCall, 0)
+		giveUps := make([]error, 0)
+
+		attempt := 0
+		err := NewLoopWithParams(testLoopParams()).
+			WithOnRetry(func(attempt int, err error, nextWait time.Duration) {
+				retries = append(retries, retryCall{attempt: attempt, err: err.Error(), nextWait: nextWait})
+			}).
+			WithOnGiveUp(func(err error) {
+				giveUps = append(giveUps, err)
+			}).
+			Run(func() error {
+				attempt++
+				return fmt.Errorf("error %d", attempt)
+			})
+
+		require.Error(t, err)
+		require.Equal(t, []retryCall{
+			{attempt: 1, err: "error 1", nextWait: 30 * time.Millisecond},
+			{attempt: 2, err: "error 2", nextWait: 30 * time.Millisecond},
+		}, retries)
+		require.Equal(t, []error{err}, giveUps)
+	})
+
+	t.Run("give up on break", func(t *testing.T) {
+		errBreak := errors.New("break")
+
+		var giveUpErr error
+		err := NewLoopWithParams(testLoopParams()).
+			BreakIf(IsErr(errBreak)).
+			WithOnGiveUp(func(err error) {
+				giveUpErr = err
+			}).
+			Run(func() error {
+				return errBreak
+			})
+
+		require.ErrorIs(t, err, errBreak)
+		require.ErrorIs(t, giveUpErr, errBreak)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		retries := 0
+		giveUp := false
+
+		attempt := 0
+		err := NewLoopWithParams(testLoopParams()).
+			WithOnRetry(func(int, error, time.Duration) {
+				retries++
+			}).
+			WithOnGiveUp(func(error) {
+				giveUp = true
+			}).
+			Run(func() error {
+				attempt++
+				if attempt < 2 {
+					return errors.New("error")
+				}
+
+				return nil
+			})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, retries)
+		require.False(t, giveUp)
+	})
+}
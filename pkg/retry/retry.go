@@ -83,6 +83,9 @@ func LoggerWithNameOpts(logger log.Logger, format string, args ...any) []ParamsB
 	}
 }
 
+// WithWait
+// set fixed wait between attempts, used if delay strategy is not set.
+// WithWait does not reset delay strategy, use WithFixedWait for it
 func WithWait(wait time.Duration) ParamsBuilderOpt {
 	return func(p Params) {
 		if wait > 0 {
@@ -103,7 +106,11 @@ type params struct {
 	name     string
 	attempts int
 	wait     time.Duration
-	logger   log.Logger
+	// delayStrategy
+	// nil if fixed wait is used
+	delayStrategy DelayStrategy
+	jitter        Jitter
+	logger        log.Logger
 }
 
 // NewParams
@@ -123,6 +130,7 @@ func NewEmptyParams(opts ...ParamsBuilderOpt) Params {
 		name:     NotSetName,
 		attempts: 1,
 		wait:     1 * time.Second,
+		jitter:   JitterNone,
 		logger:   defaultLogger,
 	}
 
@@ -149,6 +157,42 @@ func (p *params) Wait() time.Duration {
 	return p.wait
 }
 
+// Backoff
+// nil if exponential backoff is not used
+func (p *params) Backoff() *Backoff {
+	backoff, ok := p.delayStrategy.(*Backoff)
+	if !ok {
+		return nil
+	}
+
+	b := *backoff
+	return &b
+}
+
+// DelayStrategy
+// nil if fixed wait is used
+func (p *params) DelayStrategy() DelayStrategy {
+	return p.delayStrategy
+}
+
+func (p *params) Jitter() Jitter {
+	return p.jitter
+}
+
+// paramsDelay
+// delay strategy and jitter are not part of Params interface,
+// returns fixed wait without jitter for foreign Params implementations
+func paramsDelay(p Params) (DelayStrategy, Jitter) {
+	if typed, ok := p.(*params); ok {
+		return typed.delayStrategy, typed.jitter
+	}
+
+	return nil, JitterNone
+}
+
+// Clone
+// clone keeps delay strategy and jitter, so WithWait in overrides does not affect params with
+// delay strategy or backoff, pass WithFixedWait for switching clone to fixed wait
 func (p *params) Clone(overrides ...ParamsBuilderOpt) Params {
 	if govalue.IsNil(p) {
 		return nil
@@ -158,6 +202,8 @@ func (p *params) Clone(overrides ...ParamsBuilderOpt) Params {
 		WithName("%s", p.Name()),
 		WithAttempts(p.Attempts()),
 		WithWait(p.Wait()),
+		WithDelayStrategy(p.DelayStrategy()),
+		WithJitter(p.Jitter()),
 		WithLogger(p.Logger()),
 	}
 
@@ -179,11 +225,16 @@ type Loop struct {
 	name             string
 	attemptsQuantity int
 	waitTime         time.Duration
+	delayStrategy    DelayStrategy
+	jitter           Jitter
 	breakPredicate   BreakPredicate
+	retryable        RetryablePredicate
 	logger           log.Logger
 	interruptable    bool
 	showError        bool
 	prefix           string
+	onRetry          OnRetryFunc
+	onGiveUp         OnGiveUpFunc
 }
 
 // NewLoop create Loop with features:
@@ -208,10 +259,14 @@ func NewLoopWithParams(params Params) *Loop {
 		p = NewEmptyParams()
 	}
 
+	delayStrategy, jitter := paramsDelay(p)
+
 	return &Loop{
 		name:             p.Name(),
 		attemptsQuantity: p.Attempts(),
 		waitTime:         p.Wait(),
+		delayStrategy:    delayStrategy,
+		jitter:           jitter,
 		logger:           params.Logger(),
 		interruptable:    true,
 		showError:        true,
@@ -249,16 +304,20 @@ func NewSilentLoopWithParams(params Params) *Loop {
 		logger = defaultLogger
 	}
 
+	delayStrategy, jitter := paramsDelay(p)
+
 	name := p.Name()
 	return &Loop{
 		name:             name,
 		attemptsQuantity: p.Attempts(),
 		waitTime:         p.Wait(),
+		delayStrategy:    delayStrategy,
+		jitter:           jitter,
 		logger:           logger.SilentLogger(),
 		// - this loop is not interruptable by the signal watcher in tomb package.
 		interruptable: false,
 		showError:     true,
-		prefix:        fmt.Sprintf("[%s][%d] ", name, rand.Int()),
+		prefix:        fmt.Sprintf("[%s][%d]", name, rand.Int()),
 	}
 }
 
@@ -286,16 +345,53 @@ func (l *Loop) WithShowError(flag bool) *Loop {
 	return l
 }
 
+// WithBackoff
+// see WithBackoff params option
+func (l *Loop) WithBackoff(initial, maxWait time.Duration, factor float64) *Loop {
+	if backoff := newBackoff(initial, maxWait, factor); backoff != nil {
+		l.delayStrategy = backoff
+	}
+
+	return l
+}
+
+// WithDelayStrategy
+// see WithDelayStrategy params option
+func (l *Loop) WithDelayStrategy(strategy DelayStrategy) *Loop {
+	if !govalue.IsNil(strategy) {
+		l.delayStrategy = strategy
+	}
+
+	return l
+}
+
+func (l *Loop) WithJitter(jitter Jitter) *Loop {
+	l.jitter = jitter
+	return l
+}
+
 func (l *Loop) Run(task func() error) error {
-	return l.run(context.Background(), task)
+	return l.run(context.Background(), taskWithoutAttempt(task))
 }
 
 // RunContext retries a task like Run but breaks if context done.
 func (l *Loop) RunContext(ctx context.Context, task func() error) error {
+	return l.run(ctx, taskWithoutAttempt(task))
+}
+
+// RunContextTask retries a task like RunContext but passes context and attempt metadata to task,
+// so task can honor cancellation during its own work and adjust behavior on later attempts.
+func (l *Loop) RunContextTask(ctx context.Context, task func(ctx context.Context, attempt Attempt) error) error {
 	return l.run(ctx, task)
 }
 
-func (l *Loop) run(ctx context.Context, task func() error) error {
+func taskWithoutAttempt(task func() error) func(context.Context, Attempt) error {
+	return func(context.Context, Attempt) error {
+		return task()
+	}
+}
+
+func (l *Loop) run(ctx context.Context, task func(ctx context.Context, attempt Attempt) error) error {
 	if govalue.IsNil(l.logger) {
 		return fmt.Errorf("Logger is not provide for loop %s", l.name)
 	}
@@ -304,8 +400,13 @@ func (l *Loop) run(ctx context.Context, task func() error) error {
 		return fmt.Errorf("Attempts quantity must be greater than zero for loop '%s'", l.name)
 	}
 
+	// raw prefix keeps messages like "[name][id] Succeeded!"
+	// and is not used as component name for component levels
+	logger := log.WithRawPrefix(l.logger, l.prefix)
+
 	loopBody := func() error {
 		var err error
+		start := time.Now()
 		for i := 1; i <= l.attemptsQuantity; i++ {
 			// Check if process is interrupted.
 			if l.interruptable && globalInterruptChecker() {
@@ -313,28 +414,44 @@ func (l *Loop) run(ctx context.Context, task func() error) error {
 			}
 
 			// Run task and return if everything is ok.
-			err = task()
+			err = task(ctx, Attempt{
+				Number:    i,
+				Total:     l.attemptsQuantity,
+				LastError: err,
+				Elapsed:   time.Since(start),
+			})
 			if err == nil {
-				l.logger.Success(l.prefix + "Succeeded!")
+				logger.Success("Succeeded!")
 				return nil
 			}
 
 			if l.breakPredicate != nil && l.breakPredicate(err) {
-				l.logger.DebugF(l.prefix+"Client break loop with %v", err)
+				logger.DebugF("Client break loop with %v", err)
+				return err
+			}
+
+			if l.retryable != nil && !l.retryable(err) {
+				logger.DebugF("Error is not retryable, break loop with %v", err)
 				return err
 			}
 
-			l.logger.FailRetry(fmt.Sprintf(l.prefix+attemptMessage, i, l.attemptsQuantity, l.name, l.waitTime))
+			wait := delay(l.waitTime, l.delayStrategy, l.jitter, i, err)
+
+			logger.FailRetry(fmt.Sprintf(attemptMessage, i, l.attemptsQuantity, l.name, wait))
 			errorMsg := "\t%v\n\n"
 			if l.showError {
 				errorMsg = "\tStatus: %v\n\n"
 			}
-			l.logger.InfoF(l.prefix+errorMsg, err)
+			logger.InfoF(errorMsg, err)
 
 			// Do not waitTime after the last iteration.
 			if i < l.attemptsQuantity {
+				if l.onRetry != nil {
+					l.onRetry(i, err, wait)
+				}
+
 				select {
-				case <-time.After(l.waitTime):
+				case <-time.After(wait):
 				case <-ctx.Done():
 					return fmt.Errorf("Loop was canceled: %w", ctx.Err())
 				}
@@ -344,5 +461,12 @@ func (l *Loop) run(ctx context.Context, task func() error) error {
 		return fmt.Errorf("Timeout while %q: last error: %w", l.name, err)
 	}
 
-	return l.logger.Process(log.ProcessDefault, l.name, loopBody)
+	return l.logger.Process(log.ProcessDefault, l.name, func() error {
+		err := loopBody()
+		if err != nil && l.onGiveUp != nil {
+			l.onGiveUp(err)
+		}
+
+		return err
+	})
 }
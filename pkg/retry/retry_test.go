@@ -132,6 +132,36 @@ func TestSilentLoop(t *testing.T) {
 	require.Len(t, matches, 0)
 }
 
+func TestSilentLoopPrefix(t *testing.T) {
+	p, logger := testLoopParamsWithLogger()
+	loop := NewSilentLoopWithParams(p).WithLogger(logger)
+	err := loop.Run(func() error {
+		return nil
+	})
+	require.NoError(t, err)
+
+	match, err := logger.FirstMatch(&log.Match{
+		Regex: []*regexp.Regexp{regexp.MustCompile(`\[test loop\]\[\d+\] Succeeded!`)},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, match)
+}
+
+func TestSilentLoopNameWithPercent(t *testing.T) {
+	p, logger := testLoopParamsWithLogger()
+	loop := NewSilentLoopWithParams(p.Clone(WithName("%s", "100% ready %s"))).WithLogger(logger)
+	err := loop.Run(func() error {
+		return nil
+	})
+	require.NoError(t, err)
+
+	match, err := logger.FirstMatch(&log.Match{
+		Regex: []*regexp.Regexp{regexp.MustCompile(`\[100% ready %s\]\[\d+\] Succeeded!`)},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, match)
+}
+
 func TestGlobalDefaultLogger(t *testing.T) {
 	p, logger := testLoopParamsWithLogger()
 	SetGlobalDefaultLogger(logger)
@@ -164,6 +194,10 @@ func TestGlobalGlobalInterruptChecker(t *testing.T) {
 	}
 
 	SetGlobalInterruptChecker(checker)
+	t.Cleanup(func() {
+		SetGlobalInterruptChecker(func() bool { return false })
+	})
+
 	attempt := 0
 	loop := NewLoopWithParams(testLoopParams())
 	err := loop.Run(func() error {
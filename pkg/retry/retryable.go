@@ -0,0 +1,87 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"errors"
+	"io"
+	"net"
+	"syscall"
+)
+
+// RetryablePredicate
+// returns false if task should not be retried with error, see Loop.WithRetryable
+type RetryablePredicate func(err error) bool
+
+// WithRetryable
+// loop stops with error of attempt if predicate returns false for it instead of burning all attempts.
+// complements BreakIf: break predicate is checked first
+func (l *Loop) WithRetryable(pred RetryablePredicate) *Loop {
+	l.retryable = pred
+	return l
+}
+
+// AnyRetryable
+// returns true if any of predicates returns true.
+// library does not depend on k8s apimachinery, pass apierrors predicates for kubernetes api errors:
+//
+//	AnyRetryable(apierrors.IsConflict, apierrors.IsServerTimeout, apierrors.IsTooManyRequests, IsNetTimeout)
+func AnyRetryable(preds ...RetryablePredicate) RetryablePredicate {
+	return func(err error) bool {
+		for _, pred := range preds {
+			if pred != nil && pred(err) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// IsNetTimeout
+// returns true for network errors with timeout
+func IsNetTimeout(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+var temporaryConnectionErrors = []error{
+	io.EOF,
+	io.ErrUnexpectedEOF,
+	syscall.ECONNRESET,
+	syscall.ECONNREFUSED,
+	syscall.ECONNABORTED,
+	syscall.EPIPE,
+	syscall.EHOSTUNREACH,
+	syscall.ENETUNREACH,
+}
+
+// IsTemporarySSHError
+// returns true for network timeouts and connection errors which usually happen
+// while ssh connection is establishing or node is rebooting:
+// unexpected EOF, connection reset, refused or aborted, broken pipe, host or network unreachable
+func IsTemporarySSHError(err error) bool {
+	if IsNetTimeout(err) {
+		return true
+	}
+
+	for _, target := range temporaryConnectionErrors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
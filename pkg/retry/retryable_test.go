@@ -0,0 +1,125 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testTimeoutError struct{}
+
+func (testTimeoutError) Error() string   { return "timeout" }
+func (testTimeoutError) Timeout() bool   { return true }
+func (testTimeoutError) Temporary() bool { return true }
+
+func TestLoopWithRetryable(t *testing.T) {
+	errRetryable := errors.New("retryable")
+	errPermanent := errors.New("permanent")
+
+	retryable := func(err error) bool {
+		return errors.Is(err, errRetryable)
+	}
+
+	t.Run("not retryable error stops loop", func(t *testing.T) {
+		attempt := 0
+		err := NewLoopWithParams(testLoopParams()).
+			WithRetryable(retryable).
+			Run(func() error {
+				attempt++
+				if attempt == 1 {
+					return errRetryable
+				}
+
+				return errPermanent
+			})
+
+		require.ErrorIs(t, err, errPermanent)
+		require.Equal(t, 2, attempt)
+	})
+
+	t.Run("retryable errors burn all attempts", func(t *testing.T) {
+		attempt := 0
+		err := NewLoopWithParams(testLoopParams()).
+			WithRetryable(retryable).
+			Run(func() error {
+				attempt++
+				return errRetryable
+			})
+
+		require.ErrorIs(t, err, errRetryable)
+		require.Equal(t, 3, attempt)
+	})
+
+	t.Run("break predicate checked first", func(t *testing.T) {
+		breakChecked := false
+		err := NewLoopWithParams(testLoopParams()).
+			BreakIf(func(err error) bool {
+				breakChecked = true
+				return errors.Is(err, errRetryable)
+			}).
+			WithRetryable(retryable).
+			Run(func() error {
+				return errRetryable
+			})
+
+		require.ErrorIs(t, err, errRetryable)
+		require.True(t, breakChecked)
+	})
+}
+
+func TestRetryablePredicates(t *testing.T) {
+	timeoutErr := &net.OpError{Op: "dial", Net: "tcp", Err: testTimeoutError{}}
+
+	require.True(t, IsNetTimeout(timeoutErr))
+	require.True(t, IsNetTimeout(fmt.Errorf("wrapped: %w", os.ErrDeadlineExceeded)))
+	require.False(t, IsNetTimeout(errors.New("error")))
+	require.False(t, IsNetTimeout(&net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}))
+
+	for _, err := range []error{
+		timeoutErr,
+		io.EOF,
+		fmt.Errorf("ssh: handshake failed: %w", io.ErrUnexpectedEOF),
+		&net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)},
+		&net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED},
+	} {
+		require.True(t, IsTemporarySSHError(err), err.Error())
+	}
+
+	require.False(t, IsTemporarySSHError(errors.New("ssh: unable to authenticate")))
+	require.False(t, IsTemporarySSHError(context.Canceled))
+
+	t.Run("any", func(t *testing.T) {
+		errConflict := errors.New("conflict")
+		isConflict := func(err error) bool {
+			return errors.Is(err, errConflict)
+		}
+
+		pred := AnyRetryable(isConflict, nil, IsNetTimeout)
+		require.True(t, pred(errConflict))
+		require.True(t, pred(timeoutErr))
+		require.False(t, pred(errors.New("error")))
+
+		require.False(t, AnyRetryable()(errConflict))
+	})
+}
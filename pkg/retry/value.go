@@ -0,0 +1,41 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"context"
+)
+
+// RunValue retries a task like RunContext and returns result of successful attempt.
+// zero value is returned with error if all attempts failed or loop was canceled
+func RunValue[T any](ctx context.Context, loop *Loop, task func(ctx context.Context) (T, error)) (T, error) {
+	var res T
+
+	err := loop.RunContextTask(ctx, func(ctx context.Context, _ Attempt) error {
+		value, err := task(ctx)
+		if err != nil {
+			return err
+		}
+
+		res = value
+		return nil
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return res, nil
+}
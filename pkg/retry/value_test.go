@@ -0,0 +1,64 @@
+// Copyright 2025 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunValue(t *testing.T) {
+	t.Run("success after retries", func(t *testing.T) {
+		attempt := 0
+		res, err := RunValue(context.Background(), NewLoopWithParams(testLoopParams()), func(context.Context) (string, error) {
+			attempt++
+			if attempt < 3 {
+				return "partial", errors.New("temporary error")
+			}
+
+			return "result", nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "result", res)
+	})
+
+	t.Run("all attempts failed", func(t *testing.T) {
+		errForTest := errors.New("error")
+
+		res, err := RunValue(context.Background(), NewLoopWithParams(testLoopParams()), func(context.Context) (*int, error) {
+			value := 1
+			return &value, errForTest
+		})
+
+		require.ErrorIs(t, err, errForTest)
+		require.Nil(t, res)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		res, err := RunValue(ctx, NewLoopWithParams(testLoopParams()), func(ctx context.Context) (int, error) {
+			return 42, ctx.Err()
+		})
+
+		require.ErrorIs(t, err, context.Canceled)
+		require.Zero(t, res)
+	})
+}
@@ -0,0 +1,235 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+const mergeTag = "!!merge"
+
+var ErrAliasesNotAllowed = errors.New("yaml aliases are not allowed")
+
+type AliasPolicy int
+
+const (
+	// AliasPolicyAllow
+	// aliases are allowed and kept as is. Default policy
+	AliasPolicyAllow AliasPolicy = iota
+	// AliasPolicyExpand
+	// aliases and merge keys are replaced with anchored values, anchors are removed
+	AliasPolicyExpand
+	// AliasPolicyReject
+	// documents with aliases are rejected with ErrAliasesNotAllowed
+	AliasPolicyReject
+)
+
+type parseOptions struct {
+	aliasPolicy AliasPolicy
+	limits      Limits
+}
+
+type ParseOption func(o *parseOptions)
+
+// ParseWithAliasPolicy
+// set policy for anchors and aliases
+// for AliasPolicyExpand if MaxAliasExpansion limit is not set,
+// limit from DefaultLimits will be used for preventing "billion laughs" attack
+func ParseWithAliasPolicy(policy AliasPolicy) ParseOption {
+	return func(o *parseOptions) {
+		o.aliasPolicy = policy
+	}
+}
+
+// ParseWithLimits
+// check content with limits before parsing
+func ParseWithLimits(limits Limits) ParseOption {
+	return func(o *parseOptions) {
+		o.limits = limits
+	}
+}
+
+func newParseOptions(opts ...ParseOption) *parseOptions {
+	options := &parseOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	if options.aliasPolicy == AliasPolicyExpand && options.limits.MaxAliasExpansion <= 0 {
+		options.limits.MaxAliasExpansion = DefaultLimits().MaxAliasExpansion
+	}
+
+	return options
+}
+
+// prepare
+// checks limits and applies alias policy for content
+// returns content without changes for AliasPolicyAllow and AliasPolicyReject
+func (o *parseOptions) prepare(content []byte) ([]byte, error) {
+	if err := CheckLimits(content, o.limits); err != nil {
+		return nil, err
+	}
+
+	switch o.aliasPolicy {
+	case AliasPolicyReject:
+		return content, rejectAliases(content)
+	case AliasPolicyExpand:
+		return expandAliases(content)
+	default:
+		return content, nil
+	}
+}
+
+func rejectAliases(content []byte) error {
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		node := &yaml.Node{}
+		// io.EOF or invalid yaml. Invalid yaml will be reported by unmarshal
+		if err := decoder.Decode(node); err != nil {
+			return nil
+		}
+
+		if alias := findAlias(node); alias != nil {
+			return fmt.Errorf("%w: alias *%s at line %d", ErrAliasesNotAllowed, alias.Value, alias.Line)
+		}
+	}
+}
+
+func findAlias(node *yaml.Node) *yaml.Node {
+	if node.Kind == yaml.AliasNode {
+		return node
+	}
+
+	for _, child := range node.Content {
+		if alias := findAlias(child); alias != nil {
+			return alias
+		}
+	}
+
+	return nil
+}
+
+// expandAliases
+// returns all documents from content with expanded aliases and merge keys
+// content without aliases is returned as is
+func expandAliases(content []byte) ([]byte, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+
+	docs := make([]*yaml.Node, 0)
+	hasAliases := false
+
+	for {
+		node := &yaml.Node{}
+		err := decoder.Decode(node)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if findAlias(node) != nil {
+			hasAliases = true
+		}
+
+		docs = append(docs, node)
+	}
+
+	if !hasAliases {
+		return content, nil
+	}
+
+	result := bytes.Buffer{}
+	for i, doc := range docs {
+		expanded, err := encode(expandNode(doc), defaultIndent)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal expanded document: %w", err)
+		}
+
+		if i > 0 {
+			result.WriteString(yamlSeparator + "\n")
+		}
+
+		result.Write(expanded)
+	}
+
+	return result.Bytes(), nil
+}
+
+// expandNode
+// returns deep copy of node with replaced aliases with copies of anchored nodes
+// merge keys are replaced with fields of merged mappings, anchors are removed
+func expandNode(node *yaml.Node) *yaml.Node {
+	if node.Kind == yaml.AliasNode {
+		expanded := expandNode(node.Alias)
+		copyComments(node, expanded)
+		return expanded
+	}
+
+	result := *node
+	result.Anchor = ""
+	result.Alias = nil
+	result.Content = make([]*yaml.Node, 0, len(node.Content))
+
+	if node.Kind != yaml.MappingNode {
+		for _, child := range node.Content {
+			result.Content = append(result.Content, expandNode(child))
+		}
+
+		return &result
+	}
+
+	merged := make([]*yaml.Node, 0)
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+
+		if key.ShortTag() != mergeTag {
+			result.Content = append(result.Content, expandNode(key), expandNode(value))
+			continue
+		}
+
+		value = resolveAlias(value)
+
+		sources := []*yaml.Node{value}
+		if value.Kind == yaml.SequenceNode {
+			sources = value.Content
+		}
+
+		for _, source := range sources {
+			source = expandNode(source)
+			if source.Kind == yaml.MappingNode {
+				merged = append(merged, source.Content...)
+			}
+		}
+	}
+
+	// explicit keys override merged, first merged source overrides next ones
+	for i := 0; i+1 < len(merged); i += 2 {
+		if mappingValueIndex(&result, merged[i].Value) >= 0 {
+			continue
+		}
+
+		result.Content = append(result.Content, merged[i], merged[i+1])
+	}
+
+	return &result
+}
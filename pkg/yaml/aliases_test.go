@@ -0,0 +1,114 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testAliasesDoc = `apiVersion: deckhouse.io/v1
+kind: OpenStackClusterConfiguration
+defaults: &defaults
+  replicas: 1
+  volumeType: fast
+masterNodeGroup:
+  <<: *defaults
+  replicas: 3
+nodeGroups:
+  - name: front
+    settings: *defaults
+---
+kind: Second
+`
+
+func TestAliasPolicy(t *testing.T) {
+	type nodeGroup struct {
+		Name     string         `yaml:"name"`
+		Settings map[string]any `yaml:"settings"`
+	}
+
+	type config struct {
+		MasterNodeGroup map[string]any `yaml:"masterNodeGroup"`
+		NodeGroups      []nodeGroup    `yaml:"nodeGroups"`
+	}
+
+	t.Run("allow by default", func(t *testing.T) {
+		cfg, err := UnmarshalString[config](testAliasesDoc)
+		require.NoError(t, err)
+		require.Equal(t, 3, cfg.MasterNodeGroup["replicas"])
+		require.Equal(t, "fast", cfg.NodeGroups[0].Settings["volumeType"])
+
+		docs, err := SplitYAMLWithOptions(testAliasesDoc)
+		require.NoError(t, err)
+		require.Equal(t, SplitYAML(testAliasesDoc), docs)
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		_, err := UnmarshalString[config](testAliasesDoc, ParseWithAliasPolicy(AliasPolicyReject))
+		require.ErrorIs(t, err, ErrAliasesNotAllowed)
+		require.ErrorContains(t, err, "*defaults at line 7")
+
+		_, err = SplitYAMLWithOptions(testAliasesDoc, ParseWithAliasPolicy(AliasPolicyReject))
+		require.ErrorIs(t, err, ErrAliasesNotAllowed)
+
+		docs, err := SplitYAMLWithOptions("a: &a 1\n---\nb: 2\n", ParseWithAliasPolicy(AliasPolicyReject))
+		require.NoError(t, err, "anchors without aliases are allowed")
+		require.Len(t, docs, 2)
+	})
+
+	t.Run("expand", func(t *testing.T) {
+		docs, err := SplitYAMLWithOptions(testAliasesDoc, ParseWithAliasPolicy(AliasPolicyExpand))
+		require.NoError(t, err)
+		require.Len(t, docs, 2)
+
+		expected := `apiVersion: deckhouse.io/v1
+kind: OpenStackClusterConfiguration
+defaults:
+  replicas: 1
+  volumeType: fast
+masterNodeGroup:
+  replicas: 3
+  volumeType: fast
+nodeGroups:
+  - name: front
+    settings:
+      replicas: 1
+      volumeType: fast`
+		require.Equal(t, expected, docs[0])
+		require.Equal(t, "kind: Second", docs[1])
+
+		cfg, err := UnmarshalString[config](testAliasesDoc, ParseWithAliasPolicy(AliasPolicyExpand))
+		require.NoError(t, err)
+		require.Equal(t, 3, cfg.MasterNodeGroup["replicas"])
+		require.Equal(t, "fast", cfg.MasterNodeGroup["volumeType"])
+	})
+
+	t.Run("expand without aliases keeps document", func(t *testing.T) {
+		const doc = "# comment\na:   1\n"
+		docs, err := SplitYAMLWithOptions(doc, ParseWithAliasPolicy(AliasPolicyExpand))
+		require.NoError(t, err)
+		require.Equal(t, []string{"# comment\na:   1"}, docs)
+	})
+
+	t.Run("expand limits", func(t *testing.T) {
+		_, err := SplitYAMLWithOptions(testBillionLaughs, ParseWithAliasPolicy(AliasPolicyExpand))
+		require.ErrorIs(t, err, ErrLimitExceeded)
+
+		_, err = UnmarshalString[map[string]any](testAliasesDoc, ParseWithLimits(Limits{MaxDepth: 1}))
+		require.ErrorIs(t, err, ErrLimitExceeded)
+	})
+}
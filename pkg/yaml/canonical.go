@@ -0,0 +1,80 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Canonicalize
+// returns canonical form of yaml documents which does not depend on formatting:
+// comments, anchors and aliases, keys order, quoting, indentation
+// and scalars formats (for example, 0x1 and 1) are normalized
+// empty documents are skipped, multiple documents are separated with "---"
+func Canonicalize(doc []byte) ([]byte, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(doc))
+
+	result := bytes.Buffer{}
+
+	for {
+		var obj any
+		err := decoder.Decode(&obj)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal document: %w", err)
+		}
+
+		if obj == nil {
+			continue
+		}
+
+		canonical, err := MarshalStable(obj, MarshalStableWithSortedKeys())
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Len() > 0 {
+			result.WriteString(yamlSeparator + "\n")
+		}
+
+		result.Write(canonical)
+	}
+
+	return result.Bytes(), nil
+}
+
+// Hash
+// returns hex encoded sha256 of canonical form of doc
+// documents which are different only in formatting have the same hash
+func Hash(doc []byte) (string, error) {
+	canonical, err := Canonicalize(doc)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(canonical)
+
+	return hex.EncodeToString(sum[:]), nil
+}
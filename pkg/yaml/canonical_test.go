@@ -0,0 +1,89 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalize(t *testing.T) {
+	const doc = `
+# provider configuration
+apiVersion: deckhouse.io/v1
+kind: OpenStackClusterConfiguration
+defaults: &defaults
+  replicas: 1
+masterNodeGroup:
+  <<: *defaults
+  instanceClass:
+    flavorName: m1.large
+    rootDiskSize: 50
+`
+
+	const reformatted = `kind: "OpenStackClusterConfiguration"
+apiVersion: 'deckhouse.io/v1'
+masterNodeGroup: {instanceClass: {rootDiskSize: 0x32, flavorName: m1.large}, replicas: 1}
+defaults:
+    replicas: 1
+`
+
+	t.Run("canonical form", func(t *testing.T) {
+		canonical, err := Canonicalize([]byte(doc))
+		require.NoError(t, err)
+
+		expected := `apiVersion: deckhouse.io/v1
+defaults:
+  replicas: 1
+kind: OpenStackClusterConfiguration
+masterNodeGroup:
+  instanceClass:
+    flavorName: m1.large
+    rootDiskSize: 50
+  replicas: 1
+`
+		require.Equal(t, expected, string(canonical))
+
+		canonicalReformatted, err := Canonicalize([]byte(reformatted))
+		require.NoError(t, err)
+		require.Equal(t, expected, string(canonicalReformatted))
+	})
+
+	t.Run("multiple documents", func(t *testing.T) {
+		canonical, err := Canonicalize([]byte("---\nb: 1\na: 2\n---\n---\n# empty\n---\nc: 3\n"))
+		require.NoError(t, err)
+		require.Equal(t, "a: 2\nb: 1\n---\nc: 3\n", string(canonical))
+	})
+
+	t.Run("hash", func(t *testing.T) {
+		hash, err := Hash([]byte(doc))
+		require.NoError(t, err)
+		require.Len(t, hash, 64)
+
+		reformattedHash, err := Hash([]byte(reformatted))
+		require.NoError(t, err)
+		require.Equal(t, hash, reformattedHash)
+
+		changedHash, err := Hash([]byte(reformatted + "clusterType: Cloud\n"))
+		require.NoError(t, err)
+		require.NotEqual(t, hash, changedHash)
+	})
+
+	t.Run("invalid document", func(t *testing.T) {
+		_, err := Hash([]byte("a: [1"))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,262 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/deckhouse/lib-dhctl/pkg/log"
+)
+
+type DiffChangeType string
+
+const (
+	DiffAdded   DiffChangeType = "added"
+	DiffRemoved DiffChangeType = "removed"
+	DiffChanged DiffChangeType = "changed"
+)
+
+type DiffChange struct {
+	Type DiffChangeType
+	// Path
+	// path to changed value in GetPath format, empty for root
+	Path string
+	// Old
+	// nil for added values
+	Old any
+	// New
+	// nil for removed values
+	New any
+}
+
+func (c *DiffChange) String() string {
+	path := c.Path
+	if path == "" {
+		path = "<root>"
+	}
+
+	switch c.Type {
+	case DiffAdded:
+		return fmt.Sprintf("+ %s: %s", path, formatDiffValue(c.New))
+	case DiffRemoved:
+		return fmt.Sprintf("- %s: %s", path, formatDiffValue(c.Old))
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", path, formatDiffValue(c.Old), formatDiffValue(c.New))
+	}
+}
+
+type DiffReport struct {
+	// Changes
+	// in document order, map keys are sorted
+	Changes []DiffChange
+}
+
+func (r *DiffReport) IsEmpty() bool {
+	return r == nil || len(r.Changes) == 0
+}
+
+func (r *DiffReport) add(changeType DiffChangeType, path []pathSegment, before, after any) {
+	r.Changes = append(r.Changes, DiffChange{
+		Type: changeType,
+		Path: joinSegments(path),
+		Old:  before,
+		New:  after,
+	})
+}
+
+// Render
+// writes changes in human-readable format, one change per line:
+// "+ path: value" for added, "- path: value" for removed, "~ path: old -> new" for changed
+func (r *DiffReport) Render(w io.Writer) error {
+	if r.IsEmpty() {
+		return nil
+	}
+
+	for _, change := range r.Changes {
+		if _, err := fmt.Fprintln(w, change.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *DiffReport) String() string {
+	b := strings.Builder{}
+	// strings.Builder never returns error
+	_ = r.Render(&b)
+	return b.String()
+}
+
+// Log
+// log changes with logger: added and changed with InfoF, removed with WarnF
+// if report is empty logs "No changes"
+func (r *DiffReport) Log(logger log.Logger) {
+	if r.IsEmpty() {
+		logger.InfoF("No changes")
+		return
+	}
+
+	for _, change := range r.Changes {
+		if change.Type == DiffRemoved {
+			logger.WarnF("%s", change.String())
+			continue
+		}
+
+		logger.InfoF("%s", change.String())
+	}
+}
+
+// Diff
+// returns structural diff between a and b yaml documents
+// maps are compared by keys, lists are compared by indexes
+// scalars with same values but in different formats (for example, 1, 0x1 and 1.0) are equal
+func Diff(a, b []byte) (*DiffReport, error) {
+	var aObj any
+	if err := yaml.Unmarshal(a, &aObj); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal first document: %w", err)
+	}
+
+	var bObj any
+	if err := yaml.Unmarshal(b, &bObj); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal second document: %w", err)
+	}
+
+	report := &DiffReport{
+		Changes: make([]DiffChange, 0),
+	}
+
+	diffValues(report, nil, aObj, bObj)
+
+	return report, nil
+}
+
+func diffValues(report *DiffReport, path []pathSegment, a, b any) {
+	switch aTyped := a.(type) {
+	case map[string]any:
+		bTyped, ok := b.(map[string]any)
+		if !ok {
+			break
+		}
+
+		keys := make([]string, 0, len(aTyped)+len(bTyped))
+		for k := range aTyped {
+			keys = append(keys, k)
+		}
+		for k := range bTyped {
+			if _, ok := aTyped[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+
+		slices.Sort(keys)
+
+		for _, k := range keys {
+			keyPath := append(slices.Clip(path), pathSegment{key: k})
+
+			aValue, inA := aTyped[k]
+			bValue, inB := bTyped[k]
+
+			switch {
+			case !inA:
+				report.add(DiffAdded, keyPath, nil, bValue)
+			case !inB:
+				report.add(DiffRemoved, keyPath, aValue, nil)
+			default:
+				diffValues(report, keyPath, aValue, bValue)
+			}
+		}
+
+		return
+	case []any:
+		bTyped, ok := b.([]any)
+		if !ok {
+			break
+		}
+
+		for i := range max(len(aTyped), len(bTyped)) {
+			itemPath := append(slices.Clip(path), pathSegment{index: i, isIndex: true})
+
+			switch {
+			case i >= len(aTyped):
+				report.add(DiffAdded, itemPath, nil, bTyped[i])
+			case i >= len(bTyped):
+				report.add(DiffRemoved, itemPath, aTyped[i], nil)
+			default:
+				diffValues(report, itemPath, aTyped[i], bTyped[i])
+			}
+		}
+
+		return
+	}
+
+	if !scalarsEqual(a, b) {
+		report.add(DiffChanged, path, a, b)
+	}
+}
+
+// scalarsEqual
+// integers and floats are compared by value, so 1 and 1.0 are equal
+// integers are compared without conversion to keep precision for big values
+func scalarsEqual(a, b any) bool {
+	_, aIsFloat := a.(float64)
+	_, bIsFloat := b.(float64)
+	if aIsFloat != bIsFloat {
+		aNum, aIsNum := numberValue(a)
+		bNum, bIsNum := numberValue(b)
+		if aIsNum && bIsNum {
+			return aNum == bNum
+		}
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+func numberValue(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float64:
+		return n, true
+	default:
+		return 0, false
+	}
+}
+
+func formatDiffValue(v any) string {
+	switch v.(type) {
+	case map[string]any, []any:
+		content, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(content)
+	case nil:
+		return "null"
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
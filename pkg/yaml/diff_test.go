@@ -0,0 +1,143 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/deckhouse/lib-dhctl/pkg/log"
+)
+
+func TestDiff(t *testing.T) {
+	const (
+		oldDoc = `
+apiVersion: deckhouse.io/v1
+kind: OpenStackClusterConfiguration
+masterNodeGroup:
+  replicas: 1
+  instanceClass:
+    flavorName: m1.large
+    imageName: ubuntu-22-04
+nodeGroups:
+- name: front
+  replicas: 2
+- name: worker
+  replicas: 1
+tags:
+  node.deckhouse.io/group: master
+sshPublicKey: ssh-rsa AAA
+`
+		newDoc = `
+# reformatted document
+kind: OpenStackClusterConfiguration
+apiVersion: "deckhouse.io/v1"
+masterNodeGroup:
+  instanceClass: {flavorName: m1.xlarge, imageName: ubuntu-22-04}
+  replicas: 0x1
+nodeGroups:
+- name: front
+  replicas: 3
+tags:
+  node.deckhouse.io/group: master
+  team: infra
+`
+	)
+
+	t.Run("no changes for reformatted document", func(t *testing.T) {
+		report, err := Diff([]byte(oldDoc), []byte(oldDoc+"\n# comment\n"))
+		require.NoError(t, err)
+		require.True(t, report.IsEmpty())
+		require.Equal(t, "", report.String())
+	})
+
+	t.Run("numbers compared by value", func(t *testing.T) {
+		report, err := Diff([]byte("replicas: 1\nratio: 0.5\n"), []byte("replicas: 1.0\nratio: 5e-1\n"))
+		require.NoError(t, err)
+		require.True(t, report.IsEmpty(), report.String())
+
+		report, err = Diff([]byte("replicas: 1\n"), []byte("replicas: 1.5\n"))
+		require.NoError(t, err)
+		require.Equal(t, "~ replicas: 1 -> 1.5\n", report.String())
+	})
+
+	t.Run("changes", func(t *testing.T) {
+		report, err := Diff([]byte(oldDoc), []byte(newDoc))
+		require.NoError(t, err)
+
+		require.Equal(t, []DiffChange{
+			{Type: DiffChanged, Path: "masterNodeGroup.instanceClass.flavorName", Old: "m1.large", New: "m1.xlarge"},
+			{Type: DiffChanged, Path: "nodeGroups[0].replicas", Old: 2, New: 3},
+			{Type: DiffRemoved, Path: "nodeGroups[1]", Old: map[string]any{"name": "worker", "replicas": 1}},
+			{Type: DiffRemoved, Path: "sshPublicKey", Old: "ssh-rsa AAA"},
+			{Type: DiffAdded, Path: "tags.team", New: "infra"},
+		}, report.Changes)
+
+		expected := `~ masterNodeGroup.instanceClass.flavorName: m1.large -> m1.xlarge
+~ nodeGroups[0].replicas: 2 -> 3
+- nodeGroups[1]: {"name":"worker","replicas":1}
+- sshPublicKey: ssh-rsa AAA
++ tags.team: infra
+`
+		require.Equal(t, expected, report.String())
+	})
+
+	t.Run("quoted keys and root", func(t *testing.T) {
+		report, err := Diff([]byte("node.deckhouse.io/group: a\n"), []byte("node.deckhouse.io/group: b\n"))
+		require.NoError(t, err)
+		require.Len(t, report.Changes, 1)
+		require.Equal(t, `["node.deckhouse.io/group"]`, report.Changes[0].Path)
+
+		value, err := GetPath([]byte("node.deckhouse.io/group: b\n"), report.Changes[0].Path)
+		require.NoError(t, err)
+		require.Equal(t, "b", value)
+
+		report, err = Diff([]byte("a: 1\n"), []byte("[1]\n"))
+		require.NoError(t, err)
+		require.Equal(t, "~ <root>: {\"a\":1} -> [1]\n", report.String())
+	})
+
+	t.Run("null values", func(t *testing.T) {
+		report, err := Diff([]byte("a: 1\n"), []byte("a: 1\nb: null\n"))
+		require.NoError(t, err)
+		require.Equal(t, "+ b: null\n", report.String())
+	})
+
+	t.Run("log", func(t *testing.T) {
+		report, err := Diff([]byte(oldDoc), []byte(newDoc))
+		require.NoError(t, err)
+
+		logger := log.NewInMemoryLogger()
+		report.Log(logger)
+
+		line, err := logger.FirstMatch(&log.Match{Prefix: []string{"- sshPublicKey"}})
+		require.NoError(t, err)
+		require.NotEmpty(t, line)
+
+		emptyLogger := log.NewInMemoryLogger()
+		(&DiffReport{}).Log(emptyLogger)
+
+		line, err = emptyLogger.FirstMatch(&log.Match{Prefix: []string{"No changes"}})
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(line, "No changes"))
+	})
+
+	t.Run("invalid document", func(t *testing.T) {
+		_, err := Diff([]byte("a: [1"), []byte("a: 1"))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,391 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	nullTag = "!!null"
+	mapTag  = "!!map"
+	seqTag  = "!!seq"
+)
+
+// Editor
+// edits yaml document on nodes level
+// comments, anchors, keys order and styles of not changed nodes are preserved
+// path format is the same as for GetPath
+// aliases on path are expanded before editing, so anchored node and other aliases are not changed
+// Editor is not safe for concurrent use
+type Editor struct {
+	doc *yaml.Node
+}
+
+// NewEditor
+// parses document for editing. Only first document from doc is used
+func NewEditor(doc []byte) (*Editor, error) {
+	root := &yaml.Node{}
+	if err := yaml.Unmarshal(doc, root); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal document: %w", err)
+	}
+
+	if root.Kind != yaml.DocumentNode {
+		root = &yaml.Node{Kind: yaml.DocumentNode}
+	}
+
+	if len(root.Content) == 0 {
+		root.Content = []*yaml.Node{newNullNode()}
+	}
+
+	return &Editor{doc: root}, nil
+}
+
+// Set
+// sets value by path, missing maps and lists are created
+// list index can be equal to list length, in this case value will be appended
+// comments and anchor of replaced node are moved to new value, aliases of replaced node point to new value
+func (e *Editor) Set(path string, value any) error {
+	segments, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+
+	if len(segments) == 0 {
+		return fmt.Errorf("%w: cannot set root document", ErrInvalidPath)
+	}
+
+	valueNode, err := encodeNode(value)
+	if err != nil {
+		return err
+	}
+
+	parent, err := e.lookup(segments[:len(segments)-1], lookupCreate)
+	if err != nil {
+		return err
+	}
+
+	last := segments[len(segments)-1]
+
+	if last.isIndex {
+		if !ensureKind(parent, yaml.SequenceNode) {
+			return fmt.Errorf("%w: %s is not a list", ErrInvalidPath, joinSegments(segments[:len(segments)-1]))
+		}
+
+		switch {
+		case last.index < len(parent.Content):
+			e.replaceNode(parent.Content[last.index], valueNode)
+			parent.Content[last.index] = valueNode
+		case last.index == len(parent.Content):
+			parent.Content = append(parent.Content, valueNode)
+		default:
+			return fmt.Errorf("%w: index out of range %s", ErrInvalidPath, joinSegments(segments))
+		}
+
+		return nil
+	}
+
+	if !ensureKind(parent, yaml.MappingNode) {
+		return fmt.Errorf("%w: %s is not a map", ErrInvalidPath, joinSegments(segments[:len(segments)-1]))
+	}
+
+	if i := mappingValueIndex(parent, last.key); i >= 0 {
+		e.replaceNode(parent.Content[i], valueNode)
+		parent.Content[i] = valueNode
+		return nil
+	}
+
+	parent.Content = append(parent.Content, newStringNode(last.key), valueNode)
+
+	return nil
+}
+
+// Delete
+// removes field or list item by path
+// returns ErrPathNotFound if path not found
+// returns ErrInvalidPath if removed node is anchor for aliases in document
+func (e *Editor) Delete(path string) error {
+	segments, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+
+	if len(segments) == 0 {
+		return fmt.Errorf("%w: cannot delete root document", ErrInvalidPath)
+	}
+
+	// check path before expanding aliases for keeping document without changes on error
+	if _, err := e.lookup(segments, lookupRead); err != nil {
+		return err
+	}
+
+	parent, err := e.lookup(segments[:len(segments)-1], lookupEdit)
+	if err != nil {
+		return err
+	}
+
+	last := segments[len(segments)-1]
+
+	i := last.index
+	if !last.isIndex {
+		i = mappingValueIndex(parent, last.key)
+	}
+
+	if e.hasAliases(parent.Content[i]) {
+		return fmt.Errorf("%w: %s is anchor &%s used by aliases", ErrInvalidPath, joinSegments(segments), parent.Content[i].Anchor)
+	}
+
+	if last.isIndex {
+		parent.Content = append(parent.Content[:i], parent.Content[i+1:]...)
+		return nil
+	}
+
+	// remove key and value
+	parent.Content = append(parent.Content[:i-1], parent.Content[i+1:]...)
+
+	return nil
+}
+
+// Append
+// appends value to list by path, missing list is created
+func (e *Editor) Append(path string, value any) error {
+	segments, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+
+	valueNode, err := encodeNode(value)
+	if err != nil {
+		return err
+	}
+
+	list, err := e.lookup(segments, lookupCreate)
+	if err != nil {
+		return err
+	}
+
+	if !ensureKind(list, yaml.SequenceNode) {
+		return fmt.Errorf("%w: %s is not a list", ErrInvalidPath, joinSegments(segments))
+	}
+
+	list.Content = append(list.Content, valueNode)
+
+	return nil
+}
+
+// Bytes
+// returns edited yaml document
+func (e *Editor) Bytes() ([]byte, error) {
+	result, err := encode(e.doc, defaultIndent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal document: %w", err)
+	}
+
+	return result, nil
+}
+
+type lookupMode int
+
+const (
+	// lookupRead
+	// aliases are resolved, document is not changed
+	lookupRead lookupMode = iota
+	// lookupEdit
+	// aliases on path are replaced with expanded copies of anchored nodes
+	lookupEdit
+	// lookupCreate
+	// like lookupEdit, but missing nodes created as null nodes
+	// and null nodes converted to maps and lists
+	lookupCreate
+)
+
+// lookup
+// returns node by path segments
+// for lookupRead returned node can be alias, for other modes returned node is never alias
+func (e *Editor) lookup(segments []pathSegment, mode lookupMode) (*yaml.Node, error) {
+	create := mode == lookupCreate
+	slot := &e.doc.Content[0]
+
+	for i, segment := range segments {
+		current := e.resolveSlot(slot, mode)
+
+		if segment.isIndex {
+			if create && !ensureKind(current, yaml.SequenceNode) {
+				return nil, fmt.Errorf("%w: %s is not a list", ErrInvalidPath, joinSegments(segments[:i]))
+			}
+
+			switch {
+			case current.Kind == yaml.SequenceNode && segment.index < len(current.Content):
+				slot = &current.Content[segment.index]
+			case create && segment.index == len(current.Content):
+				current.Content = append(current.Content, newNullNode())
+				slot = &current.Content[len(current.Content)-1]
+			case create:
+				return nil, fmt.Errorf("%w: index out of range %s", ErrInvalidPath, joinSegments(segments[:i+1]))
+			default:
+				return nil, fmt.Errorf("%w: %s", ErrPathNotFound, joinSegments(segments[:i+1]))
+			}
+
+			continue
+		}
+
+		if create && !ensureKind(current, yaml.MappingNode) {
+			return nil, fmt.Errorf("%w: %s is not a map", ErrInvalidPath, joinSegments(segments[:i]))
+		}
+
+		valueIndex := -1
+		if current.Kind == yaml.MappingNode {
+			valueIndex = mappingValueIndex(current, segment.key)
+		}
+
+		switch {
+		case valueIndex >= 0:
+			slot = &current.Content[valueIndex]
+		case create:
+			current.Content = append(current.Content, newStringNode(segment.key), newNullNode())
+			slot = &current.Content[len(current.Content)-1]
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrPathNotFound, joinSegments(segments[:i+1]))
+		}
+	}
+
+	if mode == lookupRead {
+		return *slot, nil
+	}
+
+	return e.resolveSlot(slot, mode), nil
+}
+
+// resolveSlot
+// for lookupRead returns anchored node for alias
+// for other modes replaces alias in slot with expanded copy of anchored node
+// for preventing changes of anchored node and other aliases
+func (e *Editor) resolveSlot(slot **yaml.Node, mode lookupMode) *yaml.Node {
+	node := *slot
+	if mode == lookupRead || node.Kind != yaml.AliasNode || node.Alias == nil {
+		return resolveAlias(node)
+	}
+
+	*slot = expandNode(node)
+
+	return *slot
+}
+
+// replaceNode
+// moves comments and anchor from old node to new node
+// and points aliases of old node to new node
+func (e *Editor) replaceNode(old, node *yaml.Node) {
+	copyComments(old, node)
+
+	if old.Anchor == "" {
+		return
+	}
+
+	node.Anchor = old.Anchor
+	for _, alias := range findAliasesOf(e.doc, old) {
+		alias.Alias = node
+	}
+}
+
+func (e *Editor) hasAliases(node *yaml.Node) bool {
+	return node.Anchor != "" && len(findAliasesOf(e.doc, node)) > 0
+}
+
+func findAliasesOf(node, anchored *yaml.Node) []*yaml.Node {
+	if node.Kind == yaml.AliasNode {
+		if node.Alias == anchored {
+			return []*yaml.Node{node}
+		}
+
+		return nil
+	}
+
+	result := make([]*yaml.Node, 0)
+	for _, child := range node.Content {
+		result = append(result, findAliasesOf(child, anchored)...)
+	}
+
+	return result
+}
+
+// mappingValueIndex
+// returns index of value node for key in mapping node content, -1 if not found
+func mappingValueIndex(mapping *yaml.Node, key string) int {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return i + 1
+		}
+	}
+
+	return -1
+}
+
+// ensureKind
+// converts null node to empty node with kind
+// returns false if node is not null and has another kind
+func ensureKind(node *yaml.Node, kind yaml.Kind) bool {
+	if node.Kind == kind {
+		return true
+	}
+
+	if node.Kind != yaml.ScalarNode || node.ShortTag() != nullTag {
+		return false
+	}
+
+	node.Kind = kind
+	node.Value = ""
+	node.Style = 0
+	node.Content = nil
+
+	node.Tag = mapTag
+	if kind == yaml.SequenceNode {
+		node.Tag = seqTag
+	}
+
+	return true
+}
+
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	if node.Kind == yaml.AliasNode && node.Alias != nil {
+		return node.Alias
+	}
+
+	return node
+}
+
+func copyComments(from, to *yaml.Node) {
+	to.HeadComment = from.HeadComment
+	to.LineComment = from.LineComment
+	to.FootComment = from.FootComment
+}
+
+func encodeNode(value any) (*yaml.Node, error) {
+	node := &yaml.Node{}
+	if err := node.Encode(value); err != nil {
+		return nil, fmt.Errorf("failed to encode value: %w", err)
+	}
+
+	return node, nil
+}
+
+func newNullNode() *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: nullTag, Value: "null"}
+}
+
+func newStringNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
@@ -0,0 +1,202 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testEditorDoc = `# cluster configuration
+apiVersion: deckhouse.io/v1
+kind: ClusterConfiguration
+# do not change without operator approval
+podSubnetCIDR: 10.111.0.0/16 # pods
+defaults: &defaults
+  replicas: 1
+nodeGroups:
+  # front nodes
+  - name: front
+    settings: *defaults
+  - name: worker
+proxy:
+  httpProxy: http://proxy
+  noProxy: null
+`
+
+func TestEditor(t *testing.T) {
+	edit := func(t *testing.T, f func(e *Editor) error) string {
+		e, err := NewEditor([]byte(testEditorDoc))
+		require.NoError(t, err)
+		require.NoError(t, f(e))
+
+		result, err := e.Bytes()
+		require.NoError(t, err)
+
+		return string(result)
+	}
+
+	get := func(t *testing.T, doc, path string) any {
+		value, err := GetPath([]byte(doc), path)
+		require.NoError(t, err)
+		return value
+	}
+
+	assertPreserved := func(t *testing.T, result string) {
+		require.Contains(t, result, "# cluster configuration")
+		require.Contains(t, result, "# front nodes")
+		require.Contains(t, result, "defaults: &defaults")
+		require.Contains(t, result, "settings: *defaults")
+	}
+
+	t.Run("without changes", func(t *testing.T) {
+		result := edit(t, func(e *Editor) error {
+			return nil
+		})
+
+		require.Equal(t, testEditorDoc, result)
+	})
+
+	t.Run("set existing field", func(t *testing.T) {
+		result := edit(t, func(e *Editor) error {
+			return e.Set("podSubnetCIDR", "10.222.0.0/16")
+		})
+
+		assertPreserved(t, result)
+		require.Contains(t, result, "# do not change without operator approval\npodSubnetCIDR: 10.222.0.0/16 # pods\n")
+	})
+
+	t.Run("set new nested field", func(t *testing.T) {
+		result := edit(t, func(e *Editor) error {
+			return e.Set(`nodeGroups[1].labels["node.deckhouse.io/type"]`, "worker")
+		})
+
+		assertPreserved(t, result)
+		require.Equal(t, "worker", get(t, result, `nodeGroups[1].labels["node.deckhouse.io/type"]`))
+	})
+
+	t.Run("set into null", func(t *testing.T) {
+		result := edit(t, func(e *Editor) error {
+			return e.Set("proxy.noProxy[0]", "127.0.0.1")
+		})
+
+		assertPreserved(t, result)
+		require.Equal(t, []any{"127.0.0.1"}, get(t, result, "proxy.noProxy"))
+	})
+
+	t.Run("set complex value", func(t *testing.T) {
+		result := edit(t, func(e *Editor) error {
+			return e.Set("proxy", map[string]any{"httpsProxy": "https://proxy"})
+		})
+
+		assertPreserved(t, result)
+		require.Equal(t, map[string]any{"httpsProxy": "https://proxy"}, get(t, result, "proxy"))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		result := edit(t, func(e *Editor) error {
+			if err := e.Delete("proxy.httpProxy"); err != nil {
+				return err
+			}
+			return e.Delete("nodeGroups[1]")
+		})
+
+		assertPreserved(t, result)
+		require.NotContains(t, result, "httpProxy")
+		require.NotContains(t, result, "worker")
+	})
+
+	t.Run("append", func(t *testing.T) {
+		result := edit(t, func(e *Editor) error {
+			if err := e.Append("nodeGroups", map[string]any{"name": "system"}); err != nil {
+				return err
+			}
+			return e.Append("proxy.noProxy", "127.0.0.1")
+		})
+
+		assertPreserved(t, result)
+		require.Equal(t, "system", get(t, result, "nodeGroups[2].name"))
+		require.Equal(t, []any{"127.0.0.1"}, get(t, result, "proxy.noProxy"))
+	})
+
+	t.Run("set anchored node", func(t *testing.T) {
+		result := edit(t, func(e *Editor) error {
+			if err := e.Set("defaults", map[string]any{"replicas": 2}); err != nil {
+				return err
+			}
+			return e.Set("defaults.replicas", 3)
+		})
+
+		assertPreserved(t, result)
+		require.Equal(t, 3, get(t, result, "nodeGroups[0].settings.replicas"), "alias should point to new value")
+	})
+
+	t.Run("edit through alias", func(t *testing.T) {
+		result := edit(t, func(e *Editor) error {
+			if err := e.Set("nodeGroups[0].settings.replicas", 2); err != nil {
+				return err
+			}
+			return e.Append("nodeGroups[0].settings.zones", "a")
+		})
+
+		require.Contains(t, result, "defaults: &defaults\n  replicas: 1\n")
+		require.NotContains(t, result, "*defaults")
+		require.Equal(t, 1, get(t, result, "defaults.replicas"), "anchored node should not be changed")
+		require.Equal(t, 2, get(t, result, "nodeGroups[0].settings.replicas"))
+		require.Equal(t, []any{"a"}, get(t, result, "nodeGroups[0].settings.zones"))
+	})
+
+	t.Run("delete anchored node", func(t *testing.T) {
+		e, err := NewEditor([]byte(testEditorDoc))
+		require.NoError(t, err)
+
+		require.ErrorIs(t, e.Delete("defaults"), ErrInvalidPath)
+
+		require.NoError(t, e.Delete("nodeGroups[0].settings.replicas"))
+		require.NoError(t, e.Delete("defaults"))
+
+		result, err := e.Bytes()
+		require.NoError(t, err)
+		require.NotContains(t, string(result), "defaults")
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		e, err := NewEditor([]byte(testEditorDoc))
+		require.NoError(t, err)
+
+		require.ErrorIs(t, e.Delete("proxy.absent"), ErrPathNotFound)
+		require.ErrorIs(t, e.Delete("nodeGroups[5]"), ErrPathNotFound)
+		require.ErrorIs(t, e.Delete("nodeGroups[0].settings.absent"), ErrPathNotFound)
+		require.ErrorIs(t, e.Set("kind.field", "value"), ErrInvalidPath)
+		require.ErrorIs(t, e.Set("nodeGroups[5]", "value"), ErrInvalidPath)
+		require.ErrorIs(t, e.Append("kind", "value"), ErrInvalidPath)
+		require.ErrorIs(t, e.Set("$", "value"), ErrInvalidPath)
+
+		result, err := e.Bytes()
+		require.NoError(t, err)
+		require.Equal(t, testEditorDoc, string(result), "document should not be changed on errors")
+	})
+
+	t.Run("empty document", func(t *testing.T) {
+		e, err := NewEditor(nil)
+		require.NoError(t, err)
+		require.NoError(t, e.Set("a.b", 1))
+
+		result, err := e.Bytes()
+		require.NoError(t, err)
+		require.Equal(t, "a:\n  b: 1\n", string(result))
+	})
+}
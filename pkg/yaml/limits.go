@@ -0,0 +1,190 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	LimitDocumentSize  = "document size"
+	LimitDepth         = "nesting depth"
+	LimitAliasExpanded = "alias expansion"
+)
+
+var ErrLimitExceeded = errors.New("yaml limit exceeded")
+
+// Limits
+// zero value for any limit means no limit
+type Limits struct {
+	// MaxDocumentSize
+	// maximal input size in bytes
+	MaxDocumentSize int
+	// MaxDepth
+	// maximal nesting depth of mappings and sequences
+	MaxDepth int
+	// MaxAliasExpansion
+	// maximal count of nodes which will be produced by expanding all aliases
+	MaxAliasExpansion int
+}
+
+// DefaultLimits
+// limits suitable for user supplied configuration
+func DefaultLimits() Limits {
+	return Limits{
+		MaxDocumentSize:   10 * 1024 * 1024,
+		MaxDepth:          100,
+		MaxAliasExpansion: 10000,
+	}
+}
+
+func (l Limits) IsEmpty() bool {
+	return l.MaxDocumentSize <= 0 && l.MaxDepth <= 0 && l.MaxAliasExpansion <= 0
+}
+
+type LimitError struct {
+	Limit  string
+	Max    int
+	Actual int
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%s: %s %d exceeds maximum %d", ErrLimitExceeded, e.Limit, e.Actual, e.Max)
+}
+
+func (e *LimitError) Unwrap() error {
+	return ErrLimitExceeded
+}
+
+// CheckLimits
+// checks all documents in content without aliases expansion
+// returns *LimitError if any limit exceeded
+// invalid yaml is not checked, unmarshal should return error for it
+func CheckLimits(content []byte, limits Limits) error {
+	if limits.IsEmpty() {
+		return nil
+	}
+
+	if limits.MaxDocumentSize > 0 && len(content) > limits.MaxDocumentSize {
+		return &LimitError{Limit: LimitDocumentSize, Max: limits.MaxDocumentSize, Actual: len(content)}
+	}
+
+	if limits.MaxDepth <= 0 && limits.MaxAliasExpansion <= 0 {
+		return nil
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		node := &yaml.Node{}
+		// io.EOF or invalid yaml. Invalid yaml will be reported by unmarshal
+		if err := decoder.Decode(node); err != nil {
+			return nil
+		}
+
+		if err := checkNodeLimits(node, limits); err != nil {
+			return err
+		}
+	}
+}
+
+func checkNodeLimits(root *yaml.Node, limits Limits) error {
+	if limits.MaxDepth > 0 {
+		if err := checkDepth(root, 0, limits.MaxDepth); err != nil {
+			return err
+		}
+	}
+
+	if limits.MaxAliasExpansion <= 0 {
+		return nil
+	}
+
+	physical := countNodes(root)
+
+	checker := &expansionCounter{
+		sizes:    make(map[*yaml.Node]int),
+		maxTotal: physical + limits.MaxAliasExpansion,
+	}
+
+	if produced := checker.size(root) - physical; produced > limits.MaxAliasExpansion {
+		return &LimitError{Limit: LimitAliasExpanded, Max: limits.MaxAliasExpansion, Actual: produced}
+	}
+
+	return nil
+}
+
+func checkDepth(node *yaml.Node, depth, maxDepth int) error {
+	if node.Kind == yaml.MappingNode || node.Kind == yaml.SequenceNode {
+		depth++
+	}
+
+	if depth > maxDepth {
+		return &LimitError{Limit: LimitDepth, Max: maxDepth, Actual: depth}
+	}
+
+	for _, child := range node.Content {
+		if err := checkDepth(child, depth, maxDepth); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// countNodes
+// count nodes without aliases expansion
+func countNodes(node *yaml.Node) int {
+	total := 1
+	for _, child := range node.Content {
+		total += countNodes(child)
+	}
+
+	return total
+}
+
+type expansionCounter struct {
+	sizes    map[*yaml.Node]int
+	maxTotal int
+}
+
+// size
+// returns count of nodes after aliases expansion
+// size is capped with maxTotal+1 to prevent overflow
+// parser does not allow recursive aliases, so we do not check cycles
+func (c *expansionCounter) size(node *yaml.Node) int {
+	if s, ok := c.sizes[node]; ok {
+		return s
+	}
+
+	if node.Kind == yaml.AliasNode && node.Alias != nil {
+		return c.size(node.Alias)
+	}
+
+	total := 1
+	for _, child := range node.Content {
+		total += c.size(child)
+		if total > c.maxTotal {
+			total = c.maxTotal + 1
+			break
+		}
+	}
+
+	c.sizes[node] = total
+
+	return total
+}
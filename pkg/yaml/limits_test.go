@@ -0,0 +1,99 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testBillionLaughs = `
+a: &a ["lol","lol","lol","lol","lol","lol","lol","lol","lol"]
+b: &b [*a,*a,*a,*a,*a,*a,*a,*a,*a]
+c: &c [*b,*b,*b,*b,*b,*b,*b,*b,*b]
+d: &d [*c,*c,*c,*c,*c,*c,*c,*c,*c]
+e: &e [*d,*d,*d,*d,*d,*d,*d,*d,*d]
+f: &f [*e,*e,*e,*e,*e,*e,*e,*e,*e]
+g: &g [*f,*f,*f,*f,*f,*f,*f,*f,*f]
+`
+
+func TestCheckLimits(t *testing.T) {
+	assertLimitError := func(t *testing.T, err error, limit string) {
+		require.Error(t, err)
+		require.True(t, errors.Is(err, ErrLimitExceeded))
+
+		var limitErr *LimitError
+		require.True(t, errors.As(err, &limitErr))
+		require.Equal(t, limit, limitErr.Limit)
+	}
+
+	t.Run("empty limits", func(t *testing.T) {
+		err := CheckLimits([]byte(testBillionLaughs), Limits{})
+		require.NoError(t, err)
+	})
+
+	t.Run("default limits with regular document", func(t *testing.T) {
+		doc := `
+apiVersion: v1
+kind: Test
+base: &base
+  a: 1
+  b: 2
+first:
+  <<: *base
+second:
+  <<: *base
+---
+kind: Second
+`
+		err := CheckLimits([]byte(doc), DefaultLimits())
+		require.NoError(t, err)
+	})
+
+	t.Run("document size", func(t *testing.T) {
+		err := CheckLimits([]byte("a: 1\nb: 2\n"), Limits{MaxDocumentSize: 5})
+		assertLimitError(t, err, LimitDocumentSize)
+	})
+
+	t.Run("depth", func(t *testing.T) {
+		doc := "a:\n  b:\n    c:\n      - d: 1\n"
+
+		err := CheckLimits([]byte(doc), Limits{MaxDepth: 5})
+		require.NoError(t, err)
+
+		err = CheckLimits([]byte(doc), Limits{MaxDepth: 4})
+		assertLimitError(t, err, LimitDepth)
+	})
+
+	t.Run("depth in second document", func(t *testing.T) {
+		doc := "a: 1\n---\n" + strings.Repeat("[", 10) + strings.Repeat("]", 10) + "\n"
+
+		err := CheckLimits([]byte(doc), Limits{MaxDepth: 5})
+		assertLimitError(t, err, LimitDepth)
+	})
+
+	t.Run("alias expansion", func(t *testing.T) {
+		err := CheckLimits([]byte(testBillionLaughs), DefaultLimits())
+		assertLimitError(t, err, LimitAliasExpanded)
+	})
+
+	t.Run("invalid yaml is skipped", func(t *testing.T) {
+		err := CheckLimits([]byte("a: [1, 2"), DefaultLimits())
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const defaultIndent = 2
+
+type marshalStableOptions struct {
+	sortKeys bool
+	indent   int
+}
+
+type MarshalStableOption func(o *marshalStableOptions)
+
+// MarshalStableWithSortedKeys
+// sort keys of all mappings including structs fields
+// by default structs fields are emitted in declaration order
+func MarshalStableWithSortedKeys() MarshalStableOption {
+	return func(o *marshalStableOptions) {
+		o.sortKeys = true
+	}
+}
+
+// MarshalStableWithIndent
+// set indent for nested nodes, 2 by default
+func MarshalStableWithIndent(indent int) MarshalStableOption {
+	return func(o *marshalStableOptions) {
+		o.indent = indent
+	}
+}
+
+// MarshalStable
+// marshals v to yaml with deterministic output between runs:
+// maps keys are sorted, structs fields are emitted in declaration order
+// (or sorted with MarshalStableWithSortedKeys), indentation is normalized
+// v can be *yaml.Node, in this case node styles are reset
+func MarshalStable(v any, opts ...MarshalStableOption) ([]byte, error) {
+	options := &marshalStableOptions{
+		indent: defaultIndent,
+	}
+
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	node := &yaml.Node{}
+	if err := node.Encode(v); err != nil {
+		return nil, fmt.Errorf("failed to encode value: %w", err)
+	}
+
+	normalizeNode(node, options.sortKeys)
+
+	return encode(node, options.indent)
+}
+
+// normalizeNode
+// resets styles for consistent output and sorts mappings keys if needed
+func normalizeNode(node *yaml.Node, sortKeys bool) {
+	// keep literal style for multiline strings for readability
+	if node.Style != yaml.LiteralStyle {
+		node.Style = 0
+	}
+
+	for _, child := range node.Content {
+		normalizeNode(child, sortKeys)
+	}
+
+	if !sortKeys || node.Kind != yaml.MappingNode {
+		return
+	}
+
+	pairs := make([][2]*yaml.Node, 0, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		pairs = append(pairs, [2]*yaml.Node{node.Content[i], node.Content[i+1]})
+	}
+
+	slices.SortStableFunc(pairs, func(a, b [2]*yaml.Node) int {
+		return strings.Compare(a[0].Value, b[0].Value)
+	})
+
+	node.Content = node.Content[:0]
+	for _, pair := range pairs {
+		node.Content = append(node.Content, pair[0], pair[1])
+	}
+}
+
+func encode(v any, indent int) ([]byte, error) {
+	buf := bytes.Buffer{}
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(indent)
+
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestMarshalStable(t *testing.T) {
+	type settings struct {
+		Replicas int               `yaml:"replicas"`
+		Name     string            `yaml:"name"`
+		Labels   map[string]string `yaml:"labels,omitempty"`
+	}
+
+	value := map[string]any{
+		"zone":   "a",
+		"kind":   "NodeGroup",
+		"flag":   "true",
+		"script": "line1\nline2\n",
+		"settings": settings{
+			Replicas: 1,
+			Name:     "worker",
+			Labels:   map[string]string{"b": "2", "a": "1"},
+		},
+	}
+
+	t.Run("stable between runs", func(t *testing.T) {
+		first, err := MarshalStable(value)
+		require.NoError(t, err)
+
+		for range 20 {
+			next, err := MarshalStable(value)
+			require.NoError(t, err)
+			require.Equal(t, string(first), string(next))
+		}
+
+		expected := `flag: "true"
+kind: NodeGroup
+script: |
+  line1
+  line2
+settings:
+  replicas: 1
+  name: worker
+  labels:
+    a: "1"
+    b: "2"
+zone: a
+`
+		require.Equal(t, expected, string(first))
+	})
+
+	t.Run("sorted keys", func(t *testing.T) {
+		result, err := MarshalStable(value["settings"], MarshalStableWithSortedKeys())
+		require.NoError(t, err)
+		require.Equal(t, "labels:\n  a: \"1\"\n  b: \"2\"\nname: worker\nreplicas: 1\n", string(result))
+	})
+
+	t.Run("indent", func(t *testing.T) {
+		result, err := MarshalStable(map[string]any{"a": map[string]any{"b": 1}}, MarshalStableWithIndent(4))
+		require.NoError(t, err)
+		require.Equal(t, "a:\n    b: 1\n", string(result))
+	})
+
+	t.Run("node styles are normalized", func(t *testing.T) {
+		node := &yaml.Node{}
+		err := yaml.Unmarshal([]byte(`{b: 'x', a: ["c",    d]}`), node)
+		require.NoError(t, err)
+
+		result, err := MarshalStable(node, MarshalStableWithSortedKeys())
+		require.NoError(t, err)
+		require.Equal(t, "a:\n  - c\n  - d\nb: x\n", string(result))
+	})
+}
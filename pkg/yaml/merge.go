@@ -0,0 +1,185 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+)
+
+// StrategicMergeDirective
+// key in list item for strategic merge which controls how item should be merged
+// only "delete" value is supported now: item with same merge key will be removed from list
+const StrategicMergeDirective = "$patch"
+
+const strategicMergeDelete = "delete"
+
+type mergePatchOptions struct {
+	mergeKeys map[string]string
+}
+
+type MergePatchOption func(o *mergePatchOptions)
+
+// MergePatchWithStrategicMergeKeys
+// enables strategic merge for lists
+// keys are paths to lists in document and values are merge keys for list items
+// path consists of fields separated by dot, list items marked with [] suffix,
+// for example: "masterNodeGroup.instanceClass.additionalNetworks" or "nodeGroups[].taints"
+// items from patch list will be merged with items from base list with the same merge key value,
+// items with new merge key value will be appended
+// item with StrategicMergeDirective: delete removes item with same merge key value from list
+// lists without merge key replaced like in RFC 7386
+func MergePatchWithStrategicMergeKeys(keys map[string]string) MergePatchOption {
+	return func(o *mergePatchOptions) {
+		o.mergeKeys = keys
+	}
+}
+
+// MergePatch
+// applies yaml or json patch to base document with RFC 7386 merge patch algorithm
+// null values in patch remove fields from base
+// lists are replaced unless strategic merge enabled with MergePatchWithStrategicMergeKeys
+// empty patch returns base without changes
+// returns yaml document
+func MergePatch(base, patch []byte, opts ...MergePatchOption) ([]byte, error) {
+	if len(bytes.TrimSpace(patch)) == 0 {
+		return base, nil
+	}
+
+	var baseObj any
+	if err := yaml.Unmarshal(base, &baseObj); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal base document: %w", err)
+	}
+
+	var patchObj any
+	if err := yaml.Unmarshal(patch, &patchObj); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal patch: %w", err)
+	}
+
+	result, err := MarshalStable(MergePatchValue(baseObj, patchObj, opts...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal patched document: %w", err)
+	}
+
+	return result, nil
+}
+
+// MergePatchValue
+// like MergePatch but for unmarshalled values
+// target can be changed during merge, use returned value as result
+func MergePatchValue(target, patch any, opts ...MergePatchOption) any {
+	options := &mergePatchOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return options.merge("", target, patch)
+}
+
+func (o *mergePatchOptions) merge(path string, target, patch any) any {
+	switch typedPatch := patch.(type) {
+	case map[string]any:
+		targetMap, ok := target.(map[string]any)
+		if !ok {
+			targetMap = make(map[string]any, len(typedPatch))
+		}
+
+		for key, value := range typedPatch {
+			if value == nil {
+				delete(targetMap, key)
+				continue
+			}
+
+			targetMap[key] = o.merge(fieldPath(path, key), targetMap[key], value)
+		}
+
+		return targetMap
+	case []any:
+		mergeKey, ok := o.mergeKeys[path]
+		if !ok {
+			return patch
+		}
+
+		targetList, _ := target.([]any)
+
+		return o.mergeList(path, mergeKey, targetList, typedPatch)
+	default:
+		return patch
+	}
+}
+
+func (o *mergePatchOptions) mergeList(path, mergeKey string, target, patch []any) []any {
+	result := make([]any, len(target), len(target)+len(patch))
+	copy(result, target)
+
+	itemPath := path + "[]"
+
+	for _, item := range patch {
+		itemMap, ok := item.(map[string]any)
+		if !ok {
+			result = append(result, item)
+			continue
+		}
+
+		keyValue, hasKey := itemMap[mergeKey]
+
+		directive := itemMap[StrategicMergeDirective]
+		delete(itemMap, StrategicMergeDirective)
+
+		i := -1
+		if hasKey {
+			i = findListItem(result, mergeKey, keyValue)
+		}
+
+		switch {
+		case directive == strategicMergeDelete:
+			if i >= 0 {
+				result = append(result[:i], result[i+1:]...)
+			}
+		case i >= 0:
+			result[i] = o.merge(itemPath, result[i], itemMap)
+		default:
+			result = append(result, o.merge(itemPath, nil, itemMap))
+		}
+	}
+
+	return result
+}
+
+func findListItem(list []any, mergeKey string, keyValue any) int {
+	for i, item := range list {
+		itemMap, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if value, ok := itemMap[mergeKey]; ok && reflect.DeepEqual(value, keyValue) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func fieldPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+
+	return path + "." + field
+}
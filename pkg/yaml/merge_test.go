@@ -0,0 +1,171 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergePatch(t *testing.T) {
+	assertMerge := func(t *testing.T, base, patch, expected string, opts ...MergePatchOption) {
+		result, err := MergePatch([]byte(base), []byte(patch), opts...)
+		require.NoError(t, err)
+		require.YAMLEq(t, expected, string(result))
+	}
+
+	t.Run("RFC 7386", func(t *testing.T) {
+		// examples from RFC 7386 appendix A
+		tests := []struct {
+			base     string
+			patch    string
+			expected string
+		}{
+			{base: `{"a":"b"}`, patch: `{"a":"c"}`, expected: `{"a":"c"}`},
+			{base: `{"a":"b"}`, patch: `{"b":"c"}`, expected: `{"a":"b","b":"c"}`},
+			{base: `{"a":"b"}`, patch: `{"a":null}`, expected: `{}`},
+			{base: `{"a":"b","b":"c"}`, patch: `{"a":null}`, expected: `{"b":"c"}`},
+			{base: `{"a":["b"]}`, patch: `{"a":"c"}`, expected: `{"a":"c"}`},
+			{base: `{"a":"c"}`, patch: `{"a":["b"]}`, expected: `{"a":["b"]}`},
+			{base: `{"a":{"b":"c"}}`, patch: `{"a":{"b":"d","c":null}}`, expected: `{"a":{"b":"d"}}`},
+			{base: `{"a":[{"b":"c"}]}`, patch: `{"a":[1]}`, expected: `{"a":[1]}`},
+			{base: `["a","b"]`, patch: `["c","d"]`, expected: `["c","d"]`},
+			{base: `{"a":"b"}`, patch: `["c"]`, expected: `["c"]`},
+			{base: `{"a":"foo"}`, patch: `null`, expected: `null`},
+			{base: `{"a":"foo"}`, patch: `"bar"`, expected: `"bar"`},
+			{base: `{"e":null}`, patch: `{"a":1}`, expected: `{"e":null,"a":1}`},
+			{base: `[1,2]`, patch: `{"a":"b","c":null}`, expected: `{"a":"b"}`},
+			{base: `{}`, patch: `{"a":{"bb":{"ccc":null}}}`, expected: `{"a":{"bb":{}}}`},
+		}
+
+		for _, tt := range tests {
+			assertMerge(t, tt.base, tt.patch, tt.expected)
+		}
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		base := `
+apiVersion: deckhouse.io/v1
+kind: ClusterConfiguration
+clusterType: Cloud
+podSubnetCIDR: 10.111.0.0/16
+proxy:
+  httpProxy: http://proxy
+  noProxy:
+  - 127.0.0.1
+`
+		patch := `
+podSubnetCIDR: 10.222.0.0/16
+proxy:
+  httpProxy: null
+  noProxy:
+  - 10.0.0.1
+`
+		expected := `
+apiVersion: deckhouse.io/v1
+kind: ClusterConfiguration
+clusterType: Cloud
+podSubnetCIDR: 10.222.0.0/16
+proxy:
+  noProxy:
+  - 10.0.0.1
+`
+		assertMerge(t, base, patch, expected)
+	})
+
+	t.Run("empty patch", func(t *testing.T) {
+		result, err := MergePatch([]byte("a: 1\n"), []byte("  \n"))
+		require.NoError(t, err)
+		require.Equal(t, "a: 1\n", string(result))
+	})
+
+	t.Run("invalid patch", func(t *testing.T) {
+		_, err := MergePatch([]byte("a: 1\n"), []byte("a: [1"))
+		require.Error(t, err)
+	})
+
+	t.Run("strategic", func(t *testing.T) {
+		base := `
+nodeGroups:
+- name: front
+  replicas: 1
+  taints:
+  - key: a
+    effect: NoSchedule
+- name: worker
+  replicas: 2
+- name: system
+  replicas: 1
+`
+		patch := `
+nodeGroups:
+- name: front
+  replicas: 3
+  taints:
+  - key: b
+    effect: NoSchedule
+- name: system
+  $patch: delete
+- name: gpu
+  replicas: 1
+  labels:
+    gpu: "true"
+    removed: null
+`
+		expected := `
+nodeGroups:
+- name: front
+  replicas: 3
+  taints:
+  - key: a
+    effect: NoSchedule
+  - key: b
+    effect: NoSchedule
+- name: worker
+  replicas: 2
+- name: gpu
+  replicas: 1
+  labels:
+    gpu: "true"
+`
+		assertMerge(t, base, patch, expected, MergePatchWithStrategicMergeKeys(map[string]string{
+			"nodeGroups":          "name",
+			"nodeGroups[].taints": "key",
+		}))
+
+		// without taints merge key list replaced
+		expectedReplaced := `
+nodeGroups:
+- name: front
+  replicas: 3
+  taints:
+  - key: a
+    effect: NoExecute
+  - key: b
+    effect: NoSchedule
+`
+		assertMerge(t, base, `
+nodeGroups:
+- name: front
+  replicas: 3
+  taints:
+  - key: a
+    effect: NoExecute
+  - key: b
+    effect: NoSchedule
+`, expectedReplaced)
+	})
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var jsonNumberRegexp = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+// UnmarshalPreservingNumbers
+// unmarshals yaml or json document to json compatible value:
+// map[string]any, []any, string, bool, nil and json.Number
+// integers and floats written in json notation are returned as json.Number with original literal,
+// so values like 1.20 or integers out of float64 precision are not changed after json.Marshal
+// aliases and merge keys are expanded. YAML 1.2 rules are used for resolving scalars
+func UnmarshalPreservingNumbers(data []byte, opts ...ParseOption) (any, error) {
+	opts = append([]ParseOption{ParseWithAliasPolicy(AliasPolicyExpand)}, opts...)
+
+	data, err := newParseOptions(opts...).prepare(data)
+	if err != nil {
+		return nil, err
+	}
+
+	node := &yaml.Node{}
+	if err := yaml.Unmarshal(data, node); err != nil {
+		return nil, err
+	}
+
+	return nodeToValue(node)
+}
+
+// MarshalPreservingNumbers
+// marshals json compatible value to yaml with sorted maps keys
+// json.Number values are emitted as numbers with original literal
+func MarshalPreservingNumbers(v any) ([]byte, error) {
+	node, err := valueToNode(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode value: %w", err)
+	}
+
+	return encode(node, defaultIndent)
+}
+
+func nodeToValue(node *yaml.Node) (any, error) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+
+		return nodeToValue(node.Content[0])
+	case yaml.AliasNode:
+		return nodeToValue(node.Alias)
+	case yaml.MappingNode:
+		res := make(map[string]any, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			if key.Kind != yaml.ScalarNode {
+				return nil, fmt.Errorf("line %d: mapping key should be scalar", key.Line)
+			}
+
+			value, err := nodeToValue(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+
+			res[key.Value] = value
+		}
+
+		return res, nil
+	case yaml.SequenceNode:
+		res := make([]any, 0, len(node.Content))
+		for _, item := range node.Content {
+			value, err := nodeToValue(item)
+			if err != nil {
+				return nil, err
+			}
+
+			res = append(res, value)
+		}
+
+		return res, nil
+	case yaml.ScalarNode:
+		return scalarToValue(node)
+	default:
+		return nil, nil
+	}
+}
+
+func scalarToValue(node *yaml.Node) (any, error) {
+	switch node.ShortTag() {
+	case "!!int", "!!float":
+		if jsonNumberRegexp.MatchString(node.Value) {
+			return json.Number(node.Value), nil
+		}
+	case "!!null":
+		return nil, nil
+	case "!!str", "!!timestamp", "!!binary":
+		return node.Value, nil
+	}
+
+	var value any
+	if err := node.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func valueToNode(v any) (*yaml.Node, error) {
+	switch typed := v.(type) {
+	case json.Number:
+		tag := "!!int"
+		if strings.ContainsAny(typed.String(), ".eE") {
+			tag = "!!float"
+		}
+
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: typed.String()}, nil
+	case map[string]any:
+		keys := make([]string, 0, len(typed))
+		for key := range typed {
+			keys = append(keys, key)
+		}
+
+		slices.Sort(keys)
+
+		node := &yaml.Node{Kind: yaml.MappingNode, Tag: mapTag}
+		for _, key := range keys {
+			value, err := valueToNode(typed[key])
+			if err != nil {
+				return nil, err
+			}
+
+			node.Content = append(node.Content, newStringNode(key), value)
+		}
+
+		return node, nil
+	case []any:
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: seqTag}
+		for _, item := range typed {
+			value, err := valueToNode(item)
+			if err != nil {
+				return nil, err
+			}
+
+			node.Content = append(node.Content, value)
+		}
+
+		return node, nil
+	}
+
+	node := &yaml.Node{}
+	if err := node.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
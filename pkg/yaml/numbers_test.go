@@ -0,0 +1,112 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalPreservingNumbers(t *testing.T) {
+	t.Run("keeps numbers literals", func(t *testing.T) {
+		content := `
+version: 1.20
+big: 9007199254740993
+float: 1.5e10
+hex: 0x10
+str: "1.20"
+flag: true
+date: 2026-01-01
+empty: null
+base: &base
+  replicas: 3
+merged:
+  <<: *base
+  name: worker
+list:
+- 1.0
+- a
+`
+		value, err := UnmarshalPreservingNumbers([]byte(content))
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]any{
+			"version": json.Number("1.20"),
+			"big":     json.Number("9007199254740993"),
+			"float":   json.Number("1.5e10"),
+			"hex":     16,
+			"str":     "1.20",
+			"flag":    true,
+			"date":    "2026-01-01",
+			"empty":   nil,
+			"base":    map[string]any{"replicas": json.Number("3")},
+			"merged":  map[string]any{"replicas": json.Number("3"), "name": "worker"},
+			"list":    []any{json.Number("1.0"), "a"},
+		}, value)
+
+		jsonContent, err := json.Marshal(value)
+		require.NoError(t, err)
+		require.Contains(t, string(jsonContent), `"version":1.20`)
+		require.Contains(t, string(jsonContent), `"big":9007199254740993`)
+	})
+
+	t.Run("json document", func(t *testing.T) {
+		value, err := UnmarshalPreservingNumbers([]byte(`{"a": 1.10, "b": [12345678901234567890]}`))
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"a": json.Number("1.10"),
+			"b": []any{json.Number("12345678901234567890")},
+		}, value)
+	})
+
+	t.Run("empty document", func(t *testing.T) {
+		value, err := UnmarshalPreservingNumbers([]byte(""))
+		require.NoError(t, err)
+		require.Nil(t, value)
+	})
+
+	t.Run("invalid yaml", func(t *testing.T) {
+		_, err := UnmarshalPreservingNumbers([]byte("a: [1"))
+		require.Error(t, err)
+	})
+}
+
+func TestMarshalPreservingNumbers(t *testing.T) {
+	value := map[string]any{
+		"version": json.Number("1.20"),
+		"big":     json.Number("9007199254740993"),
+		"name":    "1.20",
+		"list":    []any{json.Number("1.0"), true, nil},
+	}
+
+	content, err := MarshalPreservingNumbers(value)
+	require.NoError(t, err)
+
+	expected := `big: 9007199254740993
+list:
+  - 1.0
+  - true
+  - null
+name: "1.20"
+version: 1.20
+`
+	require.Equal(t, expected, string(content))
+
+	parsed, err := UnmarshalPreservingNumbers(content)
+	require.NoError(t, err)
+	require.Equal(t, value, parsed)
+}
@@ -0,0 +1,287 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	ErrInvalidPath  = errors.New("invalid path")
+	ErrPathNotFound = errors.New("path not found")
+)
+
+type pathSegment struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+func (s pathSegment) String() string {
+	if s.isIndex {
+		return fmt.Sprintf("[%d]", s.index)
+	}
+
+	if s.needQuote() {
+		return fmt.Sprintf("[%q]", s.key)
+	}
+
+	return s.key
+}
+
+func (s pathSegment) needQuote() bool {
+	return s.key == "" || strings.ContainsAny(s.key, ".[]\"'")
+}
+
+// GetPath
+// returns value from document by path
+// path consists of fields separated by dot and list indexes in brackets,
+// for example: "spec.nodeGroups[0].name"
+// fields with dots or brackets can be quoted in brackets: `metadata.labels["node.deckhouse.io/group"]`
+// leading "$." is optional
+// returns ErrPathNotFound if any path segment not found
+func GetPath(doc []byte, path string) (any, error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj any
+	if err := yaml.Unmarshal(doc, &obj); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal document: %w", err)
+	}
+
+	current := obj
+	for i, segment := range segments {
+		next, ok := getSegment(current, segment)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrPathNotFound, joinSegments(segments[:i+1]))
+		}
+
+		current = next
+	}
+
+	return current, nil
+}
+
+// SetPath
+// sets value in document by path and returns changed yaml document
+// path format is the same as for GetPath
+// missing maps and lists are created
+// list index can be equal to list length, in this case value will be appended
+func SetPath(doc []byte, path string, value any) ([]byte, error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("%w: cannot set root document", ErrInvalidPath)
+	}
+
+	var obj any
+	if err := yaml.Unmarshal(doc, &obj); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal document: %w", err)
+	}
+
+	obj, err = setSegments(obj, segments, 0, value)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := MarshalStable(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal document: %w", err)
+	}
+
+	return result, nil
+}
+
+func getSegment(current any, segment pathSegment) (any, bool) {
+	if segment.isIndex {
+		list, ok := current.([]any)
+		if !ok || segment.index >= len(list) {
+			return nil, false
+		}
+
+		return list[segment.index], true
+	}
+
+	m, ok := current.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+
+	value, ok := m[segment.key]
+
+	return value, ok
+}
+
+func setSegments(current any, segments []pathSegment, i int, value any) (any, error) {
+	if i == len(segments) {
+		return value, nil
+	}
+
+	segment := segments[i]
+
+	if segment.isIndex {
+		var list []any
+		switch typed := current.(type) {
+		case []any:
+			list = typed
+		case nil:
+			list = make([]any, 0, 1)
+		default:
+			return nil, fmt.Errorf("%w: %s is not a list", ErrInvalidPath, joinSegments(segments[:i]))
+		}
+
+		if segment.index > len(list) {
+			return nil, fmt.Errorf("%w: index out of range %s", ErrInvalidPath, joinSegments(segments[:i+1]))
+		}
+
+		var item any
+		if segment.index < len(list) {
+			item = list[segment.index]
+		}
+
+		newItem, err := setSegments(item, segments, i+1, value)
+		if err != nil {
+			return nil, err
+		}
+
+		if segment.index == len(list) {
+			return append(list, newItem), nil
+		}
+
+		list[segment.index] = newItem
+
+		return list, nil
+	}
+
+	var m map[string]any
+	switch typed := current.(type) {
+	case map[string]any:
+		m = typed
+	case nil:
+		m = make(map[string]any, 1)
+	default:
+		return nil, fmt.Errorf("%w: %s is not a map", ErrInvalidPath, joinSegments(segments[:i]))
+	}
+
+	newValue, err := setSegments(m[segment.key], segments, i+1, value)
+	if err != nil {
+		return nil, err
+	}
+
+	m[segment.key] = newValue
+
+	return m, nil
+}
+
+func parsePath(path string) ([]pathSegment, error) {
+	rest := strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+
+	segments := make([]pathSegment, 0)
+
+	for rest != "" {
+		switch rest[0] {
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("%w %q: unclosed bracket", ErrInvalidPath, path)
+			}
+
+			if quoted := rest[1:end]; strings.HasPrefix(quoted, `"`) || strings.HasPrefix(quoted, `'`) {
+				key, closeIdx, err := parseQuotedKey(rest[1:])
+				if err != nil {
+					return nil, fmt.Errorf("%w %q: %w", ErrInvalidPath, path, err)
+				}
+
+				// skip quote and closing bracket
+				end = closeIdx + 2
+				if end >= len(rest) || rest[end] != ']' {
+					return nil, fmt.Errorf("%w %q: unclosed bracket", ErrInvalidPath, path)
+				}
+
+				segments = append(segments, pathSegment{key: key})
+			} else {
+				index, err := strconv.Atoi(quoted)
+				if err != nil || index < 0 {
+					return nil, fmt.Errorf("%w %q: invalid index %q", ErrInvalidPath, path, quoted)
+				}
+
+				segments = append(segments, pathSegment{index: index, isIndex: true})
+			}
+
+			rest = rest[end+1:]
+		case '.':
+			rest = rest[1:]
+			if rest == "" || rest[0] == '.' || rest[0] == '[' {
+				return nil, fmt.Errorf("%w %q: empty field", ErrInvalidPath, path)
+			}
+		default:
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+
+			segments = append(segments, pathSegment{key: rest[:end]})
+			rest = rest[end:]
+		}
+	}
+
+	return segments, nil
+}
+
+// parseQuotedKey
+// parse quoted key from start of s
+// returns key and index of closing quote
+func parseQuotedKey(s string) (string, int, error) {
+	quote := s[0]
+	b := strings.Builder{}
+
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				b.WriteByte(s[i])
+			}
+		case quote:
+			return b.String(), i, nil
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+
+	return "", 0, errors.New("unclosed quote")
+}
+
+func joinSegments(segments []pathSegment) string {
+	b := strings.Builder{}
+	for i, segment := range segments {
+		if i > 0 && !segment.isIndex && !segment.needQuote() {
+			b.WriteString(".")
+		}
+		b.WriteString(segment.String())
+	}
+
+	return b.String()
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testPathDoc = `
+apiVersion: deckhouse.io/v1
+kind: NodeGroup
+metadata:
+  name: worker
+  labels:
+    node.deckhouse.io/group: worker
+spec:
+  nodeType: CloudEphemeral
+  taints:
+  - key: a
+    effect: NoSchedule
+  - key: b
+    effect: NoExecute
+`
+
+func TestGetPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected any
+		err      error
+	}{
+		{path: "kind", expected: "NodeGroup"},
+		{path: "$.metadata.name", expected: "worker"},
+		{path: `metadata.labels["node.deckhouse.io/group"]`, expected: "worker"},
+		{path: `metadata.labels['node.deckhouse.io/group']`, expected: "worker"},
+		{path: "spec.taints[1].effect", expected: "NoExecute"},
+		{path: "spec.taints[0]", expected: map[string]any{"key": "a", "effect": "NoSchedule"}},
+		{path: "spec.taints[2]", err: ErrPathNotFound},
+		{path: "spec.absent.field", err: ErrPathNotFound},
+		{path: "kind.field", err: ErrPathNotFound},
+		{path: "spec.taints[a]", err: ErrInvalidPath},
+		{path: "spec.taints[0", err: ErrInvalidPath},
+		{path: "spec..taints", err: ErrInvalidPath},
+		{path: `metadata.labels["unclosed]`, err: ErrInvalidPath},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			value, err := GetPath([]byte(testPathDoc), tt.path)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, value)
+		})
+	}
+
+	t.Run("not found error contains path", func(t *testing.T) {
+		_, err := GetPath([]byte(testPathDoc), "spec.taints[5].key")
+		require.ErrorContains(t, err, "spec.taints[5]")
+	})
+}
+
+func TestSetPath(t *testing.T) {
+	tests := []struct {
+		name  string
+		path  string
+		value any
+		err   error
+	}{
+		{
+			name:  "replace field",
+			path:  "metadata.name",
+			value: "front",
+		},
+		{
+			name:  "create maps",
+			path:  `metadata.annotations["deckhouse.io/owner"]`,
+			value: "dhctl",
+		},
+		{
+			name:  "replace list item field",
+			path:  "spec.taints[1].effect",
+			value: "NoSchedule",
+		},
+		{
+			name:  "append list item",
+			path:  "spec.taints[2]",
+			value: map[string]any{"key": "c"},
+		},
+		{
+			name:  "create list",
+			path:  "spec.tolerations[0].key",
+			value: "c",
+		},
+		{
+			name: "index out of range",
+			path: "spec.taints[5]",
+			err:  ErrInvalidPath,
+		},
+		{
+			name: "not a map",
+			path: "kind.field",
+			err:  ErrInvalidPath,
+		},
+		{
+			name: "not a list",
+			path: "metadata[0]",
+			err:  ErrInvalidPath,
+		},
+		{
+			name: "root",
+			path: "$",
+			err:  ErrInvalidPath,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := SetPath([]byte(testPathDoc), tt.path, tt.value)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			value, err := GetPath(result, tt.path)
+			require.NoError(t, err)
+			require.Equal(t, tt.value, value)
+
+			kind, err := GetPath(result, "kind")
+			require.NoError(t, err)
+			require.Equal(t, "NodeGroup", kind, "should keep other fields")
+
+			firstTaint, err := GetPath(result, "spec.taints[0].key")
+			require.NoError(t, err)
+			require.Equal(t, "a", firstTaint, "should keep other list items")
+		})
+	}
+}
@@ -15,11 +15,18 @@
 package yaml
 
 import (
+	"bufio"
+	"bytes"
+	"errors"
 	"io"
+	"iter"
 	"regexp"
 	"strings"
+	"unicode"
 )
 
+const yamlSeparator = "---"
+
 var yamlSplitRegexp = regexp.MustCompile(`(?:^|\s*\n)---\s*`)
 
 func SplitYAML(s string) []string {
@@ -37,3 +44,111 @@ func SplitYAMLReader(reader io.Reader) ([]string, error) {
 	}
 	return SplitYAML(string(content)), nil
 }
+
+// SplitYAMLWithOptions
+// like SplitYAML but checks limits and applies alias policy for content before splitting
+// with AliasPolicyExpand returned documents do not contain anchors, aliases and merge keys
+func SplitYAMLWithOptions(s string, opts ...ParseOption) ([]string, error) {
+	content, err := newParseOptions(opts...).prepare([]byte(s))
+	if err != nil {
+		return nil, err
+	}
+
+	return SplitYAML(string(content)), nil
+}
+
+// Document
+// document from multi-document input with its position in input
+type Document struct {
+	Content string
+	// Line
+	// 1-based line number in input where Content starts
+	Line int
+	// Start
+	// byte offset of Content start in input
+	Start int
+	// End
+	// byte offset of Content end in input, input[Start:End] == Content
+	End int
+}
+
+// SplitYAMLWithPositions
+// like SplitYAML but returns documents with positions in s
+// documents contents are the same as returned by SplitYAML
+func SplitYAMLWithPositions(s string) []Document {
+	// offset of trimmed input in s, like in SplitYAML
+	base := len(s) - len(strings.TrimLeftFunc(s, unicode.IsSpace))
+	trimmed := strings.TrimSpace(s)
+
+	res := make([]Document, 0)
+
+	line := 1
+	lineCountedTo := 0
+
+	appendDoc := func(start, end int) {
+		line += strings.Count(s[lineCountedTo:base+start], "\n")
+		lineCountedTo = base + start
+
+		res = append(res, Document{
+			Content: trimmed[start:end],
+			Line:    line,
+			Start:   base + start,
+			End:     base + end,
+		})
+	}
+
+	beg := 0
+	for _, match := range yamlSplitRegexp.FindAllStringIndex(trimmed, -1) {
+		appendDoc(beg, match[0])
+		beg = match[1]
+	}
+
+	appendDoc(beg, len(trimmed))
+
+	return res
+}
+
+func SplitYAMLBytesWithPositions(s []byte) []Document {
+	return SplitYAMLWithPositions(string(s))
+}
+
+// SplitYAMLIter
+// streaming version of SplitYAMLReader
+// reads reader line by line and yields every document as soon as next separator was read,
+// so whole input is never kept in memory
+// yielded documents are trimmed like in SplitYAML and are safe to keep after next iteration
+// on read error yields nil document with error and stops
+func SplitYAMLIter(reader io.Reader) iter.Seq2[[]byte, error] {
+	return func(yield func([]byte, error) bool) {
+		r := bufio.NewReader(reader)
+		doc := bytes.Buffer{}
+
+		for {
+			line, err := r.ReadBytes('\n')
+			if err != nil && !errors.Is(err, io.EOF) {
+				yield(nil, err)
+				return
+			}
+
+			if bytes.HasPrefix(line, []byte(yamlSeparator)) {
+				if !yield(trimmedDocument(doc.Bytes()), nil) {
+					return
+				}
+
+				doc.Reset()
+				line = line[len(yamlSeparator):]
+			}
+
+			doc.Write(line)
+
+			if err != nil {
+				yield(trimmedDocument(doc.Bytes()), nil)
+				return
+			}
+		}
+	}
+}
+
+func trimmedDocument(doc []byte) []byte {
+	return append([]byte{}, bytes.TrimSpace(doc)...)
+}
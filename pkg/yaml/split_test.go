@@ -0,0 +1,130 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitYAMLIter(t *testing.T) {
+	collect := func(t *testing.T, input string) []string {
+		res := make([]string, 0)
+		for doc, err := range SplitYAMLIter(strings.NewReader(input)) {
+			require.NoError(t, err)
+			res = append(res, string(doc))
+		}
+		return res
+	}
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "empty", input: ""},
+		{name: "one document", input: "a: 1\nb: 2\n"},
+		{name: "without trailing new line", input: "a: 1\n---\nb: 2"},
+		{name: "multiple documents", input: "\n\na: 1\n  \n---\n\nb:\n  c: 2\n--- \nd: 3\n---\n"},
+		{name: "leading separator", input: "---\na: 1\n---\nb: 2\n"},
+		{name: "separator with content", input: "a: 1\n--- b: 2\n"},
+		{name: "separator like string inside", input: "a: |\n  ---\n  text\nb: \"---\"\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, SplitYAML(tt.input), collect(t, tt.input))
+		})
+	}
+
+	t.Run("documents are independent copies", func(t *testing.T) {
+		docs := make([][]byte, 0)
+		for doc, err := range SplitYAMLIter(iotest.OneByteReader(strings.NewReader("a: 1\n---\nb: 2\n---\nc: 3"))) {
+			require.NoError(t, err)
+			docs = append(docs, doc)
+		}
+
+		require.Equal(t, [][]byte{[]byte("a: 1"), []byte("b: 2"), []byte("c: 3")}, docs)
+	})
+
+	t.Run("break", func(t *testing.T) {
+		count := 0
+		for range SplitYAMLIter(strings.NewReader("a: 1\n---\nb: 2\n---\nc: 3")) {
+			count++
+			break
+		}
+
+		require.Equal(t, 1, count)
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		reader := iotest.ErrReader(errors.New("read error"))
+
+		var lastErr error
+		for _, err := range SplitYAMLIter(reader) {
+			lastErr = err
+		}
+
+		require.EqualError(t, lastErr, "read error")
+	})
+}
+
+func TestSplitYAMLWithPositions(t *testing.T) {
+	inputs := []string{
+		"",
+		"  \n",
+		"a: 1\nb: 2\n",
+		"---\na: 1\n---\nb: 2\n",
+		"\n\na: 1\n  \n---\n\nb:\n  c: 2\n--- \nd: 3\n---\n",
+	}
+
+	for _, input := range inputs {
+		docs := SplitYAMLWithPositions(input)
+
+		contents := make([]string, 0, len(docs))
+		for _, doc := range docs {
+			require.Equal(t, doc.Content, input[doc.Start:doc.End])
+			require.Equal(t, strings.Count(input[:doc.Start], "\n")+1, doc.Line)
+			contents = append(contents, doc.Content)
+		}
+
+		require.Equal(t, SplitYAML(input), contents, input)
+	}
+
+	input := `
+apiVersion: v1
+kind: First
+---
+apiVersion: v1
+kind: Second
+---
+
+apiVersion: v1
+kind: Third
+`
+
+	docs := SplitYAMLBytesWithPositions([]byte(input))
+	require.Len(t, docs, 3)
+
+	lines := make([]int, 0, len(docs))
+	for _, doc := range docs {
+		lines = append(lines, doc.Line)
+	}
+
+	require.Equal(t, []int{2, 5, 9}, lines)
+}
@@ -21,9 +21,15 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
-func Unmarshal[T any](data []byte) (T, error) {
+func Unmarshal[T any](data []byte, opts ...ParseOption) (T, error) {
 	var result T
-	err := yaml.Unmarshal(data, &result)
+
+	data, err := newParseOptions(opts...).prepare(data)
+	if err != nil {
+		return result, fmt.Errorf("failed to unmarshal to %s: %w", reflect.TypeFor[T]().String(), err)
+	}
+
+	err = yaml.Unmarshal(data, &result)
 	if err != nil {
 		return result, fmt.Errorf("failed to unmarshal to %s: %w", reflect.TypeFor[T]().String(), err)
 	}
@@ -31,6 +37,6 @@ func Unmarshal[T any](data []byte) (T, error) {
 	return result, nil
 }
 
-func UnmarshalString[T any](data string) (T, error) {
-	return Unmarshal[T]([]byte(data))
+func UnmarshalString[T any](data string, opts ...ParseOption) (T, error) {
+	return Unmarshal[T]([]byte(data), opts...)
 }
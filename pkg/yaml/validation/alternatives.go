@@ -0,0 +1,211 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+const (
+	anyOfKeyword = "anyOf"
+	oneOfKeyword = "oneOf"
+)
+
+type Alternative struct {
+	// Required
+	// all required fields for alternative
+	Required []string
+	// Missing
+	// required fields which are not present in document
+	Missing []string
+	// Errors
+	// all validation errors for alternative
+	Errors []string
+}
+
+func (a *Alternative) valid() bool {
+	return len(a.Errors) == 0
+}
+
+// AlternativesError
+// explains why document does not match anyOf or oneOf alternatives
+type AlternativesError struct {
+	Path    string
+	Keyword string
+
+	Alternatives []Alternative
+	// Closest
+	// index of alternative with minimal errors count
+	// -1 if document matches more than one alternative in oneOf
+	Closest int
+}
+
+func (e *AlternativesError) Error() string {
+	path := pathOrRoot(e.Path)
+
+	b := strings.Builder{}
+
+	if e.Closest < 0 {
+		matched := make([]string, 0)
+		for i, a := range e.Alternatives {
+			if a.valid() {
+				matched = append(matched, fmt.Sprintf("#%d", i+1))
+			}
+		}
+
+		b.WriteString(fmt.Sprintf("%s: must match exactly one alternative (%s), but matches %s", path, e.Keyword, strings.Join(matched, ", ")))
+		return b.String()
+	}
+
+	qualifier := "at least one alternative"
+	if e.Keyword == oneOfKeyword {
+		qualifier = "exactly one alternative"
+	}
+
+	b.WriteString(fmt.Sprintf("%s: must match %s (%s):", path, qualifier, e.Keyword))
+
+	for i, a := range e.Alternatives {
+		b.WriteString(fmt.Sprintf("\n\t\talternative #%d", i+1))
+		if len(a.Required) > 0 {
+			b.WriteString(fmt.Sprintf(" requires [%s]", strings.Join(a.Required, ", ")))
+		}
+
+		switch {
+		case len(a.Missing) > 0:
+			b.WriteString(fmt.Sprintf(", missing: [%s]", strings.Join(a.Missing, ", ")))
+		case len(a.Errors) > 0:
+			b.WriteString(fmt.Sprintf(", errors: %s", strings.Join(a.Errors, "; ")))
+		}
+	}
+
+	b.WriteString(fmt.Sprintf("\n\t\tclosest alternative is #%d", e.Closest+1))
+
+	return b.String()
+}
+
+// explainAlternatives
+// walks schema with data and explains all failed anyOf and oneOf
+// root is used for resolving refs in alternatives
+func explainAlternatives(root, schema *spec.Schema, data any, path string) []error {
+	if schema == nil {
+		return nil
+	}
+
+	res := make([]error, 0)
+
+	if err := explainAlternativesFor(root, anyOfKeyword, schema.AnyOf, data, path); err != nil {
+		res = append(res, err)
+	}
+
+	if err := explainAlternativesFor(root, oneOfKeyword, schema.OneOf, data, path); err != nil {
+		res = append(res, err)
+	}
+
+	switch typed := data.(type) {
+	case map[string]any:
+		fields := make([]string, 0, len(schema.Properties))
+		for field := range schema.Properties {
+			fields = append(fields, field)
+		}
+		// sort for stable errors
+		slices.Sort(fields)
+
+		for _, field := range fields {
+			value, ok := typed[field]
+			if !ok {
+				continue
+			}
+
+			prop := schema.Properties[field]
+			res = append(res, explainAlternatives(root, &prop, value, fieldPath(path, field))...)
+		}
+	case []any:
+		if schema.Items != nil && schema.Items.Schema != nil {
+			for i, item := range typed {
+				res = append(res, explainAlternatives(root, schema.Items.Schema, item, itemPath(path, i))...)
+			}
+		}
+	}
+
+	return res
+}
+
+func explainAlternativesFor(root *spec.Schema, keyword string, branches []spec.Schema, data any, path string) *AlternativesError {
+	if len(branches) == 0 {
+		return nil
+	}
+
+	alternatives := make([]Alternative, 0, len(branches))
+	validCount := 0
+	closest := 0
+
+	for i := range branches {
+		alternative := explainAlternative(root, &branches[i], data)
+		if alternative.valid() {
+			validCount++
+		}
+
+		alternatives = append(alternatives, alternative)
+
+		if len(alternative.Errors) < len(alternatives[closest].Errors) {
+			closest = i
+		}
+	}
+
+	switch {
+	case keyword == anyOfKeyword && validCount > 0:
+		return nil
+	case keyword == oneOfKeyword && validCount == 1:
+		return nil
+	case keyword == oneOfKeyword && validCount > 1:
+		closest = -1
+	}
+
+	return &AlternativesError{
+		Path:         path,
+		Keyword:      keyword,
+		Alternatives: alternatives,
+		Closest:      closest,
+	}
+}
+
+func explainAlternative(root, branch *spec.Schema, data any) Alternative {
+	alternative := Alternative{
+		Required: branch.Required,
+		Missing:  make([]string, 0),
+		Errors:   make([]string, 0),
+	}
+
+	if obj, ok := data.(map[string]any); ok {
+		for _, field := range branch.Required {
+			if _, present := obj[field]; !present {
+				alternative.Missing = append(alternative.Missing, field)
+			}
+		}
+	}
+
+	result := validate.NewSchemaValidator(branch, root, "", strfmt.Default).Validate(data)
+	for _, err := range result.Errors {
+		alternative.Errors = append(alternative.Errors, err.Error())
+	}
+
+	return alternative
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+func TestExplainAlternatives(t *testing.T) {
+	t.Run("any of in validator error", func(t *testing.T) {
+		validator := NewValidator(nil)
+		err := validator.LoadSchemas(strings.NewReader(testSchemaTestKind))
+		require.NoError(t, err)
+
+		doc := []byte(`
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+`)
+		_, err = validator.Validate(&doc, ValidateWithNoPrettyError(true))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "<root>: must match at least one alternative (anyOf)")
+		require.Contains(t, err.Error(), "alternative #2 requires [apiVersion, kind, sshUser, sudoPassword], missing: [sudoPassword]")
+	})
+
+	const schema = `
+kind: OneOfKind
+apiVersions:
+- apiVersion: test
+  openAPISpec:
+    type: object
+    properties:
+      provider:
+        type: object
+        oneOf:
+        - required: [region]
+        - required: [zone]
+        - required: [region, zone, network]
+`
+	schemas, err := LoadSchemas(strings.NewReader(schema))
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+
+	explain := func(t *testing.T, doc string) []error {
+		var data any
+		err := yaml.Unmarshal([]byte(doc), &data)
+		require.NoError(t, err)
+		return explainAlternatives(schemas[0].Schema, schemas[0].Schema, data, "")
+	}
+
+	t.Run("valid one of", func(t *testing.T) {
+		require.Empty(t, explain(t, "provider: {zone: a}"))
+	})
+
+	t.Run("no one alternative matched", func(t *testing.T) {
+		errs := explain(t, "provider: {network: a}")
+		require.Len(t, errs, 1)
+
+		var altErr *AlternativesError
+		require.ErrorAs(t, errs[0], &altErr)
+		require.Equal(t, "provider", altErr.Path)
+		require.Equal(t, oneOfKeyword, altErr.Keyword)
+		require.Equal(t, 0, altErr.Closest)
+		require.Len(t, altErr.Alternatives, 3)
+		require.Equal(t, []string{"region", "zone"}, altErr.Alternatives[2].Missing)
+		require.Contains(t, altErr.Error(), "provider: must match exactly one alternative (oneOf)")
+	})
+
+	t.Run("multiple alternatives matched", func(t *testing.T) {
+		errs := explain(t, "provider: {region: a, zone: b}")
+		require.Len(t, errs, 1)
+
+		var altErr *AlternativesError
+		require.ErrorAs(t, errs[0], &altErr)
+		require.Equal(t, -1, altErr.Closest)
+		require.Contains(t, altErr.Error(), "but matches #1, #2")
+	})
+
+	t.Run("alternatives with not expanded refs", func(t *testing.T) {
+		// schemas added with AddSchema can contain not expanded refs
+		root := new(spec.Schema)
+		err := yaml.Unmarshal([]byte(`
+type: object
+definitions:
+  aws:
+    required: [region]
+  gcp:
+    required: [zone]
+properties:
+  provider:
+    type: object
+    oneOf:
+    - $ref: "#/definitions/aws"
+    - $ref: "#/definitions/gcp"
+`), root)
+		require.NoError(t, err)
+
+		var data any
+		err = yaml.Unmarshal([]byte("provider: {zone: a}"), &data)
+		require.NoError(t, err)
+
+		require.Empty(t, explainAlternatives(root, root, data, ""))
+	})
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+type BatchValidationResult struct {
+	// Index
+	// nil if index was not parsed
+	Index *SchemaIndex
+	// Doc
+	// document after validation with applied defaults
+	// if validation failed contains input document
+	Doc []byte
+	// DefaultedPaths
+	// paths of fields added from schema defaults
+	// filled only if ValidateWithDefaultedPaths passed
+	DefaultedPaths []string
+	Err            error
+}
+
+// ValidateBatch
+// validates documents concurrently with concurrency workers
+// if concurrency less than 1, GOMAXPROCS workers will be used
+// returns results in input order and *ValidationError with all errors
+// if ctx done, not validated documents will have ctx error
+// ctx passed into PreValidators and extensions handlers
+// PreValidators and extensions handlers should be safe for concurrent use
+func (v *Validator) ValidateBatch(ctx context.Context, docs [][]byte, concurrency int, opts ...ValidateOption) ([]*BatchValidationResult, error) {
+	if concurrency < 1 {
+		concurrency = runtime.GOMAXPROCS(0)
+	}
+
+	results := make([]*BatchValidationResult, len(docs))
+
+	jobs := make(chan int)
+	wg := sync.WaitGroup{}
+
+	for range min(concurrency, len(docs)) {
+		wg.Go(func() {
+			for i := range jobs {
+				results[i] = v.validateBatchDoc(ctx, docs[i], opts...)
+			}
+		})
+	}
+
+	for i := range docs {
+		select {
+		case <-ctx.Done():
+			results[i] = &BatchValidationResult{Doc: docs[i], Err: ctx.Err()}
+		case jobs <- i:
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	errs := NewErrorBuilder()
+	for i, res := range results {
+		errs.AddDocumentError(i, res.Doc, res.Err)
+	}
+
+	return results, errs.ErrorOrNil()
+}
+
+func (v *Validator) validateBatchDoc(ctx context.Context, doc []byte, opts ...ValidateOption) *BatchValidationResult {
+	// copy for prevent changing input
+	docForValidate := make([]byte, len(doc))
+	copy(docForValidate, doc)
+
+	var defaultedPaths []string
+	index, err := v.ValidateContext(ctx, &docForValidate, withDocumentDefaultedPaths(opts, &defaultedPaths)...)
+
+	return &BatchValidationResult{
+		Index:          index,
+		Doc:            docForValidate,
+		DefaultedPaths: defaultedPaths,
+		Err:            err,
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/deckhouse/lib-dhctl/pkg/yaml/validation/transformer"
+)
+
+func TestValidateBatch(t *testing.T) {
+	validator := NewValidator(nil).SetLogger(testGetLogger())
+	err := validator.LoadSchemas(strings.NewReader(testSchemaTestKind))
+	require.NoError(t, err)
+	// transformer changes schema on every call, validation should use schema copy
+	validator.SetDefaultTransformers(transformer.NewAdditionalPropertiesTransformerDisallowFull())
+
+	const docsCount = 50
+
+	docs := make([][]byte, 0, docsCount)
+	for i := range docsCount {
+		port := fmt.Sprintf("%d", 2000+i)
+		// every tenth document is invalid
+		if i%10 == 0 {
+			port = `"invalid"`
+		}
+
+		docs = append(docs, []byte(fmt.Sprintf(`
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: user-%d
+sudoPassword: "no secret"
+sshPort: %s
+`, i, port)))
+	}
+
+	t.Run("results in input order", func(t *testing.T) {
+		results, err := validator.ValidateBatch(context.Background(), docs, 8, ValidateWithNoPrettyError(true))
+		require.Error(t, err)
+		require.ErrorIs(t, err, ErrDocumentValidationFailed)
+
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Len(t, validationErr.Errors, docsCount/10)
+		require.Equal(t, 10, *validationErr.Errors[1].Index)
+
+		require.Len(t, results, docsCount)
+		for i, res := range results {
+			if i%10 == 0 {
+				require.Error(t, res.Err)
+				continue
+			}
+
+			require.NoError(t, res.Err)
+			require.Equal(t, indexTestKind, *res.Index)
+			asserTestKind(t, res.Doc, &testKind{
+				SSHUser:      fmt.Sprintf("user-%d", i),
+				SudoPassword: "no secret",
+				SSHPort:      2000 + i,
+			})
+		}
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		results, err := validator.ValidateBatch(ctx, docs, 0)
+		require.Error(t, err)
+		require.Len(t, results, docsCount)
+
+		canceled := 0
+		for _, res := range results {
+			if res.Err == context.Canceled {
+				canceled++
+			}
+		}
+
+		require.Positive(t, canceled)
+	})
+
+	t.Run("defaulted paths per document", func(t *testing.T) {
+		const count = 200
+
+		docs := make([][]byte, 0, count)
+		for i := range count {
+			doc := "apiVersion: deckhouse.io/v1\nkind: TestKind\nsshUser: user\nsudoPassword: pass\n"
+			// every second document without port, port will be set from default
+			if i%2 == 1 {
+				doc += "sshPort: 2222\n"
+			}
+
+			docs = append(docs, []byte(doc))
+		}
+
+		var shared []string
+		results, err := validator.ValidateBatch(context.Background(), docs, 8, ValidateWithDefaultedPaths(&shared))
+		require.NoError(t, err)
+		require.Nil(t, shared, "should not set shared paths")
+
+		for i, res := range results {
+			if i%2 == 1 {
+				require.Empty(t, res.DefaultedPaths)
+				continue
+			}
+
+			require.Equal(t, []string{"sshPort"}, res.DefaultedPaths)
+		}
+	})
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"cmp"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/spec"
+)
+
+var kubeVersionRegexp = regexp.MustCompile(`^v([1-9][0-9]*)(?:(alpha|beta)([1-9][0-9]*))?$`)
+
+// SetBestVersionNegotiation
+// if schema for document version not found (with fallbacks and wildcard versions),
+// schema for the highest registered version of the same group and kind will be used with warning
+// instead of returning ErrSchemaNotFound. Validate returns index with negotiated version.
+// versions are compared like kubernetes versions: v2 > v1 > v1beta2 > v1beta1 > v1alpha1
+// it helps to validate documents generated by newer release
+func (v *Validator) SetBestVersionNegotiation(enabled bool) *Validator {
+	v.bestVersionNegotiation = enabled
+	return v
+}
+
+func (v *Validator) getBestVersionSchema(index *SchemaIndex) *spec.Schema {
+	if !v.bestVersionNegotiation {
+		return nil
+	}
+
+	group := index.Group()
+	if strings.HasPrefix(group, InvalidGroupPrefix) {
+		return nil
+	}
+
+	v.schemasMutex.RLock()
+	defer v.schemasMutex.RUnlock()
+
+	var (
+		best       *SchemaIndex
+		bestSchema *spec.Schema
+	)
+
+	for candidate, schema := range v.schemas {
+		if candidate.Kind != index.Kind || schema == nil {
+			continue
+		}
+
+		candidateGroup, candidateVersion := candidate.GroupAndGroupVersion()
+		if candidateGroup != group || candidateVersion == AnyVersion {
+			continue
+		}
+
+		if best == nil || compareKubeVersions(candidateVersion, best.GroupVersion()) > 0 {
+			best = &candidate
+			bestSchema = schema
+		}
+	}
+
+	if best == nil {
+		return nil
+	}
+
+	v.logger().WarnF("Schema for %s not found, use schema for the highest registered version %s", index.String(), best.Version)
+
+	*index = *best
+
+	return bestSchema
+}
+
+// compareKubeVersions
+// compare versions by kubernetes rules: GA > beta > alpha, then by major and minor numbers
+// well-formed versions are greater than not well-formed, not well-formed versions compare lexicographically
+func compareKubeVersions(a, b string) int {
+	aMatch := kubeVersionRegexp.FindStringSubmatch(a)
+	bMatch := kubeVersionRegexp.FindStringSubmatch(b)
+
+	switch {
+	case aMatch == nil && bMatch == nil:
+		// lexicographically less version is preferred like in kubernetes
+		return strings.Compare(b, a)
+	case aMatch == nil:
+		return -1
+	case bMatch == nil:
+		return 1
+	}
+
+	stability := func(level string) int {
+		switch level {
+		case "alpha":
+			return 0
+		case "beta":
+			return 1
+		default:
+			return 2
+		}
+	}
+
+	number := func(s string) int {
+		n, _ := strconv.Atoi(s)
+		return n
+	}
+
+	if res := cmp.Compare(stability(aMatch[2]), stability(bMatch[2])); res != 0 {
+		return res
+	}
+
+	if res := cmp.Compare(number(aMatch[1]), number(bMatch[1])); res != 0 {
+		return res
+	}
+
+	return cmp.Compare(number(aMatch[3]), number(bMatch[3]))
+}
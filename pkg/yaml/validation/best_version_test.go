@@ -0,0 +1,105 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBestVersionNegotiation(t *testing.T) {
+	getValidator := func() *Validator {
+		validator := NewValidator(nil).SetLogger(testGetLogger())
+		for _, version := range []string{"deckhouse.io/v1alpha1", "deckhouse.io/v1beta1", "deckhouse.io/v1", "other.io/v2", GroupAnyVersion("any.io")} {
+			validator.AddSchema(SchemaIndex{Kind: "TestKind", Version: version}, &spec.Schema{})
+		}
+
+		return validator
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		doc := []byte("apiVersion: deckhouse.io/v2\nkind: TestKind\n")
+		_, err := getValidator().Validate(&doc)
+		require.ErrorIs(t, err, ErrSchemaNotFound)
+	})
+
+	tests := []struct {
+		name          string
+		version       string
+		shouldFound   bool
+		expectedIndex SchemaIndex
+	}{
+		{
+			name:          "newer version",
+			version:       "deckhouse.io/v2",
+			shouldFound:   true,
+			expectedIndex: SchemaIndex{Kind: "TestKind", Version: "deckhouse.io/v1"},
+		},
+		{
+			name:          "exact version wins",
+			version:       "deckhouse.io/v1beta1",
+			shouldFound:   true,
+			expectedIndex: SchemaIndex{Kind: "TestKind", Version: "deckhouse.io/v1beta1"},
+		},
+		{
+			name:          "another group",
+			version:       "other.io/v3",
+			shouldFound:   true,
+			expectedIndex: SchemaIndex{Kind: "TestKind", Version: "other.io/v2"},
+		},
+		{
+			name:          "wildcard version wins",
+			version:       "any.io/v1",
+			shouldFound:   true,
+			expectedIndex: SchemaIndex{Kind: "TestKind", Version: "any.io/v1"},
+		},
+		{
+			name:        "unknown group",
+			version:     "unknown.io/v1",
+			shouldFound: false,
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.name, func(t *testing.T) {
+			validator := getValidator().SetBestVersionNegotiation(true)
+
+			index := SchemaIndex{Kind: "TestKind", Version: tst.version}
+			schema := validator.getSchemaWithFallback(&index)
+
+			if !tst.shouldFound {
+				require.Nil(t, schema)
+				return
+			}
+
+			require.NotNil(t, schema)
+			require.Equal(t, tst.expectedIndex, index)
+		})
+	}
+}
+
+func TestCompareKubeVersions(t *testing.T) {
+	// ascending order
+	ordered := []string{"foo10", "foo1", "v1alpha1", "v1alpha2", "v2alpha1", "v1beta1", "v2beta3", "v1", "v2", "v10"}
+
+	for i := 0; i+1 < len(ordered); i++ {
+		require.Equal(t, -1, compareKubeVersions(ordered[i], ordered[i+1]), "%s < %s", ordered[i], ordered[i+1])
+		require.Equal(t, 1, compareKubeVersions(ordered[i+1], ordered[i]), "%s > %s", ordered[i+1], ordered[i])
+	}
+
+	require.Equal(t, 0, compareKubeVersions("v1beta1", "v1beta1"))
+}
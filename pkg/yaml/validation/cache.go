@@ -0,0 +1,190 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"sync"
+)
+
+const defaultValidationCacheSize = 1024
+
+// ValidationCacheStats
+// counters of ValidationCache lookups
+type ValidationCacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+type validationCacheKey struct {
+	index   SchemaIndex
+	docHash [sha256.Size]byte
+	options validateOptions
+}
+
+type validationCacheEntry struct {
+	key   validationCacheKey
+	index SchemaIndex
+	doc   []byte
+	err   error
+}
+
+// ValidationCache
+// LRU cache of validation results keyed by index, sha256 of document and validate options
+// stores validation error and document with applied defaults
+// safe for concurrent use
+type ValidationCache struct {
+	mu sync.Mutex
+
+	size    int
+	entries map[validationCacheKey]*list.Element
+	lru     *list.List
+
+	stats ValidationCacheStats
+}
+
+// NewValidationCache
+// if size less than 1, default size 1024 will be used
+func NewValidationCache(size int) *ValidationCache {
+	if size < 1 {
+		size = defaultValidationCacheSize
+	}
+
+	return &ValidationCache{
+		size:    size,
+		entries: make(map[validationCacheKey]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+func (c *ValidationCache) Stats() ValidationCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.stats
+}
+
+func (c *ValidationCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
+
+// Purge
+// removes all entries, counters are not reset
+func (c *ValidationCache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[validationCacheKey]*list.Element)
+	c.lru.Init()
+}
+
+func (c *ValidationCache) get(key validationCacheKey) (*validationCacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+
+	c.stats.Hits++
+	c.lru.MoveToFront(elem)
+
+	return elem.Value.(*validationCacheEntry), true
+}
+
+func (c *ValidationCache) add(entry *validationCacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[entry.key]; ok {
+		elem.Value = entry
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	c.entries[entry.key] = c.lru.PushFront(entry)
+
+	for c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*validationCacheEntry).key)
+	}
+}
+
+// SetCache
+// cache validation results for unchanged documents. nil disables caching
+// cache is purged when schemas are added or reloaded, but not when transformers,
+// prevalidators or extensions validators are changed, so set cache after configuring validator
+// use cache only if prevalidators and extensions handlers results depend only on document
+// cache is not copied with Clone
+func (v *Validator) SetCache(cache *ValidationCache) *Validator {
+	v.cache = cache
+	return v
+}
+
+func (v *Validator) purgeCache() {
+	if v.cache != nil {
+		v.cache.Purge()
+	}
+}
+
+func (v *Validator) validateWithCache(index *SchemaIndex, doc *[]byte, opts []ValidateOption, validate func() error) error {
+	if v.cache == nil {
+		return validate()
+	}
+
+	options := *newValidateOptions(opts...)
+	// output parameter, does not affect validation result
+	options.defaultedPaths = nil
+
+	key := validationCacheKey{
+		index:   *index,
+		docHash: sha256.Sum256(*doc),
+		options: options,
+	}
+
+	if entry, ok := v.cache.get(key); ok {
+		*index = entry.index
+		if entry.err == nil {
+			*doc = append([]byte{}, entry.doc...)
+		}
+
+		return entry.err
+	}
+
+	err := validate()
+
+	// context errors do not depend on document
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	v.cache.add(&validationCacheEntry{
+		key:   key,
+		index: *index,
+		doc:   append([]byte{}, *doc...),
+		err:   err,
+	})
+
+	return err
+}
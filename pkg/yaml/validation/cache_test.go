@@ -0,0 +1,118 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidationCache(t *testing.T) {
+	const (
+		validDoc = `
+apiVersion: deckhouse.io/v1alpha1
+kind: TestKind
+sshUser: ubuntu
+sudoPassword: "no secret"
+`
+		invalidDoc = `
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+sshPort: "port"
+`
+	)
+
+	getValidator := func(t *testing.T, cache *ValidationCache) (*Validator, *[]string) {
+		calls := make([]string, 0)
+
+		validator := NewValidator(nil).SetLogger(testGetLogger()).
+			AddDefaultPreValidator(newTestCallsPreValidator(&calls, "prevalidator", nil)).
+			SetCache(cache)
+
+		err := validator.LoadSchemas(strings.NewReader(testSchemaTestKind))
+		require.NoError(t, err)
+
+		return validator, &calls
+	}
+
+	t.Run("hits and misses", func(t *testing.T) {
+		cache := NewValidationCache(10)
+		validator, calls := getValidator(t, cache)
+
+		var outputs []string
+		for range 2 {
+			doc := []byte(validDoc)
+			index, err := validator.Validate(&doc)
+			require.NoError(t, err)
+			require.Equal(t, indexTestKind, *index, "should return index after fallback")
+			outputs = append(outputs, string(doc))
+		}
+
+		require.Len(t, *calls, 1)
+		require.Equal(t, outputs[0], outputs[1])
+		require.Contains(t, outputs[1], `"sshPort":22`)
+		require.Equal(t, ValidationCacheStats{Hits: 1, Misses: 1}, cache.Stats())
+
+		for range 2 {
+			doc := []byte(invalidDoc)
+			_, err := validator.Validate(&doc)
+			require.ErrorIs(t, err, ErrDocumentValidationFailed)
+			require.Equal(t, invalidDoc, string(doc))
+		}
+
+		require.Len(t, *calls, 2)
+		require.Equal(t, ValidationCacheStats{Hits: 2, Misses: 2}, cache.Stats())
+
+		doc := []byte(validDoc)
+		_, err := validator.Validate(&doc, ValidateWithPreserveFormat(true))
+		require.NoError(t, err)
+		require.Len(t, *calls, 3, "options should be part of key")
+	})
+
+	t.Run("purge on schemas change", func(t *testing.T) {
+		cache := NewValidationCache(10)
+		validator, calls := getValidator(t, cache)
+
+		doc := []byte(validDoc)
+		_, err := validator.Validate(&doc)
+		require.NoError(t, err)
+		require.Equal(t, 1, cache.Len())
+
+		validator.AddSchema(SchemaIndex{Kind: "Another", Version: "v1"}, validator.Get(&indexTestKind))
+		require.Equal(t, 0, cache.Len())
+
+		doc = []byte(validDoc)
+		_, err = validator.Validate(&doc)
+		require.NoError(t, err)
+		require.Len(t, *calls, 2)
+	})
+
+	t.Run("eviction", func(t *testing.T) {
+		cache := NewValidationCache(1)
+		validator, calls := getValidator(t, cache)
+
+		for _, d := range []string{validDoc, invalidDoc, validDoc} {
+			doc := []byte(d)
+			_, _ = validator.Validate(&doc)
+		}
+
+		require.Len(t, *calls, 3)
+		require.Equal(t, 1, cache.Len())
+		require.Equal(t, uint64(0), cache.Stats().Hits)
+	})
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/deckhouse/lib-dhctl/pkg/yaml/validation/transformer"
+)
+
+// Clone
+// returns independent copy of validator. Schemas are shared with copy read-only:
+// adding, reloading or overlaying schemas in one validator does not affect another,
+// and transformers of both validators are applied to schemas copies.
+// Prevalidators, transformers, fallbacks, aliases, extensions validators and decryptors lists are copied,
+// so copy can be customized per request without mutating validator
+// prevalidators, transformers, extensions validators and decryptors itself are shared
+func (v *Validator) Clone() *Validator {
+	v.schemasMutex.RLock()
+	defer v.schemasMutex.RUnlock()
+
+	preValidators := make(map[SchemaIndex][]PreValidator, len(v.preValidators))
+	for index, validators := range v.preValidators {
+		preValidators[index] = slices.Clone(validators)
+	}
+
+	transformers := make(map[SchemaIndex][]transformer.SchemaTransformer, len(v.transformers))
+	for index, t := range v.transformers {
+		transformers[index] = slices.Clone(t)
+	}
+
+	return &Validator{
+		schemas:                 maps.Clone(v.schemas),
+		preValidators:           preValidators,
+		defaultPreValidators:    slices.Clone(v.defaultPreValidators),
+		loggerProvider:          v.loggerProvider,
+		versionFallbacks:        maps.Clone(v.versionFallbacks),
+		versionFallbackFuncs:    slices.Clone(v.versionFallbackFuncs),
+		kindAliases:             maps.Clone(v.kindAliases),
+		transformers:            transformers,
+		defaultTransformers:     slices.Clone(v.defaultTransformers),
+		conditionalTransformers: slices.Clone(v.conditionalTransformers),
+		extensionsValidators:    slices.Clone(v.extensionsValidators),
+		limits:                  v.limits,
+		messages:                v.messages,
+		instrumenter:            v.instrumenter,
+		decryptors:              slices.Clone(v.decryptors),
+		bestVersionNegotiation:  v.bestVersionNegotiation,
+		transformMutex:          v.transformMutex,
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/deckhouse/lib-dhctl/pkg/yaml/validation/transformer"
+)
+
+func TestValidatorClone(t *testing.T) {
+	const schemas = `
+kind: CloneKind
+apiVersions:
+- apiVersion: deckhouse.io/v1
+  openAPISpec:
+    type: object
+    additionalProperties: true
+    properties:
+      kind:
+        type: string
+      apiVersion:
+        type: string
+      name:
+        type: string
+`
+	const doc = `
+apiVersion: deckhouse.io/v1
+kind: CloneKind
+name: test
+unknown: value
+`
+
+	index := SchemaIndex{Kind: "CloneKind", Version: "deckhouse.io/v1"}
+
+	getValidator := func(t *testing.T) *Validator {
+		validator := NewValidator(nil).SetLogger(testGetLogger())
+		err := validator.LoadSchemas(strings.NewReader(schemas))
+		require.NoError(t, err)
+		return validator
+	}
+
+	validate := func(v *Validator) error {
+		d := []byte(doc)
+		_, err := v.Validate(&d)
+		return err
+	}
+
+	t.Run("transformers do not affect original", func(t *testing.T) {
+		validator := getValidator(t)
+
+		clone := validator.Clone().AddTransformers(index, transformer.NewAdditionalPropertiesTransformerDisallowFull())
+
+		require.ErrorIs(t, validate(clone), ErrDocumentValidationFailed)
+		require.NoError(t, validate(validator))
+		require.True(t, validator.Get(&index).AdditionalProperties.Allows, "shared schema should not change")
+	})
+
+	t.Run("original transformers do not change shared schemas", func(t *testing.T) {
+		validator := getValidator(t)
+		clone := validator.Clone()
+
+		validator.AddTransformers(index, transformer.NewAdditionalPropertiesTransformerDisallowFull())
+
+		require.ErrorIs(t, validate(validator), ErrDocumentValidationFailed)
+		require.NoError(t, validate(clone))
+	})
+
+	t.Run("prevalidators and aliases are copied", func(t *testing.T) {
+		calls := make([]string, 0)
+
+		validator := getValidator(t).AddPreValidator(index, newTestCallsPreValidator(&calls, "original", nil))
+
+		clone := validator.Clone().
+			AddPreValidator(index, newTestCallsPreValidator(&calls, "clone", nil)).
+			AddKindAlias("OldCloneKind", "CloneKind")
+
+		require.NoError(t, validate(validator))
+		require.Equal(t, []string{"original"}, calls)
+
+		calls = calls[:0]
+		require.NoError(t, validate(clone))
+		require.Equal(t, []string{"original", "clone"}, calls)
+
+		aliased := SchemaIndex{Kind: "OldCloneKind", Version: "deckhouse.io/v1"}
+		require.Nil(t, validator.getSchemaWithFallback(&aliased))
+	})
+
+	t.Run("schemas are independent", func(t *testing.T) {
+		validator := getValidator(t)
+		clone := validator.Clone()
+
+		another := SchemaIndex{Kind: "AnotherKind", Version: "v1"}
+		clone.AddSchema(another, validator.Get(&index))
+
+		require.NotNil(t, clone.Get(&another))
+		require.Nil(t, validator.Get(&another))
+	})
+
+	t.Run("concurrent clones", func(t *testing.T) {
+		validator := getValidator(t).SetDefaultTransformers(transformer.NewAdditionalPropertiesTransformer())
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(strict bool) {
+				defer wg.Done()
+
+				clone := validator.Clone()
+				if strict {
+					clone.AddTransformers(index, transformer.NewAdditionalPropertiesTransformerDisallowFull())
+					require.Error(t, validate(clone))
+					return
+				}
+
+				require.NoError(t, validate(clone))
+			}(i%2 == 0)
+		}
+
+		wg.Wait()
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/go-openapi/spec"
+	"github.com/name212/govalue"
+
+	"github.com/deckhouse/lib-dhctl/pkg/yaml/validation/transformer"
+)
+
+// TransformerPredicate
+// decides should conditional transformers be applied for document with index
+// doc is document passed for validation
+type TransformerPredicate func(index SchemaIndex, doc []byte) bool
+
+type conditionalTransformers struct {
+	predicate    TransformerPredicate
+	transformers []transformer.SchemaTransformer
+}
+
+// AddTransformersIf
+// add transformers applied only if predicate returns true for validated document
+// conditional transformers apply after transformers added with AddTransformers or SetDefaultTransformers
+// in order of adding. Unlike static transformers they are applied to schema copy,
+// so loaded schema stays unchanged for documents that do not match predicate
+func (v *Validator) AddTransformersIf(predicate TransformerPredicate, t ...transformer.SchemaTransformer) *Validator {
+	if predicate == nil || len(t) == 0 {
+		return v
+	}
+
+	v.conditionalTransformers = append(v.conditionalTransformers, conditionalTransformers{
+		predicate:    predicate,
+		transformers: slices.Clone(t),
+	})
+
+	return v
+}
+
+// TransformIfGroup
+// predicate for AddTransformersIf matches documents with one of passed groups
+// for example deckhouse.io for deckhouse.io/v1 version
+func TransformIfGroup(groups ...string) TransformerPredicate {
+	return func(index SchemaIndex, _ []byte) bool {
+		return slices.Contains(groups, index.Group())
+	}
+}
+
+// applyConditionalTransformers
+// should be called with transformMutex locked, because transformers can be not safe for concurrent use
+func (v *Validator) applyConditionalTransformers(index *SchemaIndex, schema *spec.Schema, doc []byte) (*spec.Schema, error) {
+	transformers := make([]transformer.SchemaTransformer, 0)
+	for _, c := range v.conditionalTransformers {
+		if c.predicate(*index, doc) {
+			transformers = append(transformers, c.transformers...)
+		}
+	}
+
+	if len(transformers) == 0 {
+		return schema, nil
+	}
+
+	schema, err := copySchema(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range transformers {
+		if govalue.IsNil(t) {
+			continue
+		}
+
+		schema = t.Transform(schema)
+	}
+
+	return schema, nil
+}
+
+func copySchema(schema *spec.Schema) (*spec.Schema, error) {
+	content, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("schema marshal failed: %w", err)
+	}
+
+	result := new(spec.Schema)
+	if err := json.Unmarshal(content, result); err != nil {
+		return nil, fmt.Errorf("schema unmarshal failed: %w", err)
+	}
+
+	return result, nil
+}
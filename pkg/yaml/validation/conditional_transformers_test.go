@@ -0,0 +1,139 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/deckhouse/lib-dhctl/pkg/yaml/validation/transformer"
+)
+
+func TestAddTransformersIf(t *testing.T) {
+	const schemas = `
+kind: ConditionalKind
+apiVersions:
+- apiVersion: deckhouse.io/v1
+  openAPISpec:
+    type: object
+    additionalProperties: true
+    properties:
+      kind:
+        type: string
+      apiVersion:
+        type: string
+      name:
+        type: string
+- apiVersion: v1
+  openAPISpec:
+    type: object
+    additionalProperties: true
+    properties:
+      kind:
+        type: string
+      apiVersion:
+        type: string
+      name:
+        type: string
+`
+
+	getValidator := func(t *testing.T) *Validator {
+		validator := NewValidator(nil).SetLogger(testGetLogger())
+		err := validator.LoadSchemas(strings.NewReader(schemas))
+		require.NoError(t, err)
+		return validator
+	}
+
+	docWithVersion := func(version string) []byte {
+		return []byte(`
+apiVersion: ` + version + `
+kind: ConditionalKind
+name: test
+unknown: value
+`)
+	}
+
+	t.Run("group predicate", func(t *testing.T) {
+		validator := getValidator(t).AddTransformersIf(
+			TransformIfGroup("deckhouse.io"),
+			transformer.NewAdditionalPropertiesTransformerDisallowFull(),
+		)
+
+		doc := docWithVersion("deckhouse.io/v1")
+		_, err := validator.Validate(&doc, ValidateWithNoPrettyError(true))
+		require.ErrorIs(t, err, ErrDocumentValidationFailed)
+		require.Contains(t, err.Error(), "unknown")
+
+		doc = docWithVersion("v1")
+		_, err = validator.Validate(&doc)
+		require.NoError(t, err, "transformer should not apply for another group")
+	})
+
+	t.Run("loaded schema does not change", func(t *testing.T) {
+		strict := false
+
+		validator := getValidator(t).AddTransformersIf(
+			func(SchemaIndex, []byte) bool {
+				return strict
+			},
+			transformer.NewAdditionalPropertiesTransformerDisallowFull(),
+		)
+
+		strict = true
+		doc := docWithVersion("deckhouse.io/v1")
+		_, err := validator.Validate(&doc)
+		require.ErrorIs(t, err, ErrDocumentValidationFailed)
+
+		strict = false
+		doc = docWithVersion("deckhouse.io/v1")
+		_, err = validator.Validate(&doc)
+		require.NoError(t, err)
+
+		index := SchemaIndex{Kind: "ConditionalKind", Version: "deckhouse.io/v1"}
+		require.True(t, validator.Get(&index).AdditionalProperties.Allows)
+	})
+
+	t.Run("predicate receives document", func(t *testing.T) {
+		validator := getValidator(t).AddTransformersIf(
+			func(_ SchemaIndex, doc []byte) bool {
+				return strings.Contains(string(doc), "name: strict")
+			},
+			transformer.NewAdditionalPropertiesTransformerDisallowFull(),
+		)
+
+		doc := []byte(`
+apiVersion: v1
+kind: ConditionalKind
+name: strict
+unknown: value
+`)
+		_, err := validator.Validate(&doc)
+		require.ErrorIs(t, err, ErrDocumentValidationFailed)
+
+		doc = docWithVersion("v1")
+		_, err = validator.Validate(&doc)
+		require.NoError(t, err)
+	})
+
+	t.Run("nil predicate is ignored", func(t *testing.T) {
+		validator := getValidator(t).AddTransformersIf(nil, transformer.NewAdditionalPropertiesTransformerDisallowFull())
+
+		doc := docWithVersion("deckhouse.io/v1")
+		_, err := validator.Validate(&doc)
+		require.NoError(t, err)
+	})
+}
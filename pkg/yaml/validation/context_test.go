@@ -0,0 +1,118 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deckhouse/lib-dhctl/pkg/log"
+)
+
+type testContextKey struct{}
+
+type testContextPreValidator struct {
+	values []any
+	after  func()
+}
+
+func (p *testContextPreValidator) Validate([]byte, log.Logger) (*spec.Schema, error) {
+	panic("should call ValidateContext")
+}
+
+func (p *testContextPreValidator) ValidateContext(ctx context.Context, _ []byte, _ log.Logger) (*spec.Schema, error) {
+	p.values = append(p.values, ctx.Value(testContextKey{}))
+	if p.after != nil {
+		p.after()
+	}
+
+	return nil, nil
+}
+
+type testContextRuleHandler struct {
+	values []any
+}
+
+func (h *testContextRuleHandler) Validate(ctx context.Context, _ json.RawMessage, _ ExtensionsRuleParams) error {
+	h.values = append(h.values, ctx.Value(testContextKey{}))
+	return nil
+}
+
+func TestValidateContext(t *testing.T) {
+	const doc = `
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+sshAgentPrivateKeys:
+- key: "mykey"
+  passphrase: "pass"
+`
+
+	getValidator := func(t *testing.T) *Validator {
+		validator := NewValidator(nil).SetLogger(testGetLogger())
+		err := validator.LoadSchemas(strings.NewReader(testSchemaTestKind))
+		require.NoError(t, err, "failed to load schema")
+		return validator
+	}
+
+	t.Run("pass ctx", func(t *testing.T) {
+		preValidator := &testContextPreValidator{}
+		handler := &testContextRuleHandler{}
+
+		validator := getValidator(t).
+			AddPreValidator(indexTestKind, preValidator).
+			AddExtensionsValidators(NewExtensionsValidatorWithHandlers(xRulesExtension, map[string]ExtensionsRuleHandler{
+				"passphrase": handler,
+			}))
+
+		ctx := context.WithValue(context.Background(), testContextKey{}, "value")
+
+		d := []byte(doc)
+		_, err := validator.ValidateContext(ctx, &d)
+		require.NoError(t, err)
+
+		require.Equal(t, []any{"value"}, preValidator.values)
+		require.Equal(t, []any{"value"}, handler.values)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		d := []byte(doc)
+		_, err := getValidator(t).ValidateContext(ctx, &d)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("documents canceled between documents", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		preValidator := &testContextPreValidator{after: cancel}
+		validator := getValidator(t).AddPreValidator(indexTestKind, preValidator)
+
+		content := []byte(strings.Join([]string{doc, doc, doc}, "\n---\n"))
+
+		result, err := validator.ValidateDocumentsContext(ctx, content)
+		require.ErrorIs(t, err, context.Canceled)
+		require.Len(t, result.Documents, 1)
+		require.Len(t, preValidator.values, 1)
+	})
+}
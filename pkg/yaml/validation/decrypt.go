@@ -0,0 +1,97 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/name212/govalue"
+	"sigs.k8s.io/yaml"
+)
+
+// Decryptor
+// decrypts documents before index parsing and validation
+// Decrypt is called only if IsEncrypted returns true for document
+type Decryptor interface {
+	IsEncrypted(doc []byte) bool
+	Decrypt(ctx context.Context, doc []byte) ([]byte, error)
+}
+
+type funcDecryptor struct {
+	isEncrypted func(doc []byte) bool
+	decrypt     func(ctx context.Context, doc []byte) ([]byte, error)
+}
+
+// NewDecryptor
+// returns Decryptor from functions, for example
+// NewDecryptor(IsSOPSEncrypted, decryptWithSOPS)
+func NewDecryptor(isEncrypted func(doc []byte) bool, decrypt func(ctx context.Context, doc []byte) ([]byte, error)) Decryptor {
+	return &funcDecryptor{
+		isEncrypted: isEncrypted,
+		decrypt:     decrypt,
+	}
+}
+
+func (d *funcDecryptor) IsEncrypted(doc []byte) bool {
+	return d.isEncrypted(doc)
+}
+
+func (d *funcDecryptor) Decrypt(ctx context.Context, doc []byte) ([]byte, error) {
+	return d.decrypt(ctx, doc)
+}
+
+// IsSOPSEncrypted
+// returns true if document contains sops metadata with mac on top level
+func IsSOPSEncrypted(doc []byte) bool {
+	var obj struct {
+		SOPS map[string]any `json:"sops"`
+	}
+
+	if err := yaml.Unmarshal(doc, &obj); err != nil {
+		return false
+	}
+
+	_, hasMac := obj.SOPS["mac"]
+
+	return hasMac
+}
+
+// AddDecryptors
+// decryptors are called in order of adding for every document before index parsing
+// in Validate, ValidateWithIndex, ValidateDocuments and ValidateBatch
+// validated document contains decrypted content, so decrypted secrets can be shown
+// in errors with document dump. Use ValidateWithOmitDocInError for prevent it
+func (v *Validator) AddDecryptors(decryptors ...Decryptor) *Validator {
+	v.decryptors = append(v.decryptors, decryptors...)
+	return v
+}
+
+func (v *Validator) decrypt(ctx context.Context, doc []byte) ([]byte, error) {
+	for _, decryptor := range v.decryptors {
+		if govalue.IsNil(decryptor) || !decryptor.IsEncrypted(doc) {
+			continue
+		}
+
+		decrypted, err := decryptor.Decrypt(ctx, doc)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrDecryptFailed, err)
+		}
+
+		doc = decrypted
+	}
+
+	return doc, nil
+}
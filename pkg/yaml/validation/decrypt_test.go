@@ -0,0 +1,134 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecryptors(t *testing.T) {
+	const (
+		encryptedDoc = `
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ENC[AES256_GCM,data:dWJ1bnR1,type:str]
+sudoPassword: ENC[AES256_GCM,data:c2VjcmV0,type:str]
+sops:
+  mac: ENC[AES256_GCM,data:bWFj,type:str]
+  version: 3.9.0
+`
+		decryptedDoc = `
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+sudoPassword: secret
+`
+	)
+
+	getValidator := func(t *testing.T, decryptErr error) (*Validator, *int) {
+		calls := 0
+
+		decryptor := NewDecryptor(IsSOPSEncrypted, func(ctx context.Context, doc []byte) ([]byte, error) {
+			calls++
+			if decryptErr != nil {
+				return nil, decryptErr
+			}
+
+			return []byte(decryptedDoc), nil
+		})
+
+		validator := NewValidator(nil).SetLogger(testGetLogger()).AddDecryptors(decryptor)
+		err := validator.LoadSchemas(strings.NewReader(testSchemaTestKind))
+		require.NoError(t, err)
+
+		return validator, &calls
+	}
+
+	t.Run("is sops encrypted", func(t *testing.T) {
+		require.True(t, IsSOPSEncrypted([]byte(encryptedDoc)))
+		require.False(t, IsSOPSEncrypted([]byte(decryptedDoc)))
+		require.False(t, IsSOPSEncrypted([]byte("sops: value")))
+		require.False(t, IsSOPSEncrypted([]byte("{")))
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		validator, calls := getValidator(t, nil)
+
+		doc := []byte(encryptedDoc)
+		index, err := validator.Validate(&doc)
+		require.NoError(t, err)
+		require.Equal(t, indexTestKind, *index)
+		require.Equal(t, 1, *calls)
+
+		asserTestKind(t, doc, &testKind{
+			SSHUser:      "ubuntu",
+			SudoPassword: "secret",
+			SSHPort:      22,
+		})
+
+		doc = []byte(decryptedDoc)
+		err = validator.ValidateWithIndex(&indexTestKind, &doc)
+		require.NoError(t, err)
+		require.Equal(t, 1, *calls, "should not decrypt not encrypted document")
+	})
+
+	t.Run("documents", func(t *testing.T) {
+		validator, calls := getValidator(t, nil)
+
+		content := []byte(strings.Join([]string{encryptedDoc, decryptedDoc}, "\n---\n"))
+		result, err := validator.ValidateDocuments(content)
+		require.NoError(t, err)
+		require.Len(t, result.Documents, 2)
+		require.Equal(t, 1, *calls)
+	})
+
+	t.Run("documents are decrypted once", func(t *testing.T) {
+		calls := 0
+		// decryptor which can not distinguish encrypted documents and fails on plain text
+		decryptor := NewDecryptor(func([]byte) bool { return true }, func(ctx context.Context, doc []byte) ([]byte, error) {
+			calls++
+			if !IsSOPSEncrypted(doc) {
+				return nil, fmt.Errorf("not encrypted")
+			}
+
+			return []byte(decryptedDoc), nil
+		})
+
+		validator := NewValidator(nil).SetLogger(testGetLogger()).AddDecryptors(decryptor)
+		require.NoError(t, validator.LoadSchemas(strings.NewReader(testSchemaTestKind)))
+
+		result, err := validator.ValidateDocuments([]byte(encryptedDoc))
+		require.NoError(t, err)
+		require.Len(t, result.Documents, 1)
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("decrypt failed", func(t *testing.T) {
+		validator, _ := getValidator(t, fmt.Errorf("no key"))
+
+		doc := []byte(encryptedDoc)
+		_, err := validator.Validate(&doc)
+		require.ErrorIs(t, err, ErrDecryptFailed)
+		require.Contains(t, err.Error(), "no key")
+
+		_, err = validator.ValidateDocuments([]byte(encryptedDoc))
+		require.ErrorIs(t, err, ErrDecryptFailed)
+	})
+}
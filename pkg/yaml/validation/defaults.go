@@ -0,0 +1,115 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"slices"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ValidateWithDefaultedPaths
+// after successful validation, paths is set to sorted list of fields paths
+// which values were added from schema defaults, like sshPort or nodes[0].zone
+// if default value is object, only path to object is returned
+// multi-document methods (ValidateDocuments, ValidateBatch, etc.) do not set paths,
+// they return paths for every document in DefaultedPaths field of result
+func ValidateWithDefaultedPaths(paths *[]string) ValidateOption {
+	return func(o *validateOptions) {
+		o.defaultedPaths = paths
+	}
+}
+
+// withDocumentDefaultedPaths
+// replaces caller paths with paths for one document in multi-document methods,
+// because caller paths are shared between all documents and can be set concurrently
+func withDocumentDefaultedPaths(opts []ValidateOption, paths *[]string) []ValidateOption {
+	if newValidateOptions(opts...).defaultedPaths == nil {
+		return opts
+	}
+
+	return append(slices.Clip(opts), ValidateWithDefaultedPaths(paths))
+}
+
+// DefaultedPaths
+// returns sorted list of fields paths which are present in validated document but absent in original,
+// so they were added from schema defaults. Documents can be in YAML or JSON format
+func DefaultedPaths(original, validated []byte) ([]string, error) {
+	var originalObj, validatedObj any
+
+	if err := yaml.Unmarshal(original, &originalObj); err != nil {
+		return nil, fmt.Errorf("unmarshal original document: %w", err)
+	}
+
+	if err := yaml.Unmarshal(validated, &validatedObj); err != nil {
+		return nil, fmt.Errorf("unmarshal validated document: %w", err)
+	}
+
+	paths := addedPaths(originalObj, validatedObj, "")
+	slices.Sort(paths)
+
+	return paths, nil
+}
+
+func addedPaths(original, validated any, path string) []string {
+	res := make([]string, 0)
+
+	switch validatedTyped := validated.(type) {
+	case map[string]any:
+		originalTyped, ok := original.(map[string]any)
+		if !ok {
+			return res
+		}
+
+		for field, value := range validatedTyped {
+			originalValue, ok := originalTyped[field]
+			if !ok {
+				res = append(res, fieldPath(path, field))
+				continue
+			}
+
+			res = append(res, addedPaths(originalValue, value, fieldPath(path, field))...)
+		}
+	case []any:
+		originalTyped, ok := original.([]any)
+		if !ok || len(originalTyped) != len(validatedTyped) {
+			return res
+		}
+
+		for i, item := range validatedTyped {
+			res = append(res, addedPaths(originalTyped[i], item, itemPath(path, i))...)
+		}
+	}
+
+	return res
+}
+
+// fillDefaultedPaths
+// defaulted paths are calculated from documents and do not depend on cache
+func fillDefaultedPaths(original, validated []byte, options *validateOptions) error {
+	if options.defaultedPaths == nil {
+		return nil
+	}
+
+	paths, err := DefaultedPaths(original, validated)
+	if err != nil {
+		return err
+	}
+
+	*options.defaultedPaths = paths
+
+	return nil
+}
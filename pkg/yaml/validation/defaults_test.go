@@ -0,0 +1,136 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultedPaths(t *testing.T) {
+	const schemas = `
+kind: DefaultsKind
+apiVersions:
+- apiVersion: deckhouse.io/v1
+  openAPISpec:
+    type: object
+    properties:
+      apiVersion:
+        type: string
+      kind:
+        type: string
+      replicas:
+        type: integer
+        default: 1
+      mode:
+        type: string
+        default: Static
+      settings:
+        type: object
+        default: {}
+        properties:
+          timeout:
+            type: string
+            default: 1m
+      nodes:
+        type: array
+        items:
+          type: object
+          properties:
+            name:
+              type: string
+            zone:
+              type: string
+              default: a
+`
+
+	validator := NewValidator(nil).SetLogger(testGetLogger())
+	err := validator.LoadSchemas(strings.NewReader(schemas))
+	require.NoError(t, err)
+
+	t.Run("defaulted fields", func(t *testing.T) {
+		doc := []byte(`
+apiVersion: deckhouse.io/v1
+kind: DefaultsKind
+mode: Cloud
+nodes:
+- name: first
+- name: second
+  zone: b
+`)
+		original := append([]byte{}, doc...)
+
+		var paths []string
+		_, err := validator.Validate(&doc, ValidateWithDefaultedPaths(&paths))
+		require.NoError(t, err)
+		require.Equal(t, []string{"nodes[0].zone", "replicas", "settings"}, paths)
+
+		fromDocs, err := DefaultedPaths(original, doc)
+		require.NoError(t, err)
+		require.Equal(t, paths, fromDocs)
+	})
+
+	t.Run("without defaults", func(t *testing.T) {
+		doc := []byte(`{"apiVersion": "deckhouse.io/v1", "kind": "DefaultsKind", "replicas": 2, "mode": "Static", "settings": {"timeout": "1s"}}`)
+
+		paths := []string{"stale"}
+		_, err := validator.Validate(&doc, ValidateWithDefaultedPaths(&paths))
+		require.NoError(t, err)
+		require.Empty(t, paths)
+	})
+
+	t.Run("with cache", func(t *testing.T) {
+		cached := validator.Clone().SetCache(NewValidationCache(0))
+		for range 2 {
+			doc := []byte("apiVersion: deckhouse.io/v1\nkind: DefaultsKind\nreplicas: 3\n")
+
+			var paths []string
+			_, err := cached.Validate(&doc, ValidateWithDefaultedPaths(&paths))
+			require.NoError(t, err)
+			require.Equal(t, []string{"mode", "settings"}, paths)
+		}
+
+		require.Equal(t, uint64(1), cached.cache.Stats().Hits)
+	})
+
+	t.Run("multiple documents", func(t *testing.T) {
+		content := []byte(`
+apiVersion: deckhouse.io/v1
+kind: DefaultsKind
+replicas: 2
+mode: Static
+---
+apiVersion: deckhouse.io/v1
+kind: DefaultsKind
+settings: {}
+`)
+
+		var shared []string
+		result, err := validator.ValidateDocuments(content, ValidateWithDefaultedPaths(&shared))
+		require.NoError(t, err)
+		require.Nil(t, shared, "should not set shared paths")
+
+		require.Len(t, result.Documents, 2)
+		require.Equal(t, []string{"settings"}, result.Documents[0].DefaultedPaths)
+		require.Equal(t, []string{"mode", "replicas", "settings.timeout"}, result.Documents[1].DefaultedPaths)
+	})
+
+	t.Run("invalid document", func(t *testing.T) {
+		_, err := DefaultedPaths([]byte("a: [1"), []byte("a: 1"))
+		require.Error(t, err)
+	})
+}
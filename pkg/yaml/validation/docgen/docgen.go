@@ -0,0 +1,207 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/go-openapi/spec"
+
+	"github.com/deckhouse/lib-dhctl/pkg/yaml/validation"
+)
+
+type Field struct {
+	Path        string `json:"path"`
+	Type        string `json:"type"`
+	Required    bool   `json:"required"`
+	Default     any    `json:"default,omitempty"`
+	Description string `json:"description,omitempty"`
+	Examples    []any  `json:"examples,omitempty"`
+}
+
+type Document struct {
+	Kind        string  `json:"kind"`
+	Version     string  `json:"apiVersion"`
+	Description string  `json:"description,omitempty"`
+	Fields      []Field `json:"fields"`
+}
+
+// Describe
+// collects all fields from schema recursively
+// array items are described with [] suffix, like sshAgentPrivateKeys[].key
+// fields sorted by name on every level
+func Describe(index validation.SchemaIndex, schema *spec.Schema) *Document {
+	doc := &Document{
+		Kind:    index.Kind,
+		Version: index.Version,
+		Fields:  make([]Field, 0),
+	}
+
+	if schema == nil {
+		return doc
+	}
+
+	doc.Description = schema.Description
+	doc.Fields = describeProperties("", schema, doc.Fields)
+
+	return doc
+}
+
+func DescribeSchemas(schemas []*validation.SchemaWithIndex) []*Document {
+	res := make([]*Document, 0, len(schemas))
+	for _, s := range schemas {
+		if s == nil {
+			continue
+		}
+
+		res = append(res, Describe(s.Index, s.Schema))
+	}
+
+	return res
+}
+
+func RenderJSON(w io.Writer, docs ...*Document) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(docs); err != nil {
+		return fmt.Errorf("cannot render json: %w", err)
+	}
+
+	return nil
+}
+
+func RenderMarkdown(w io.Writer, docs ...*Document) error {
+	b := strings.Builder{}
+
+	for i, doc := range docs {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+
+		b.WriteString(fmt.Sprintf("## %s (%s)\n\n", doc.Kind, doc.Version))
+		if doc.Description != "" {
+			b.WriteString(strings.TrimSpace(doc.Description))
+			b.WriteString("\n\n")
+		}
+
+		b.WriteString("| Field | Type | Required | Default | Description | Examples |\n")
+		b.WriteString("|-------|------|----------|---------|-------------|----------|\n")
+
+		for _, f := range doc.Fields {
+			required := ""
+			if f.Required {
+				required = "yes"
+			}
+
+			examples := make([]string, 0, len(f.Examples))
+			for _, e := range f.Examples {
+				examples = append(examples, markdownValue(e))
+			}
+
+			b.WriteString(fmt.Sprintf(
+				"| `%s` | %s | %s | %s | %s | %s |\n",
+				f.Path,
+				markdownCell(f.Type),
+				required,
+				markdownValue(f.Default),
+				markdownCell(f.Description),
+				strings.Join(examples, "<br>"),
+			))
+		}
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("cannot render markdown: %w", err)
+	}
+
+	return nil
+}
+
+func describeProperties(prefix string, schema *spec.Schema, fields []Field) []Field {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		prop := schema.Properties[name]
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+
+		fields = append(fields, Field{
+			Path:        path,
+			Type:        schemaType(&prop),
+			Required:    slices.Contains(schema.Required, name),
+			Default:     prop.Default,
+			Description: strings.TrimSpace(prop.Description),
+			Examples:    validation.SchemaExamples(&prop),
+		})
+
+		fields = describeNested(path, &prop, fields)
+	}
+
+	return fields
+}
+
+func describeNested(path string, schema *spec.Schema, fields []Field) []Field {
+	if len(schema.Properties) > 0 {
+		fields = describeProperties(path, schema, fields)
+	}
+
+	if schema.Items != nil && schema.Items.Schema != nil {
+		fields = describeNested(path+"[]", schema.Items.Schema, fields)
+	}
+
+	return fields
+}
+
+func schemaType(schema *spec.Schema) string {
+	t := strings.Join(schema.Type, "|")
+
+	if schema.Items != nil && schema.Items.Schema != nil {
+		if itemsType := schemaType(schema.Items.Schema); itemsType != "" {
+			return fmt.Sprintf("%s of %s", t, itemsType)
+		}
+	}
+
+	return t
+}
+
+func markdownValue(v any) string {
+	if v == nil {
+		return ""
+	}
+
+	content, err := json.Marshal(v)
+	if err != nil {
+		return markdownCell(fmt.Sprintf("%v", v))
+	}
+
+	return "`" + markdownCell(string(content)) + "`"
+}
+
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(strings.TrimSpace(s), "\n", "<br>")
+}
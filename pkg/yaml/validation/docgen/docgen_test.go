@@ -0,0 +1,127 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/deckhouse/lib-dhctl/pkg/yaml/validation"
+)
+
+const testSchema = `
+kind: TestKind
+apiVersions:
+- apiVersion: deckhouse.io/v1
+  openAPISpec:
+    type: object
+    description: Test kind.
+    required: [sshUser]
+    properties:
+      sshUser:
+        type: string
+        description: SSH username.
+        x-examples: ["ubuntu", "root"]
+      sshPort:
+        default: 22
+        type: integer
+        description: SSH port.
+        x-examples: 2200
+      sshAgentPrivateKeys:
+        type: array
+        items:
+          type: object
+          required: [key]
+          properties:
+            key:
+              type: string
+              description: |
+                Private SSH key.
+                Multiline | description.
+`
+
+func loadTestSchemas(t *testing.T) []*validation.SchemaWithIndex {
+	schemas, err := validation.LoadSchemas(strings.NewReader(testSchema))
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+
+	return schemas
+}
+
+func TestDescribe(t *testing.T) {
+	docs := DescribeSchemas(loadTestSchemas(t))
+	require.Len(t, docs, 1)
+
+	doc := docs[0]
+	require.Equal(t, "TestKind", doc.Kind)
+	require.Equal(t, "deckhouse.io/v1", doc.Version)
+	require.Equal(t, "Test kind.", doc.Description)
+
+	paths := make([]string, 0, len(doc.Fields))
+	for _, f := range doc.Fields {
+		paths = append(paths, f.Path)
+	}
+
+	require.Equal(t, []string{
+		"sshAgentPrivateKeys",
+		"sshAgentPrivateKeys[].key",
+		"sshPort",
+		"sshUser",
+	}, paths)
+
+	require.Equal(t, "array of object", doc.Fields[0].Type)
+	require.True(t, doc.Fields[1].Required)
+
+	require.Equal(t, "integer", doc.Fields[2].Type)
+	require.False(t, doc.Fields[2].Required)
+	require.EqualValues(t, 22, doc.Fields[2].Default)
+	require.Equal(t, []any{float64(2200)}, doc.Fields[2].Examples)
+
+	require.True(t, doc.Fields[3].Required)
+	require.Equal(t, []any{"ubuntu", "root"}, doc.Fields[3].Examples)
+}
+
+func TestRender(t *testing.T) {
+	docs := DescribeSchemas(loadTestSchemas(t))
+
+	t.Run("markdown", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		err := RenderMarkdown(buf, docs...)
+		require.NoError(t, err)
+
+		out := buf.String()
+		require.Contains(t, out, "## TestKind (deckhouse.io/v1)")
+		require.Contains(t, out, "| `sshPort` | integer |  | `22` | SSH port. | `2200` |")
+		require.Contains(t, out, "| `sshUser` | string | yes |  | SSH username. | `\"ubuntu\"`<br>`\"root\"` |")
+		require.Contains(t, out, `Private SSH key.<br>Multiline \| description.`)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		err := RenderJSON(buf, docs...)
+		require.NoError(t, err)
+
+		var result []*Document
+		err = json.Unmarshal(buf.Bytes(), &result)
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		require.Len(t, result[0].Fields, 4)
+		require.Equal(t, "sshUser", result[0].Fields[3].Path)
+	})
+}
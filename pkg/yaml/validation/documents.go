@@ -0,0 +1,156 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"sigs.k8s.io/yaml"
+
+	libyaml "github.com/deckhouse/lib-dhctl/pkg/yaml"
+)
+
+type ValidatedDocument struct {
+	Index SchemaIndex
+	// Doc
+	// document after validation with applied defaults
+	Doc []byte
+	// DefaultedPaths
+	// paths of fields added from schema defaults
+	// filled only if ValidateWithDefaultedPaths passed
+	DefaultedPaths []string
+}
+
+type DocumentsValidationResult struct {
+	Documents []*ValidatedDocument
+	// Resources
+	// documents without schema. Filled only if ValidateWithCollectUnknownKinds passed
+	Resources [][]byte
+}
+
+// ValidateDocuments
+// split multi-document content and validate every document
+// if ValidateWithCollectUnknownKinds passed, documents without schema
+// are collected into Resources instead of failing
+// returns *ValidationError with all errors for all invalid documents
+func (v *Validator) ValidateDocuments(content []byte, opts ...ValidateOption) (*DocumentsValidationResult, error) {
+	return v.ValidateDocumentsContext(context.Background(), content, opts...)
+}
+
+// ValidateDocumentsContext
+// like ValidateDocuments but checks ctx between documents and pass ctx into PreValidators and extensions handlers
+// if ctx done, returns result for already validated documents and ctx error
+func (v *Validator) ValidateDocumentsContext(ctx context.Context, content []byte, opts ...ValidateOption) (*DocumentsValidationResult, error) {
+	options := newValidateOptions(opts...)
+
+	result := &DocumentsValidationResult{
+		Documents: make([]*ValidatedDocument, 0),
+		Resources: make([][]byte, 0),
+	}
+
+	errs := NewErrorBuilder()
+
+	docs := libyaml.SplitYAMLBytesWithPositions(content)
+
+	for i, d := range docs {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		if strings.TrimSpace(d.Content) == "" {
+			continue
+		}
+
+		// decrypted and parsed in one place with same options as for single document
+		doc := []byte(d.Content)
+		var defaultedPaths []string
+		index, err := v.ValidateContext(ctx, &doc, withDocumentDefaultedPaths(opts, &defaultedPaths)...)
+
+		switch {
+		case err == nil:
+			result.Documents = append(result.Documents, &ValidatedDocument{
+				Index:          *index,
+				Doc:            doc,
+				DefaultedPaths: defaultedPaths,
+			})
+		case options.collectUnknownKinds && errors.Is(err, ErrSchemaNotFound):
+			v.logger().DebugF("Document %d %s collected as resource", i, index.String())
+			result.Resources = append(result.Resources, doc)
+		default:
+			errs.AddDocumentError(i, doc, err).WithLine(d.Line)
+		}
+	}
+
+	return result, errs.ErrorOrNil()
+}
+
+// ValidateReader
+// read all content from reader and validate every document like ValidateDocuments
+// every error for invalid document contains document ordinal number, line and kind with version
+// if reader returns error - wrap reader error with ErrRead
+func (v *Validator) ValidateReader(reader io.Reader, opts ...ValidateOption) (*DocumentsValidationResult, error) {
+	return v.ValidateReaderContext(context.Background(), reader, opts...)
+}
+
+// ValidateReaderContext
+// like ValidateReader but with ctx, see ValidateDocumentsContext
+func (v *Validator) ValidateReaderContext(ctx context.Context, reader io.Reader, opts ...ValidateOption) (*DocumentsValidationResult, error) {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRead, err)
+	}
+
+	return v.ValidateDocumentsContext(ctx, content, opts...)
+}
+
+func newDocumentError(i int, doc []byte, err error) Error {
+	index := namedIndex{}
+	// error is not important here, we only want to enrich error with index fields
+	_ = yaml.Unmarshal(doc, &index)
+
+	schemaIndex := SchemaIndex{Kind: index.Kind, Version: index.Version}
+	group, groupVersion := schemaIndex.GroupAndGroupVersion()
+
+	return Error{
+		Index:    &i,
+		Group:    group,
+		Version:  groupVersion,
+		Kind:     index.Kind,
+		Name:     index.Metadata.Name,
+		Messages: errorMessages(err),
+	}
+}
+
+// errorMessages
+// returns message for every violation if err contains multiple violations
+// otherwise returns err message
+func errorMessages(err error) []string {
+	var errs *multierror.Error
+	if !errors.As(err, &errs) || len(errs.Errors) == 0 {
+		return []string{err.Error()}
+	}
+
+	res := make([]string, 0, len(errs.Errors))
+	for _, e := range errs.Errors {
+		res = append(res, e.Error())
+	}
+
+	return res
+}
@@ -0,0 +1,198 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDocuments(t *testing.T) {
+	getValidator := func(t *testing.T) *Validator {
+		validator := NewValidator(nil).SetLogger(testGetLogger())
+		err := validator.LoadSchemas(strings.NewReader(testSchemaTestKind))
+		require.NoError(t, err, "failed to load schema")
+		return validator
+	}
+
+	const (
+		testKindDoc = `
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+sudoPassword: "no secret"
+`
+		resourceDoc = `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: test
+`
+		invalidDoc = `
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+sshPort: "port"
+`
+	)
+
+	joinDocs := func(docs ...string) []byte {
+		return []byte(strings.Join(docs, "\n---\n"))
+	}
+
+	t.Run("without collect unknown kinds", func(t *testing.T) {
+		result, err := getValidator(t).ValidateDocuments(joinDocs(testKindDoc, resourceDoc))
+		require.Error(t, err, "should fail on unknown kind")
+		require.ErrorIs(t, err, ErrSchemaNotFound)
+
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Len(t, validationErr.Errors, 1)
+		require.Equal(t, 1, *validationErr.Errors[0].Index)
+		require.Equal(t, 9, validationErr.Errors[0].Line)
+		require.Equal(t, "Namespace", validationErr.Errors[0].Kind)
+		require.Equal(t, "test", validationErr.Errors[0].Name)
+		require.Contains(t, err.Error(), "[1 at line 9]")
+
+		require.Len(t, result.Documents, 1)
+		require.Empty(t, result.Resources)
+	})
+
+	t.Run("collect unknown kinds", func(t *testing.T) {
+		result, err := getValidator(t).ValidateDocuments(
+			joinDocs(testKindDoc, resourceDoc, ""),
+			ValidateWithCollectUnknownKinds(true),
+		)
+		require.NoError(t, err)
+
+		require.Len(t, result.Documents, 1)
+		require.Equal(t, indexTestKind, result.Documents[0].Index)
+		asserTestKind(t, result.Documents[0].Doc, &testKind{
+			SSHUser:      "ubuntu",
+			SudoPassword: "no secret",
+			SSHPort:      22,
+		})
+
+		require.Len(t, result.Resources, 1)
+		require.Contains(t, string(result.Resources[0]), "kind: Namespace")
+	})
+
+	t.Run("invalid document does not collect", func(t *testing.T) {
+		result, err := getValidator(t).ValidateDocuments(
+			joinDocs(resourceDoc, invalidDoc),
+			ValidateWithCollectUnknownKinds(true),
+			ValidateWithNoPrettyError(true),
+		)
+		require.Error(t, err)
+		require.ErrorIs(t, err, ErrDocumentValidationFailed)
+
+		require.Empty(t, result.Documents)
+		require.Len(t, result.Resources, 1)
+	})
+}
+
+func TestValidateReader(t *testing.T) {
+	validator := NewValidator(nil).SetLogger(testGetLogger())
+	err := validator.LoadSchemas(strings.NewReader(testSchemaTestKind))
+	require.NoError(t, err)
+
+	const (
+		validDoc = `apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+sudoPassword: "no secret"
+`
+		invalidDoc = `apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+sudoPassword: "no secret"
+sshPort: "port"
+`
+	)
+
+	t.Run("valid and invalid documents", func(t *testing.T) {
+		content := strings.Join([]string{validDoc, invalidDoc, validDoc}, "---\n")
+
+		result, err := validator.ValidateReader(strings.NewReader(content))
+		require.Error(t, err)
+		require.ErrorIs(t, err, ErrDocumentValidationFailed)
+
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Len(t, validationErr.Errors, 1)
+
+		docErr := validationErr.Errors[0]
+		require.NotNil(t, docErr.Index)
+		require.Equal(t, 1, *docErr.Index)
+		require.Equal(t, 6, docErr.Line)
+		require.Equal(t, "deckhouse.io", docErr.Group)
+		require.Equal(t, "v1", docErr.Version)
+		require.Equal(t, "TestKind", docErr.Kind)
+		require.Contains(t, err.Error(), "[1 at line 6]deckhouse.io/v1, Kind=TestKind")
+
+		require.Len(t, result.Documents, 2)
+		require.Equal(t, indexTestKind, result.Documents[0].Index)
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		_, err := validator.ValidateReader(iotest.ErrReader(errors.New("broken")))
+		require.Error(t, err)
+		require.ErrorIs(t, err, ErrRead)
+		require.Contains(t, err.Error(), "broken")
+	})
+}
+
+func TestValidateDocumentsReportAllViolations(t *testing.T) {
+	validator := NewValidator(nil).SetLogger(testGetLogger()).
+		AddExtensionsValidators(NewXRulesExtensionsValidator(map[string]ExtensionsValidatorHandler{
+			"passphrase": func(json.RawMessage) error {
+				return fmt.Errorf("passphrase is weak")
+			},
+		}))
+
+	err := validator.LoadSchemas(strings.NewReader(testSchemaTestKind))
+	require.NoError(t, err)
+
+	content := []byte(`
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+sshPort: "port"
+sshAgentPrivateKeys:
+- key: first
+  passphrase: "1"
+- key: second
+  passphrase: "2"
+`)
+
+	_, err = validator.ValidateDocuments(content, ValidateWithNoPrettyError(true))
+	require.ErrorIs(t, err, ErrDocumentValidationFailed)
+
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	require.Len(t, validationErr.Errors, 1)
+
+	messages := validationErr.Errors[0].Messages
+	require.Len(t, messages, 3)
+	require.Contains(t, messages[0], "sshPort must be of type integer")
+	require.Contains(t, messages[1], `sshAgentPrivateKeys[0]: rule "passphrase" failed: passphrase is weak`)
+	require.Contains(t, messages[2], `sshAgentPrivateKeys[1]: rule "passphrase" failed: passphrase is weak`)
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/name212/govalue"
+)
+
+// EffectiveSchema
+// returns schema for index as JSON as it will be used for validation:
+// with version fallbacks and kind aliases and after index-specific or default transformers
+// conditional transformers added with AddTransformersIf depend on document and are not applied
+// loaded schema is not changed
+// if schema not found returns ErrSchemaNotFound
+func (v *Validator) EffectiveSchema(index SchemaIndex) ([]byte, error) {
+	schema := v.getSchemaWithFallback(&index)
+	if schema == nil {
+		return nil, fmt.Errorf("%w: %s", ErrSchemaNotFound, index.String())
+	}
+
+	v.transformMutex.Lock()
+	defer v.transformMutex.Unlock()
+
+	schema, err := copySchema(schema)
+	if err != nil {
+		return nil, fmt.Errorf("cannot copy schema for %s: %w", index.String(), err)
+	}
+
+	for _, t := range v.transformersForIndex(&index) {
+		if govalue.IsNil(t) {
+			continue
+		}
+
+		schema = t.Transform(schema)
+	}
+
+	content, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal effective schema for %s: %w", index.String(), err)
+	}
+
+	return content, nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deckhouse/lib-dhctl/pkg/yaml/validation/transformer"
+)
+
+func TestEffectiveSchema(t *testing.T) {
+	const schemas = `
+kind: EffectiveKind
+apiVersions:
+- apiVersion: deckhouse.io/v1
+  openAPISpec:
+    type: object
+    additionalProperties: true
+    properties:
+      host:
+        type: string
+        default: registry.deckhouse.io
+`
+	index := SchemaIndex{Kind: "EffectiveKind", Version: "deckhouse.io/v1"}
+
+	getValidator := func(t *testing.T) *Validator {
+		validator := NewValidator(nil).SetLogger(testGetLogger())
+		err := validator.LoadSchemas(strings.NewReader(schemas))
+		require.NoError(t, err)
+		return validator
+	}
+
+	unmarshal := func(t *testing.T, content []byte) *spec.Schema {
+		schema := new(spec.Schema)
+		require.NoError(t, json.Unmarshal(content, schema))
+		return schema
+	}
+
+	t.Run("with transformers", func(t *testing.T) {
+		validator := getValidator(t).
+			SetDefaultTransformers(transformer.NewDefaultOverrideTransformer().Override("host", "registry.local")).
+			AddTransformers(index, transformer.NewAdditionalPropertiesTransformerDisallowFull())
+
+		content, err := validator.EffectiveSchema(index)
+		require.NoError(t, err)
+
+		schema := unmarshal(t, content)
+		require.False(t, schema.AdditionalProperties.Allows)
+		require.Equal(t, "registry.deckhouse.io", schema.Properties["host"].Default, "default transformers should not apply if index has own")
+
+		require.True(t, validator.Get(&index).AdditionalProperties.Allows, "loaded schema should not change")
+	})
+
+	t.Run("default transformers and fallback", func(t *testing.T) {
+		validator := getValidator(t).
+			SetDefaultTransformers(transformer.NewDefaultOverrideTransformer().Override("host", "registry.local"))
+
+		content, err := validator.EffectiveSchema(SchemaIndex{Kind: index.Kind, Version: "deckhouse.io/v1alpha1"})
+		require.NoError(t, err)
+		require.Equal(t, "registry.local", unmarshal(t, content).Properties["host"].Default)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := getValidator(t).EffectiveSchema(SchemaIndex{Kind: "Unknown", Version: "v1"})
+		require.ErrorIs(t, err, ErrSchemaNotFound)
+	})
+}
@@ -29,6 +29,8 @@ const (
 	ErrDocumentValidationFailed
 	ErrSchemaNotFound
 	ErrRead
+	ErrLimitExceeded
+	ErrDecryptFailed
 	ErrUnknown
 )
 
@@ -39,6 +41,8 @@ var validationErrors = []ErrorKind{
 	ErrDocumentValidationFailed,
 	ErrSchemaNotFound,
 	ErrRead,
+	ErrLimitExceeded,
+	ErrDecryptFailed,
 	ErrUnknown,
 }
 
@@ -92,6 +96,10 @@ func (k ErrorKind) String() string {
 		return "SchemaNotFound"
 	case ErrRead:
 		return "ReadError"
+	case ErrLimitExceeded:
+		return "LimitExceeded"
+	case ErrDecryptFailed:
+		return "DecryptFailed"
 	case ErrUnknown:
 		return unknownErrString
 	default:
@@ -118,7 +126,10 @@ func (v *ValidationError) Error() string {
 	errs := make([]string, 0, len(v.Errors))
 	for _, e := range v.Errors {
 		b := strings.Builder{}
-		if e.Index != nil {
+		switch {
+		case e.Index != nil && e.Line > 0:
+			b.WriteString(fmt.Sprintf("[%d at line %d]", *e.Index, e.Line))
+		case e.Index != nil:
 			b.WriteString(fmt.Sprintf("[%d]", *e.Index))
 		}
 
@@ -139,6 +150,16 @@ func (v *ValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", v.Kind, strings.Join(errs, "\n"))
 }
 
+// Unwrap
+// returns Kind for using errors.Is with ErrorKind
+func (v *ValidationError) Unwrap() error {
+	if v == nil || v.Kind == 0 {
+		return nil
+	}
+
+	return v.Kind
+}
+
 func (v *ValidationError) ErrorOrNil() error {
 	if v == nil {
 		return nil
@@ -151,7 +172,10 @@ func (v *ValidationError) ErrorOrNil() error {
 }
 
 type Error struct {
-	Index    *int
+	Index *int
+	// Line
+	// 1-based line where document starts in multi-document input, 0 if unknown
+	Line     int
 	Group    string
 	Version  string
 	Kind     string
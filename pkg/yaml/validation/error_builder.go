@@ -0,0 +1,193 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrorBuilder
+// aggregates errors from different sources into one *ValidationError
+// safe for concurrent use
+type ErrorBuilder struct {
+	m   sync.Mutex
+	err *ValidationError
+}
+
+func NewErrorBuilder() *ErrorBuilder {
+	return &ErrorBuilder{
+		err: &ValidationError{},
+	}
+}
+
+// Add
+// appends error entry with kind and messages
+// returned entry can be used for enriching error with index, document kind, name, etc.
+func (b *ErrorBuilder) Add(kind ErrorKind, messages ...string) *ErrorEntry {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	b.err.Append(kind, Error{Messages: messages})
+
+	return &ErrorEntry{
+		builder: b,
+		i:       len(b.err.Errors) - 1,
+	}
+}
+
+// AddError
+// appends error entry with err message, kind is extracted from err
+// nil err is skipped and nil entry is returned
+func (b *ErrorBuilder) AddError(err error) *ErrorEntry {
+	if err == nil {
+		return nil
+	}
+
+	return b.Add(ExtractValidationError(err), err.Error())
+}
+
+// AddDocumentError
+// like AddError, but enrich error with kind, version and name from document
+func (b *ErrorBuilder) AddDocumentError(i int, doc []byte, err error) *ErrorEntry {
+	if err == nil {
+		return nil
+	}
+
+	docErr := newDocumentError(i, doc, err)
+
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	b.err.Append(ExtractValidationError(err), docErr)
+
+	return &ErrorEntry{
+		builder: b,
+		i:       len(b.err.Errors) - 1,
+	}
+}
+
+// Merge
+// appends all entries from *ValidationError in err chain
+// other errors are appended like with AddError
+func (b *ErrorBuilder) Merge(err error) *ErrorBuilder {
+	if err == nil {
+		return b
+	}
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		b.AddError(err)
+		return b
+	}
+
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	for _, e := range validationErr.Errors {
+		b.err.Append(validationErr.Kind, e)
+	}
+
+	return b
+}
+
+func (b *ErrorBuilder) Len() int {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	return len(b.err.Errors)
+}
+
+// Build
+// returns copy of aggregated error or nil if no errors were added
+func (b *ErrorBuilder) Build() *ValidationError {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	if len(b.err.Errors) == 0 {
+		return nil
+	}
+
+	errs := make([]Error, len(b.err.Errors))
+	copy(errs, b.err.Errors)
+
+	return &ValidationError{
+		Kind:   b.err.Kind,
+		Errors: errs,
+	}
+}
+
+// ErrorOrNil
+// like Build but returns error interface, for using in return statements
+func (b *ErrorBuilder) ErrorOrNil() error {
+	if err := b.Build(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ErrorEntry
+// entry in ErrorBuilder
+type ErrorEntry struct {
+	builder *ErrorBuilder
+	i       int
+}
+
+func (e *ErrorEntry) WithIndex(index int) *ErrorEntry {
+	return e.update(func(err *Error) {
+		err.Index = &index
+	})
+}
+
+func (e *ErrorEntry) WithLine(line int) *ErrorEntry {
+	return e.update(func(err *Error) {
+		err.Line = line
+	})
+}
+
+// WithSchemaIndex
+// set group, version and kind from schema index
+func (e *ErrorEntry) WithSchemaIndex(index SchemaIndex) *ErrorEntry {
+	return e.update(func(err *Error) {
+		err.Group, err.Version = index.GroupAndGroupVersion()
+		err.Kind = index.Kind
+	})
+}
+
+func (e *ErrorEntry) WithName(name string) *ErrorEntry {
+	return e.update(func(err *Error) {
+		err.Name = name
+	})
+}
+
+func (e *ErrorEntry) WithMessages(messages ...string) *ErrorEntry {
+	return e.update(func(err *Error) {
+		err.Messages = append(err.Messages, messages...)
+	})
+}
+
+func (e *ErrorEntry) update(f func(err *Error)) *ErrorEntry {
+	if e == nil {
+		return nil
+	}
+
+	e.builder.m.Lock()
+	defer e.builder.m.Unlock()
+
+	f(&e.builder.err.Errors[e.i])
+
+	return e
+}
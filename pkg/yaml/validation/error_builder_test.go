@@ -0,0 +1,118 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorBuilder(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		builder := NewErrorBuilder()
+		builder.AddError(nil)
+		builder.Merge(nil)
+
+		require.Nil(t, builder.Build())
+		require.NoError(t, builder.ErrorOrNil())
+		require.Equal(t, 0, builder.Len())
+	})
+
+	t.Run("entries", func(t *testing.T) {
+		builder := NewErrorBuilder()
+
+		builder.Add(ErrKindValidationFailed, "node group is invalid").
+			WithIndex(1).
+			WithSchemaIndex(SchemaIndex{Kind: "NodeGroup", Version: "deckhouse.io/v1"}).
+			WithName("worker").
+			WithMessages("replicas must be positive")
+
+		builder.AddError(fmt.Errorf("%w: kubernetes version is not supported", ErrDocumentValidationFailed))
+
+		err := builder.ErrorOrNil()
+		require.Error(t, err)
+		require.ErrorIs(t, err, ErrDocumentValidationFailed, "should use max kind")
+
+		expected := `DocumentValidationFailed: [1]deckhouse.io/v1, Kind=NodeGroup "worker": node group is invalid; replicas must be positive
+DocumentValidationFailed: kubernetes version is not supported`
+		require.Equal(t, expected, err.Error())
+	})
+
+	t.Run("document error", func(t *testing.T) {
+		builder := NewErrorBuilder()
+
+		doc := []byte(`
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: test
+`)
+		builder.AddDocumentError(3, doc, fmt.Errorf("%w: test", ErrSchemaNotFound)).WithLine(20)
+
+		validationErr := builder.Build()
+		require.NotNil(t, validationErr)
+		require.Equal(t, ErrSchemaNotFound, validationErr.Kind)
+		require.Len(t, validationErr.Errors, 1)
+		require.Equal(t, 3, *validationErr.Errors[0].Index)
+		require.Equal(t, 20, validationErr.Errors[0].Line)
+		require.Equal(t, "Namespace", validationErr.Errors[0].Kind)
+		require.Equal(t, "test", validationErr.Errors[0].Name)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		other := NewErrorBuilder()
+		other.Add(ErrKindInvalidYAML, "first").WithIndex(0)
+		other.Add(ErrKindInvalidYAML, "second").WithIndex(1)
+
+		builder := NewErrorBuilder()
+		builder.Merge(fmt.Errorf("wrapped: %w", other.Build()))
+		builder.Merge(errors.New("plain error"))
+
+		validationErr := builder.Build()
+		require.Len(t, validationErr.Errors, 3)
+		require.Equal(t, ErrUnknown, validationErr.Kind)
+		require.Equal(t, []string{"first"}, validationErr.Errors[0].Messages)
+		require.Equal(t, []string{"plain error"}, validationErr.Errors[2].Messages)
+	})
+
+	t.Run("build returns copy", func(t *testing.T) {
+		builder := NewErrorBuilder()
+		builder.Add(ErrRead, "first")
+
+		built := builder.Build()
+		builder.Add(ErrRead, "second")
+
+		require.Len(t, built.Errors, 1)
+		require.Len(t, builder.Build().Errors, 2)
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		builder := NewErrorBuilder()
+
+		wg := sync.WaitGroup{}
+		for i := range 50 {
+			wg.Go(func() {
+				builder.Add(ErrRead, "error").WithIndex(i)
+			})
+		}
+		wg.Wait()
+
+		require.Equal(t, 50, builder.Len())
+	})
+}
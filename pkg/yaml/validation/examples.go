@@ -0,0 +1,189 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	xExamplesExtension = "x-examples"
+	itemsPathSuffix    = "[]"
+)
+
+// SchemaExamples
+// returns examples from x-examples extension and example keyword of schema
+// x-examples can be list of examples or one example
+func SchemaExamples(schema *spec.Schema) []any {
+	if schema == nil {
+		return nil
+	}
+
+	res := make([]any, 0)
+
+	if raw, ok := schema.Extensions[xExamplesExtension]; ok && raw != nil {
+		if list, ok := raw.([]any); ok {
+			res = append(res, list...)
+		} else {
+			res = append(res, raw)
+		}
+	}
+
+	if schema.Example != nil {
+		res = append(res, schema.Example)
+	}
+
+	if len(res) == 0 {
+		return nil
+	}
+
+	return res
+}
+
+// ExamplesFor
+// returns examples for field in schema for index
+// fieldPath is dot separated fields, list items are marked with [] suffix,
+// for example "sshAgentPrivateKeys[].key". Empty fieldPath returns examples for whole document
+// returns ErrSchemaNotFound if schema or field not found
+func (v *Validator) ExamplesFor(index SchemaIndex, fieldPath string) ([]any, error) {
+	schema := v.getSchemaWithFallback(&index)
+	if schema == nil {
+		return nil, fmt.Errorf("%w: %s", ErrSchemaNotFound, index.String())
+	}
+
+	fieldSchema := schemaForField(schema, fieldPath)
+	if fieldSchema == nil {
+		return nil, fmt.Errorf("%w: field %q not found in schema for %s", ErrSchemaNotFound, fieldPath, index.String())
+	}
+
+	return SchemaExamples(fieldSchema), nil
+}
+
+// ValidateExamples
+// self-check for loaded schemas: validates every document example against its schema
+// with applied transformers and every field example against field schema
+// returns *ValidationError with all broken examples
+func (v *Validator) ValidateExamples() error {
+	errs := NewErrorBuilder()
+
+	for _, index := range v.schemasIndexes() {
+		schema := v.Get(&index)
+
+		for i, example := range SchemaExamples(schema) {
+			doc, err := yaml.Marshal(example)
+			if err == nil {
+				err = v.ValidateWithIndex(&index, &doc, ValidateWithNoPrettyError(true))
+			}
+
+			if err != nil {
+				errs.Add(ExtractValidationError(err), fmt.Sprintf("example #%d: %v", i+1, err)).
+					WithSchemaIndex(index)
+			}
+		}
+
+		walkSchemaFields("", schema, func(path string, fieldSchema *spec.Schema) {
+			for i, example := range SchemaExamples(fieldSchema) {
+				result := validate.NewSchemaValidator(fieldSchema, nil, path, strfmt.Default).Validate(example)
+				for _, err := range result.Errors {
+					errs.Add(ErrDocumentValidationFailed, fmt.Sprintf("example #%d for %s: %v", i+1, path, err)).
+						WithSchemaIndex(index)
+				}
+			}
+		})
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func (v *Validator) schemasIndexes() []SchemaIndex {
+	v.schemasMutex.RLock()
+	defer v.schemasMutex.RUnlock()
+
+	indexes := make([]SchemaIndex, 0, len(v.schemas))
+	for index := range v.schemas {
+		indexes = append(indexes, index)
+	}
+
+	slices.SortFunc(indexes, func(a, b SchemaIndex) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Version, b.Version))
+	})
+
+	return indexes
+}
+
+func schemaForField(schema *spec.Schema, fieldPath string) *spec.Schema {
+	if fieldPath == "" {
+		return schema
+	}
+
+	current := schema
+
+	for _, segment := range strings.Split(fieldPath, ".") {
+		field, isItems := strings.CutSuffix(segment, itemsPathSuffix)
+
+		current = propertySchema(current, field)
+		if current == nil {
+			return nil
+		}
+
+		if !isItems {
+			continue
+		}
+
+		if current.Items == nil || current.Items.Schema == nil {
+			return nil
+		}
+
+		current = current.Items.Schema
+	}
+
+	return current
+}
+
+// walkSchemaFields
+// calls f for every property and items schema with path in ExamplesFor format
+func walkSchemaFields(path string, schema *spec.Schema, f func(path string, schema *spec.Schema)) {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		prop := schema.Properties[name]
+		propPath := name
+		if path != "" {
+			propPath = path + "." + name
+		}
+
+		f(propPath, &prop)
+		walkSchemaFields(propPath, &prop, f)
+
+		if prop.Items != nil && prop.Items.Schema != nil {
+			itemsPath := propPath + itemsPathSuffix
+			f(itemsPath, prop.Items.Schema)
+			walkSchemaFields(itemsPath, prop.Items.Schema, f)
+		}
+	}
+}
@@ -15,12 +15,18 @@
 package validation
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/go-openapi/spec"
+	"github.com/hashicorp/go-multierror"
+	"github.com/name212/govalue"
 	"sigs.k8s.io/yaml"
+
+	"github.com/deckhouse/lib-dhctl/pkg/log"
 )
 
 const (
@@ -35,22 +41,58 @@ type (
 	ExtensionsValidatorHandler func(oldValue json.RawMessage) error
 )
 
+// ExtensionsRuleParams
+// passed to ExtensionsRuleHandler
+// Path is full path to field like sshAgentPrivateKeys[2].passphrase, empty for document root
+// Schema is schema of the field which contains rule
+type ExtensionsRuleParams struct {
+	Path   string
+	Schema *spec.Schema
+	Logger log.Logger
+}
+
+// ExtensionsRuleHandler
+// value is empty if field is absent in document
+type ExtensionsRuleHandler interface {
+	Validate(ctx context.Context, value json.RawMessage, params ExtensionsRuleParams) error
+}
+
+// Validate
+// adapter ExtensionsValidatorHandler to ExtensionsRuleHandler
+func (h ExtensionsValidatorHandler) Validate(_ context.Context, value json.RawMessage, _ ExtensionsRuleParams) error {
+	return h(value)
+}
+
 type ExtensionsValidator struct {
 	name       string
-	validators map[string]ExtensionsValidatorHandler
+	validators map[string]ExtensionsRuleHandler
 }
 
 func NewExtensionsValidator(extensionName string, validators map[string]ExtensionsValidatorHandler) *ExtensionsValidator {
-	if len(validators) == 0 {
-		validators = make(map[string]ExtensionsValidatorHandler)
+	handlers := make(map[string]ExtensionsRuleHandler, len(validators))
+	for rule, validator := range validators {
+		handlers[rule] = validator
+	}
+
+	return NewExtensionsValidatorWithHandlers(extensionName, handlers)
+}
+
+func NewExtensionsValidatorWithHandlers(extensionName string, handlers map[string]ExtensionsRuleHandler) *ExtensionsValidator {
+	if len(handlers) == 0 {
+		handlers = make(map[string]ExtensionsRuleHandler)
 	}
 
 	return &ExtensionsValidator{
 		name:       extensionName,
-		validators: validators,
+		validators: handlers,
 	}
 }
 
+func (v *ExtensionsValidator) AddRuleHandler(rule string, handler ExtensionsRuleHandler) *ExtensionsValidator {
+	v.validators[rule] = handler
+	return v
+}
+
 func NewXRulesExtensionsValidator(validators map[string]ExtensionsValidatorHandler) *ExtensionsValidator {
 	return NewExtensionsValidator(xRulesExtension, validators)
 }
@@ -60,82 +102,140 @@ func (v *ExtensionsValidator) ExtensionName() string {
 }
 
 func (v *ExtensionsValidator) Validate(data json.RawMessage, schema spec.Schema) error {
-	if schema.Properties == nil {
+	return v.ValidateWithContext(context.Background(), nil, data, schema)
+}
+
+// ValidateWithContext
+// validate data like Validate but pass ctx and logger to rules handlers
+// if logger is nil, silent logger will be passed
+// all failed rules are returned as *multierror.Error with *ExtensionRuleError errors
+func (v *ExtensionsValidator) ValidateWithContext(ctx context.Context, logger log.Logger, data json.RawMessage, schema spec.Schema) error {
+	if govalue.IsNil(logger) {
+		logger = log.NewSilentLogger()
+	}
+
+	errs := v.validate(ctx, logger, "", data, &schema)
+	if len(errs) == 0 {
 		return nil
 	}
 
-	var properties map[string]json.RawMessage
+	return multierror.Append(nil, errs...)
+}
 
-	err := yaml.Unmarshal(data, &properties)
-	if err != nil {
-		return err
-	}
+func (v *ExtensionsValidator) validate(ctx context.Context, logger log.Logger, path string, data json.RawMessage, schema *spec.Schema) []error {
+	// rules are called with empty data for absent fields, for example for required-style rules
+	errs := v.validateRules(ctx, logger, path, data, schema)
 
-	err = v.validateData(data, schema)
-	if err != nil {
-		return err
+	if len(schema.Properties) > 0 {
+		var properties map[string]json.RawMessage
+		// rules of fields of absent object are called with empty data also
+		if len(data) > 0 {
+			if err := yaml.Unmarshal(data, &properties); err != nil {
+				return append(errs, newExtensionPathError(path, err))
+			}
+		}
+
+		fields := make([]string, 0, len(schema.Properties))
+		for field := range schema.Properties {
+			fields = append(fields, field)
+		}
+		// sort for stable errors
+		slices.Sort(fields)
+
+		for _, field := range fields {
+			fieldSchema := schema.Properties[field]
+			errs = append(errs, v.validate(ctx, logger, fieldPath(path, field), properties[field], &fieldSchema)...)
+		}
 	}
 
-	for field, fieldSchema := range schema.Properties {
-		err = v.validateData(properties[field], fieldSchema)
-		if err != nil {
-			return fmt.Errorf("%s: %w", field, err)
+	// avoid validation exception by validation empty data
+	if len(data) > 0 && schema.Items != nil && schema.Items.Schema != nil {
+		var items []json.RawMessage
+		if err := yaml.Unmarshal(data, &items); err != nil {
+			return append(errs, newExtensionPathError(path, err))
 		}
 
-		err = v.Validate(properties[field], fieldSchema)
-		if err != nil {
-			return fmt.Errorf("%s: %w", field, err)
+		for i, item := range items {
+			errs = append(errs, v.validate(ctx, logger, itemPath(path, i), item, schema.Items.Schema)...)
 		}
 	}
 
-	return nil
+	return errs
 }
 
-func (v *ExtensionsValidator) validateData(data json.RawMessage, schema spec.Schema) error {
-	if rules, ok := schema.Extensions.GetStringSlice(v.name); ok {
-		for _, rule := range rules {
-			validator, ok := v.validators[rule]
-			if !ok {
-				continue
-			}
+func (v *ExtensionsValidator) validateRules(ctx context.Context, logger log.Logger, path string, data json.RawMessage, schema *spec.Schema) []error {
+	rules, ok := schema.Extensions.GetStringSlice(v.name)
+	if !ok {
+		return nil
+	}
 
-			err := validator(data)
-			if err != nil {
-				return err
-			}
+	errs := make([]error, 0)
+
+	for _, rule := range rules {
+		validator, ok := v.validators[rule]
+		if !ok || govalue.IsNil(validator) {
+			continue
 		}
-	}
 
-	if schema.SchemaProps.Items != nil && schema.SchemaProps.Items.Schema != nil {
-		if rules, ok := schema.SchemaProps.Items.Schema.Extensions.GetStringSlice(v.name); ok {
-			for _, rule := range rules {
-				validator, ok := v.validators[rule]
-				if !ok {
-					continue
-				}
-
-				// avoid validation exception by validation empty data
-				if len(data) == 0 {
-					return nil
-				}
-
-				var items []json.RawMessage
-				err := json.Unmarshal(data, &items)
-				if err != nil {
-					return err
-				}
-
-				for _, item := range items {
-					err = validator(item)
-					if err != nil {
-						return err
-					}
-				}
-			}
+		params := ExtensionsRuleParams{
+			Path:   path,
+			Schema: schema,
+			Logger: logger,
 		}
+
+		if err := validator.Validate(ctx, data, params); err != nil {
+			errs = append(errs, &ExtensionRuleError{
+				Path: path,
+				Rule: rule,
+				Err:  err,
+			})
+		}
+	}
+
+	return errs
+}
+
+// ExtensionRuleError
+// returned from ExtensionsValidator.Validate when rule failed
+// Path is full path to field like sshAgentPrivateKeys[2].passphrase
+// Path is empty for document root
+type ExtensionRuleError struct {
+	Path string
+	Rule string
+	Err  error
+}
+
+func (e *ExtensionRuleError) Error() string {
+	path := pathOrRoot(e.Path)
+
+	if e.Rule == "" {
+		return fmt.Sprintf("%s: %v", path, e.Err)
 	}
 
-	return nil
+	return fmt.Sprintf("%s: rule %q failed: %v", path, e.Rule, e.Err)
+}
+
+func (e *ExtensionRuleError) Unwrap() error {
+	return e.Err
+}
+
+func newExtensionPathError(path string, err error) error {
+	return &ExtensionRuleError{
+		Path: path,
+		Err:  err,
+	}
+}
+
+func fieldPath(parent, field string) string {
+	if parent == "" {
+		return field
+	}
+
+	return parent + "." + field
+}
+
+func itemPath(parent string, i int) string {
+	return fmt.Sprintf("%s[%d]", parent, i)
 }
 
 func NewExtensionsRuleError(msg string, err error) error {
@@ -0,0 +1,137 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// MutuallyExclusiveRule
+// returns handler for x-rules which fails if more than one of fields is set in object
+// for example:
+//
+//	NewXRulesExtensionsValidator(map[string]ExtensionsValidatorHandler{
+//		"sshAuth": MutuallyExclusiveRule("sshAgentPrivateKeys", "sudoPassword"),
+//	})
+//
+// and x-rules: [sshAuth] on object schema
+func MutuallyExclusiveRule(fields ...string) ExtensionsValidatorHandler {
+	return func(value json.RawMessage) error {
+		obj, err := unmarshalRuleObject(value)
+		if err != nil {
+			return err
+		}
+
+		set := presentFields(obj, fields)
+		if len(set) > 1 {
+			return fmt.Errorf(
+				"%w: fields [%s] are mutually exclusive, but set [%s]",
+				ErrValidationRuleFailed,
+				strings.Join(fields, ", "),
+				strings.Join(set, ", "),
+			)
+		}
+
+		return nil
+	}
+}
+
+// AtLeastOneOfRule
+// returns handler for x-rules which fails if none of fields is set in object
+func AtLeastOneOfRule(fields ...string) ExtensionsValidatorHandler {
+	return func(value json.RawMessage) error {
+		obj, err := unmarshalRuleObject(value)
+		if err != nil {
+			return err
+		}
+
+		if len(presentFields(obj, fields)) == 0 {
+			return fmt.Errorf("%w: at least one of fields [%s] should be set", ErrValidationRuleFailed, strings.Join(fields, ", "))
+		}
+
+		return nil
+	}
+}
+
+// LessThanRule
+// returns handler for x-rules which fails if numeric field lesser is not less than numeric field greater
+// rule is skipped if any of fields is not set
+func LessThanRule(lesser, greater string) ExtensionsValidatorHandler {
+	return numericPairRule(lesser, greater, "less than", func(a, b float64) bool {
+		return a < b
+	})
+}
+
+// GreaterThanRule
+// returns handler for x-rules which fails if numeric field greater is not greater than numeric field lesser
+// rule is skipped if any of fields is not set
+func GreaterThanRule(greater, lesser string) ExtensionsValidatorHandler {
+	return numericPairRule(greater, lesser, "greater than", func(a, b float64) bool {
+		return a > b
+	})
+}
+
+func numericPairRule(first, second, relation string, check func(a, b float64) bool) ExtensionsValidatorHandler {
+	return func(value json.RawMessage) error {
+		obj, err := unmarshalRuleObject(value)
+		if err != nil {
+			return err
+		}
+
+		if len(presentFields(obj, []string{first, second})) < 2 {
+			return nil
+		}
+
+		a, ok := obj[first].(float64)
+		if !ok {
+			return fmt.Errorf("%w: field %s should be number", ErrValidationRuleFailed, first)
+		}
+
+		b, ok := obj[second].(float64)
+		if !ok {
+			return fmt.Errorf("%w: field %s should be number", ErrValidationRuleFailed, second)
+		}
+
+		if !check(a, b) {
+			return fmt.Errorf("%w: %s (%v) should be %s %s (%v)", ErrValidationRuleFailed, first, a, relation, second, b)
+		}
+
+		return nil
+	}
+}
+
+func unmarshalRuleObject(value json.RawMessage) (map[string]any, error) {
+	var obj map[string]any
+	if err := yaml.Unmarshal(value, &obj); err != nil {
+		return nil, fmt.Errorf("%w: value should be object: %w", ErrValidationRuleFailed, err)
+	}
+
+	return obj, nil
+}
+
+func presentFields(obj map[string]any, fields []string) []string {
+	res := make([]string, 0)
+	for _, field := range fields {
+		if v, ok := obj[field]; ok && v != nil {
+			res = append(res, field)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuiltinExtensionsRules(t *testing.T) {
+	const schemas = `
+kind: RulesKind
+apiVersions:
+- apiVersion: deckhouse.io/v1
+  openAPISpec:
+    type: object
+    x-rules: [auth, target]
+    properties:
+      apiVersion:
+        type: string
+      kind:
+        type: string
+      password:
+        type: string
+      privateKey:
+        type: string
+      host:
+        type: string
+      hosts:
+        type: array
+        items:
+          type: string
+      pool:
+        type: object
+        x-rules: [minLessMax, maxGreaterMin]
+        properties:
+          min:
+            type: integer
+          max:
+            type: integer
+`
+
+	validator := NewValidator(nil).SetLogger(testGetLogger()).
+		AddExtensionsValidators(NewXRulesExtensionsValidator(map[string]ExtensionsValidatorHandler{
+			"auth":          MutuallyExclusiveRule("password", "privateKey"),
+			"target":        AtLeastOneOfRule("host", "hosts"),
+			"minLessMax":    LessThanRule("min", "max"),
+			"maxGreaterMin": GreaterThanRule("max", "min"),
+		}))
+
+	err := validator.LoadSchemas(strings.NewReader(schemas))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		doc  string
+		err  string
+	}{
+		{
+			name: "valid",
+			doc: `
+password: secret
+host: 127.0.0.1
+pool:
+  min: 1
+  max: 3
+`,
+		},
+		{
+			name: "pair is skipped if field is not set",
+			doc: `
+hosts: [127.0.0.1]
+pool:
+  min: 5
+`,
+		},
+		{
+			name: "mutually exclusive",
+			doc: `
+password: secret
+privateKey: key
+host: 127.0.0.1
+`,
+			err: `<root>: rule "auth" failed: validation rule failed: fields [password, privateKey] are mutually exclusive, but set [password, privateKey]`,
+		},
+		{
+			name: "at least one of",
+			doc: `
+password: secret
+`,
+			err: `<root>: rule "target" failed: validation rule failed: at least one of fields [host, hosts] should be set`,
+		},
+		{
+			name: "less than",
+			doc: `
+host: 127.0.0.1
+pool:
+  min: 3
+  max: 3
+`,
+			err: `pool: rule "minLessMax" failed: validation rule failed: min (3) should be less than max (3)`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			doc := []byte("apiVersion: deckhouse.io/v1\nkind: RulesKind\n" + test.doc)
+			_, err := validator.Validate(&doc, ValidateWithNoPrettyError(true))
+
+			if test.err == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, ErrDocumentValidationFailed)
+			require.ErrorIs(t, err, ErrValidationRuleFailed)
+			require.Contains(t, err.Error(), test.err)
+		})
+	}
+
+	t.Run("greater than", func(t *testing.T) {
+		err := GreaterThanRule("max", "min")([]byte(`{"min": 3, "max": 1}`))
+		require.ErrorIs(t, err, ErrValidationRuleFailed)
+		require.Contains(t, err.Error(), "max (1) should be greater than min (3)")
+
+		err = GreaterThanRule("max", "min")([]byte(`{"min": 3, "max": "a"}`))
+		require.ErrorContains(t, err, "field max should be number")
+	})
+}
@@ -0,0 +1,246 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/deckhouse/lib-dhctl/pkg/log"
+)
+
+func TestExtensionsValidatorPaths(t *testing.T) {
+	const schema = `
+kind: PathKind
+apiVersions:
+- apiVersion: test
+  openAPISpec:
+    type: object
+    properties:
+      hosts:
+        type: array
+        items:
+          type: object
+          x-rules: [notEmpty]
+          properties:
+            name:
+              type: string
+            passphrase:
+              type: string
+              x-rules: [notBad]
+`
+	schemas, err := LoadSchemas(strings.NewReader(schema))
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+
+	errBad := errors.New("bad value")
+
+	validator := NewXRulesExtensionsValidator(map[string]ExtensionsValidatorHandler{
+		"notEmpty": func(value json.RawMessage) error {
+			if string(value) == "{}" {
+				return errors.New("empty host")
+			}
+			return nil
+		},
+		"notBad": func(value json.RawMessage) error {
+			if string(value) == `"bad"` {
+				return errBad
+			}
+			return nil
+		},
+	})
+
+	tests := []struct {
+		name         string
+		doc          string
+		expectedPath string
+		expectedRule string
+	}{
+		{
+			name: "valid",
+			doc: `
+hosts:
+- name: first
+  passphrase: good
+- name: second
+`,
+		},
+		{
+			name: "nested field in array",
+			doc: `
+hosts:
+- name: first
+  passphrase: good
+- name: second
+- name: third
+  passphrase: bad
+`,
+			expectedPath: "hosts[2].passphrase",
+			expectedRule: "notBad",
+		},
+		{
+			name: "array item",
+			doc: `
+hosts:
+- name: first
+- {}
+`,
+			expectedPath: "hosts[1]",
+			expectedRule: "notEmpty",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validator.Validate([]byte(test.doc), *schemas[0].Schema)
+			if test.expectedPath == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+
+			var ruleErr *ExtensionRuleError
+			require.ErrorAs(t, err, &ruleErr)
+			require.Equal(t, test.expectedPath, ruleErr.Path)
+			require.Equal(t, test.expectedRule, ruleErr.Rule)
+			require.Contains(t, err.Error(), test.expectedPath)
+		})
+	}
+
+	t.Run("unwrap handler error", func(t *testing.T) {
+		err := validator.Validate([]byte("hosts: [{passphrase: bad}]"), *schemas[0].Schema)
+		require.ErrorIs(t, err, errBad)
+	})
+}
+
+type testCtxKey struct{}
+
+type testRuleHandler struct {
+	calls     []ExtensionsRuleParams
+	ctxValues []any
+}
+
+func (h *testRuleHandler) Validate(ctx context.Context, value json.RawMessage, params ExtensionsRuleParams) error {
+	h.calls = append(h.calls, params)
+	h.ctxValues = append(h.ctxValues, ctx.Value(testCtxKey{}))
+	params.Logger.DebugF("validate %s: %s", params.Path, string(value))
+
+	if string(value) == `"bad"` {
+		return errors.New("bad value")
+	}
+
+	return nil
+}
+
+func TestExtensionsValidatorRuleHandler(t *testing.T) {
+	const schema = `
+kind: HandlerKind
+apiVersions:
+- apiVersion: test
+  openAPISpec:
+    type: object
+    properties:
+      registry:
+        type: object
+        properties:
+          auth:
+            type: string
+            description: Registry auth.
+            x-rules: [registryAuth]
+`
+	schemas, err := LoadSchemas(strings.NewReader(schema))
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+
+	handler := &testRuleHandler{}
+	validator := NewXRulesExtensionsValidator(nil).AddRuleHandler("registryAuth", handler)
+
+	logger := log.NewInMemoryLogger()
+	ctx := context.WithValue(context.Background(), testCtxKey{}, "value")
+
+	err = validator.ValidateWithContext(ctx, logger, []byte("registry: {auth: good}"), *schemas[0].Schema)
+	require.NoError(t, err)
+
+	require.Len(t, handler.calls, 1)
+	require.Equal(t, "registry.auth", handler.calls[0].Path)
+	require.Equal(t, "Registry auth.", handler.calls[0].Schema.Description)
+	require.Equal(t, "value", handler.ctxValues[0])
+
+	match, err := logger.FirstMatch(&log.Match{Prefix: []string{"validate registry.auth"}})
+	require.NoError(t, err)
+	require.NotEmpty(t, match, "handler should log with passed logger")
+
+	err = validator.Validate([]byte("registry: {auth: bad}"), *schemas[0].Schema)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `registry.auth: rule "registryAuth" failed`)
+}
+
+func TestExtensionsValidatorAbsentField(t *testing.T) {
+	const schema = `
+kind: AbsentKind
+apiVersions:
+- apiVersion: test
+  openAPISpec:
+    type: object
+    properties:
+      registry:
+        type: object
+        properties:
+          auth:
+            type: string
+            x-rules: [requiredAuth]
+      hosts:
+        type: array
+        items:
+          type: string
+          x-rules: [requiredAuth]
+`
+	schemas, err := LoadSchemas(strings.NewReader(schema))
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+
+	calls := 0
+	validator := NewXRulesExtensionsValidator(map[string]ExtensionsValidatorHandler{
+		"requiredAuth": func(value json.RawMessage) error {
+			calls++
+			if len(value) == 0 {
+				return errors.New("auth is required")
+			}
+			return nil
+		},
+	})
+
+	for _, doc := range []string{"registry: {}", "hosts: []", "{}"} {
+		calls = 0
+
+		err := validator.Validate([]byte(doc), *schemas[0].Schema)
+		require.Error(t, err, doc)
+
+		var ruleErr *ExtensionRuleError
+		require.ErrorAs(t, err, &ruleErr, doc)
+		require.Equal(t, "registry.auth", ruleErr.Path, doc)
+		// rules of items of absent or empty array are not called
+		require.Equal(t, 1, calls, doc)
+	}
+
+	err = validator.Validate([]byte("registry: {auth: token}"), *schemas[0].Schema)
+	require.NoError(t, err)
+}
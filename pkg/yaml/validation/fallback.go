@@ -0,0 +1,189 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/go-openapi/spec"
+)
+
+const (
+	fallbackGroupWildcard = "*"
+
+	// AnyVersion
+	// register schema with SchemaIndex{Kind: "X", Version: AnyVersion} for validate all versions of kind
+	// or with Version "group/*" (see GroupAnyVersion) for validate all versions of kind in group
+	// exact matches and version fallbacks win over wildcard schemas
+	AnyVersion = "*"
+)
+
+// GroupAnyVersion
+// returns version for registering schema for all versions of kind in group
+// like deckhouse.io/*
+func GroupAnyVersion(group string) string {
+	return group + "/" + AnyVersion
+}
+
+// VersionFallbackFunc
+// returns index for next lookup or nil if function cannot resolve fallback
+type VersionFallbackFunc func(index SchemaIndex) *SchemaIndex
+
+// getSchemaWithFallback
+// follows fallbacks chain until schema found
+// if schema found by fallback, index will be changed to found index
+// if no schema found in fallbacks chain, looks up schema registered for any version
+// of group and then for any version of kind. version in index is not changed in this case
+// if best version negotiation enabled, looks up the highest registered version of group and kind
+// and changes index to it
+// if still no schema found, repeats lookup for kind alias and changes kind in index to matched
+func (v *Validator) getSchemaWithFallback(index *SchemaIndex) *spec.Schema {
+	current := *index
+	visitedKinds := make(map[string]struct{})
+
+	for {
+		if schema := v.followFallbacks(&current); schema != nil {
+			*index = current
+			return schema
+		}
+
+		if schema := v.getAnyVersionSchema(current); schema != nil {
+			*index = current
+			return schema
+		}
+
+		if schema := v.getBestVersionSchema(&current); schema != nil {
+			*index = current
+			return schema
+		}
+
+		visitedKinds[current.Kind] = struct{}{}
+
+		alias, ok := v.kindAliases[current.Kind]
+		if !ok || alias == "" {
+			return nil
+		}
+
+		if _, ok := visitedKinds[alias]; ok {
+			v.logger().DebugF("Kind alias cycle found for %s", alias)
+			return nil
+		}
+
+		v.logger().DebugF("Kind alias %s to %s", current.Kind, alias)
+
+		current.Kind = alias
+	}
+}
+
+func (v *Validator) getAnyVersionSchema(index SchemaIndex) *spec.Schema {
+	candidates := make([]SchemaIndex, 0, 2)
+
+	if group := index.Group(); group != "" && !strings.HasPrefix(group, InvalidGroupPrefix) {
+		candidates = append(candidates, SchemaIndex{Kind: index.Kind, Version: GroupAnyVersion(group)})
+	}
+
+	candidates = append(candidates, SchemaIndex{Kind: index.Kind, Version: AnyVersion})
+
+	for _, candidate := range candidates {
+		if schema := v.Get(&candidate); schema != nil {
+			v.logger().DebugF("Use schema %s for %s", candidate.String(), index.String())
+			return schema
+		}
+	}
+
+	return nil
+}
+
+func (v *Validator) followFallbacks(index *SchemaIndex) *spec.Schema {
+	current := *index
+	visited := make(map[SchemaIndex]struct{})
+
+	for {
+		if schema := v.Get(&current); schema != nil {
+			*index = current
+			return schema
+		}
+
+		visited[current] = struct{}{}
+
+		next := v.nextFallback(current)
+		if next == nil {
+			v.logger().DebugF("No fallback schema for version %s", current.Version)
+			return nil
+		}
+
+		if _, ok := visited[*next]; ok {
+			v.logger().DebugF("Fallback cycle found for %s", next.String())
+			return nil
+		}
+
+		v.logger().DebugF("Fallback %s to %s", current.String(), next.String())
+
+		current = *next
+	}
+}
+
+func (v *Validator) nextFallback(index SchemaIndex) *SchemaIndex {
+	if fallback, ok := v.versionFallbacks[index.Version]; ok && fallback != "" {
+		return &SchemaIndex{Kind: index.Kind, Version: fallback}
+	}
+
+	if next := v.wildcardFallback(index); next != nil {
+		return next
+	}
+
+	for _, f := range v.versionFallbackFuncs {
+		if next := f(index); next != nil && next.IsValid() {
+			return next
+		}
+	}
+
+	return nil
+}
+
+func (v *Validator) wildcardFallback(index SchemaIndex) *SchemaIndex {
+	patterns := make([]string, 0)
+	for pattern := range v.versionFallbacks {
+		if strings.Contains(pattern, fallbackGroupWildcard) {
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	// stable order for multiple matched patterns
+	slices.Sort(patterns)
+
+	group := index.Group()
+
+	for _, pattern := range patterns {
+		fallback := v.versionFallbacks[pattern]
+		if fallback == "" {
+			continue
+		}
+
+		matched, err := path.Match(pattern, index.Version)
+		if err != nil || !matched {
+			continue
+		}
+
+		return &SchemaIndex{
+			Kind:    index.Kind,
+			Version: strings.Replace(fallback, fallbackGroupWildcard, group, 1),
+		}
+	}
+
+	return nil
+}
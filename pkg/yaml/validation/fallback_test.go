@@ -0,0 +1,240 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionFallbacks(t *testing.T) {
+	getValidator := func(t *testing.T) *Validator {
+		validator := NewValidator(nil).SetLogger(testGetLogger())
+		err := validator.LoadSchemas(strings.NewReader(testSchemaTestKind))
+		require.NoError(t, err, "failed to load schema")
+		return validator
+	}
+
+	tests := []struct {
+		name          string
+		version       string
+		prepare       func(v *Validator)
+		shouldFound   bool
+		expectedIndex SchemaIndex
+	}{
+		{
+			name:    "default fallback",
+			version: "deckhouse.io/v1alpha1",
+			prepare: func(*Validator) {},
+
+			shouldFound:   true,
+			expectedIndex: indexTestKind,
+		},
+		{
+			name:    "chain",
+			version: "deckhouse.io/v1alpha2",
+			prepare: func(v *Validator) {
+				v.AddVersionFallback("deckhouse.io/v1alpha2", "deckhouse.io/v1beta1").
+					AddVersionFallback("deckhouse.io/v1beta1", "deckhouse.io/v1alpha1")
+			},
+
+			shouldFound:   true,
+			expectedIndex: indexTestKind,
+		},
+		{
+			name:    "cycle",
+			version: "deckhouse.io/v1alpha2",
+			prepare: func(v *Validator) {
+				v.AddVersionFallback("deckhouse.io/v1alpha2", "deckhouse.io/v1beta1").
+					AddVersionFallback("deckhouse.io/v1beta1", "deckhouse.io/v1alpha2")
+			},
+
+			shouldFound: false,
+		},
+		{
+			name:    "wildcard group",
+			version: "deckhouse.io/v1beta2",
+			prepare: func(v *Validator) {
+				v.AddVersionFallback("*/v1beta2", "*/v1")
+			},
+
+			shouldFound:   true,
+			expectedIndex: indexTestKind,
+		},
+		{
+			name:    "wildcard group does not match another group",
+			version: "another.io/v1beta2",
+			prepare: func(v *Validator) {
+				v.AddVersionFallback("*/v1beta2", "*/v1")
+			},
+
+			shouldFound: false,
+		},
+		{
+			name:    "func",
+			version: "v2",
+			prepare: func(v *Validator) {
+				v.AddVersionFallbackFunc(func(SchemaIndex) *SchemaIndex {
+					return nil
+				}).AddVersionFallbackFunc(func(index SchemaIndex) *SchemaIndex {
+					if index.Kind != indexTestKind.Kind {
+						return nil
+					}
+
+					return &SchemaIndex{Kind: index.Kind, Version: "deckhouse.io/v1"}
+				})
+			},
+
+			shouldFound:   true,
+			expectedIndex: indexTestKind,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			validator := getValidator(t)
+			test.prepare(validator)
+
+			index := SchemaIndex{Kind: indexTestKind.Kind, Version: test.version}
+			schema := validator.getSchemaWithFallback(&index)
+
+			if !test.shouldFound {
+				require.Nil(t, schema)
+				require.Equal(t, test.version, index.Version, "should not change index")
+				return
+			}
+
+			require.NotNil(t, schema)
+			require.Equal(t, test.expectedIndex, index)
+		})
+	}
+}
+
+func TestAnyVersionSchemas(t *testing.T) {
+	getValidator := func(t *testing.T) *Validator {
+		validator := NewValidator(nil).SetLogger(testGetLogger())
+		err := validator.LoadSchemas(strings.NewReader(testSchemaTestKind))
+		require.NoError(t, err, "failed to load schema")
+		return validator
+	}
+
+	exact := getValidator(t).Get(&indexTestKind)
+
+	groupSchema := &spec.Schema{}
+	groupSchema.Description = "group"
+
+	anySchema := &spec.Schema{}
+	anySchema.Description = "any"
+
+	validator := getValidator(t).
+		AddSchema(SchemaIndex{Kind: indexTestKind.Kind, Version: GroupAnyVersion("deckhouse.io")}, groupSchema).
+		AddSchema(SchemaIndex{Kind: indexTestKind.Kind, Version: AnyVersion}, anySchema)
+
+	tests := []struct {
+		version     string
+		description string
+	}{
+		{version: "deckhouse.io/v1", description: exact.Description},
+		{version: "deckhouse.io/v1alpha1", description: exact.Description},
+		{version: "deckhouse.io/v2", description: "group"},
+		{version: "another.io/v1", description: "any"},
+		{version: "v1", description: "any"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			index := SchemaIndex{Kind: indexTestKind.Kind, Version: test.version}
+			schema := validator.getSchemaWithFallback(&index)
+
+			require.NotNil(t, schema)
+			require.Equal(t, test.description, schema.Description)
+			require.NotEqual(t, AnyVersion, index.Version)
+		})
+	}
+
+	t.Run("another kind", func(t *testing.T) {
+		index := SchemaIndex{Kind: "AnotherKind", Version: "deckhouse.io/v2"}
+		require.Nil(t, validator.getSchemaWithFallback(&index))
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		validator := NewValidator(nil).SetLogger(testGetLogger()).
+			AddSchema(SchemaIndex{Kind: indexTestKind.Kind, Version: AnyVersion}, exact)
+
+		doc := []byte(`
+apiVersion: deckhouse.io/v1beta3
+kind: TestKind
+sshUser: ubuntu
+sudoPassword: "no secret"
+`)
+		index, err := validator.Validate(&doc)
+		require.NoError(t, err)
+		require.Equal(t, "deckhouse.io/v1beta3", index.Version)
+	})
+}
+
+func TestKindAliases(t *testing.T) {
+	getValidator := func(t *testing.T) *Validator {
+		validator := NewValidator(nil).SetLogger(testGetLogger())
+		err := validator.LoadSchemas(strings.NewReader(testSchemaTestKind))
+		require.NoError(t, err, "failed to load schema")
+		return validator
+	}
+
+	t.Run("alias with version fallback", func(t *testing.T) {
+		validator := getValidator(t).AddKindAlias("OldTestKind", indexTestKind.Kind)
+
+		index := SchemaIndex{Kind: "OldTestKind", Version: "deckhouse.io/v1alpha1"}
+		require.NotNil(t, validator.getSchemaWithFallback(&index))
+		require.Equal(t, indexTestKind, index)
+	})
+
+	t.Run("chain", func(t *testing.T) {
+		validator := getValidator(t).
+			AddKindAlias("OldestTestKind", "OldTestKind").
+			AddKindAlias("OldTestKind", indexTestKind.Kind)
+
+		doc := []byte(`
+apiVersion: deckhouse.io/v1
+kind: OldestTestKind
+sshUser: ubuntu
+sudoPassword: "no secret"
+`)
+		index, err := validator.Validate(&doc)
+		require.NoError(t, err)
+		require.Equal(t, indexTestKind, *index)
+	})
+
+	t.Run("exact kind wins", func(t *testing.T) {
+		validator := getValidator(t).AddKindAlias(indexTestKind.Kind, "AnotherKind")
+
+		index := indexTestKind
+		require.NotNil(t, validator.getSchemaWithFallback(&index))
+		require.Equal(t, indexTestKind, index)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		validator := getValidator(t).
+			AddKindAlias("A", "B").
+			AddKindAlias("B", "A")
+
+		index := SchemaIndex{Kind: "A", Version: "deckhouse.io/v1"}
+		require.Nil(t, validator.getSchemaWithFallback(&index))
+		require.Equal(t, "A", index.Kind, "should not change index")
+	})
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	libyaml "github.com/deckhouse/lib-dhctl/pkg/yaml"
+)
+
+type DocumentFormat int
+
+const (
+	DocumentFormatYAML DocumentFormat = iota + 1
+	DocumentFormatJSON
+)
+
+func (f DocumentFormat) String() string {
+	switch f {
+	case DocumentFormatYAML:
+		return "YAML"
+	case DocumentFormatJSON:
+		return "JSON"
+	default:
+		return unknownErrString
+	}
+}
+
+// DetectDocumentFormat
+// returns DocumentFormatJSON if doc is valid JSON object or array
+// otherwise returns DocumentFormatYAML
+func DetectDocumentFormat(doc []byte) DocumentFormat {
+	trimmed := bytes.TrimSpace(doc)
+	if len(trimmed) == 0 {
+		return DocumentFormatYAML
+	}
+
+	if (trimmed[0] == '{' || trimmed[0] == '[') && json.Valid(trimmed) {
+		return DocumentFormatJSON
+	}
+
+	return DocumentFormatYAML
+}
+
+// ValidateWithPreserveFormat
+// by default validated document with applied defaults is returned as JSON for any input,
+// with this option YAML input is returned as YAML. JSON input is always returned as JSON
+func ValidateWithPreserveFormat(v bool) ValidateOption {
+	return func(o *validateOptions) {
+		o.preserveFormat = v
+	}
+}
+
+// ValidateWithPreserveNumbers
+// by default numbers are converted to float64 during validation, so 1.20 is returned as 1.2
+// and integers out of float64 precision are changed.
+// with this option numbers literals are kept as is in returned document
+func ValidateWithPreserveNumbers(v bool) ValidateOption {
+	return func(o *validateOptions) {
+		o.preserveNumbers = v
+	}
+}
+
+// unmarshalDocument
+// JSON documents are unmarshalled without converting to YAML
+// in strict mode JSON unmarshalled as YAML also, because encoding/json does not detect duplicated keys
+func unmarshalDocument(doc []byte, format DocumentFormat, options *validateOptions) (map[string]any, error) {
+	var out map[string]any
+
+	if options.preserveNumbers {
+		return unmarshalDocumentPreservingNumbers(doc, format, options.strictUnmarshal)
+	}
+
+	switch {
+	case options.strictUnmarshal:
+		if err := yaml.UnmarshalStrict(doc, &out); err != nil {
+			return nil, fmt.Errorf("yaml unmarshal strict: %w", err)
+		}
+	case format == DocumentFormatJSON:
+		if err := json.Unmarshal(doc, &out); err != nil {
+			return nil, fmt.Errorf("json unmarshal: %w", err)
+		}
+	default:
+		if err := yaml.Unmarshal(doc, &out); err != nil {
+			return nil, fmt.Errorf("yaml unmarshal: %w", err)
+		}
+	}
+
+	return out, nil
+}
+
+// unmarshalDocumentPreservingNumbers
+// numbers are unmarshalled as json.Number with original literals
+func unmarshalDocumentPreservingNumbers(doc []byte, format DocumentFormat, strict bool) (map[string]any, error) {
+	if strict {
+		// only for detecting duplicated and unknown keys
+		var out map[string]any
+		if err := yaml.UnmarshalStrict(doc, &out); err != nil {
+			return nil, fmt.Errorf("yaml unmarshal strict: %w", err)
+		}
+	}
+
+	if format == DocumentFormatJSON {
+		var out map[string]any
+
+		decoder := json.NewDecoder(bytes.NewReader(doc))
+		decoder.UseNumber()
+
+		if err := decoder.Decode(&out); err != nil {
+			return nil, fmt.Errorf("json unmarshal: %w", err)
+		}
+
+		return out, nil
+	}
+
+	value, err := libyaml.UnmarshalPreservingNumbers(doc)
+	if err != nil {
+		return nil, fmt.Errorf("yaml unmarshal: %w", err)
+	}
+
+	if value == nil {
+		return nil, nil
+	}
+
+	out, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("yaml unmarshal: document should be object, got %T", value)
+	}
+
+	return out, nil
+}
+
+func marshalDocument(data any, format DocumentFormat, options *validateOptions) ([]byte, error) {
+	if !options.preserveFormat || format != DocumentFormatYAML {
+		return json.Marshal(data)
+	}
+
+	if options.preserveNumbers {
+		return libyaml.MarshalPreservingNumbers(data)
+	}
+
+	return yaml.Marshal(data)
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectDocumentFormat(t *testing.T) {
+	tests := []struct {
+		doc      string
+		expected DocumentFormat
+	}{
+		{doc: `{"kind": "TestKind"}`, expected: DocumentFormatJSON},
+		{doc: "\n  [1, 2]\n", expected: DocumentFormatJSON},
+		{doc: "kind: TestKind", expected: DocumentFormatYAML},
+		{doc: "{kind: TestKind}", expected: DocumentFormatYAML},
+		{doc: "", expected: DocumentFormatYAML},
+	}
+
+	for _, test := range tests {
+		t.Run(test.doc, func(t *testing.T) {
+			require.Equal(t, test.expected, DetectDocumentFormat([]byte(test.doc)))
+		})
+	}
+}
+
+func TestValidateJSON(t *testing.T) {
+	validator := NewValidator(nil).SetLogger(testGetLogger())
+	err := validator.LoadSchemas(strings.NewReader(testSchemaTestKind))
+	require.NoError(t, err)
+
+	const (
+		jsonDoc = `{
+  "apiVersion": "deckhouse.io/v1",
+  "kind": "TestKind",
+  "sshUser": "ubuntu",
+  "sudoPassword": "no secret"
+}`
+		yamlDoc = `
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+sudoPassword: "no secret"
+`
+	)
+
+	expected := &testKind{
+		SSHUser:      "ubuntu",
+		SudoPassword: "no secret",
+		SSHPort:      22,
+	}
+
+	t.Run("json input", func(t *testing.T) {
+		for _, opts := range [][]ValidateOption{nil, {ValidateWithPreserveFormat(true)}} {
+			doc := []byte(jsonDoc)
+			index, err := validator.Validate(&doc, opts...)
+			require.NoError(t, err)
+			require.Equal(t, indexTestKind, *index)
+
+			require.True(t, json.Valid(doc), "output should be json")
+			asserTestKind(t, doc, expected)
+		}
+	})
+
+	t.Run("yaml input with preserve format", func(t *testing.T) {
+		doc := []byte(yamlDoc)
+		err := validator.ValidateWithIndex(&indexTestKind, &doc, ValidateWithPreserveFormat(true))
+		require.NoError(t, err)
+
+		require.Equal(t, DocumentFormatYAML, DetectDocumentFormat(doc))
+		require.Contains(t, string(doc), "sshPort: 22\n")
+		asserTestKind(t, doc, expected)
+	})
+
+	t.Run("yaml input returns json by default", func(t *testing.T) {
+		doc := []byte(yamlDoc)
+		err := validator.ValidateWithIndex(&indexTestKind, &doc)
+		require.NoError(t, err)
+		require.Equal(t, DocumentFormatJSON, DetectDocumentFormat(doc))
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		doc := []byte(`{"apiVersion": "deckhouse.io/v1", "kind": "TestKind", "sshUser": "ubuntu", "sshPort": "22"}`)
+		_, err := validator.Validate(&doc, ValidateWithNoPrettyError(true))
+		require.ErrorIs(t, err, ErrDocumentValidationFailed)
+		require.Contains(t, err.Error(), "sshPort must be of type integer")
+	})
+
+	t.Run("strict json with duplicated keys", func(t *testing.T) {
+		doc := []byte(`{"apiVersion": "deckhouse.io/v1", "kind": "TestKind", "sshUser": "ubuntu", "sshUser": "root", "sudoPassword": "secret"}`)
+		err := validator.ValidateWithIndex(&indexTestKind, &doc, ValidateWithStrictUnmarshal(true))
+		require.ErrorIs(t, err, ErrKindInvalidYAML)
+	})
+}
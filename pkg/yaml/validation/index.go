@@ -22,6 +22,8 @@ import (
 	"strings"
 
 	"sigs.k8s.io/yaml"
+
+	libyaml "github.com/deckhouse/lib-dhctl/pkg/yaml"
 )
 
 const (
@@ -35,6 +37,8 @@ type SchemaIndex struct {
 
 type parseIndexOption struct {
 	noCheckIsValid bool
+	limits         libyaml.Limits
+	messages       *Messages
 }
 
 type ParseIndexOption func(*parseIndexOption)
@@ -45,6 +49,23 @@ func ParseIndexWithoutCheckValid() ParseIndexOption {
 	}
 }
 
+// ParseIndexWithLimits
+// check content with limits before unmarshal
+// if any limit exceeded returns ErrLimitExceeded
+func ParseIndexWithLimits(limits libyaml.Limits) ParseIndexOption {
+	return func(o *parseIndexOption) {
+		o.limits = limits
+	}
+}
+
+// ParseIndexWithMessages
+// use messages templates for errors
+func ParseIndexWithMessages(messages *Messages) ParseIndexOption {
+	return func(o *parseIndexOption) {
+		o.messages = messages
+	}
+}
+
 var parseIndexNoCheckValidOpt = ParseIndexWithoutCheckValid()
 
 // ParseIndex
@@ -64,9 +85,13 @@ func ParseIndex(reader io.Reader, opts ...ParseIndexOption) (*SchemaIndex, error
 		return nil, fmt.Errorf("%w: %w", ErrRead, err)
 	}
 
+	if err := checkLimits(content, options.limits); err != nil {
+		return nil, err
+	}
+
 	// we cannot use yaml.UnmarshalStrict here
 	// because strict unmarshal also verify that another keys not present
-	if err := contentHasMultipleSchemaKeys(content); err != nil {
+	if err := contentHasMultipleSchemaKeys(options.messages, content); err != nil {
 		return nil, err
 	}
 
@@ -78,12 +103,70 @@ func ParseIndex(reader io.Reader, opts ...ParseIndexOption) (*SchemaIndex, error
 	}
 
 	if !options.noCheckIsValid && !index.IsValid() {
-		return nil, index.invalidIndexErr(content)
+		return nil, index.invalidIndexErr(options.messages, content)
 	}
 
 	return &index, nil
 }
 
+// DocumentIndex
+// SchemaIndex of document from multi-document input
+type DocumentIndex struct {
+	Index SchemaIndex
+	// Number
+	// 0-based document number in input, same as Error.Index
+	Number int
+	// Line
+	// 1-based line number in input where document starts
+	Line int
+}
+
+// ParseIndexAll
+// split multi-document content from reader and parse SchemaIndex for every not empty document
+// if reader returns error - wrap reader error with ErrRead
+// returns indexes for all parsed documents and *ValidationError with errors
+// for every document with invalid index, so indexes can be used even if error returned
+func ParseIndexAll(reader io.Reader, opts ...ParseIndexOption) ([]*DocumentIndex, error) {
+	options := &parseIndexOption{}
+	for _, o := range opts {
+		o(options)
+	}
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRead, err)
+	}
+
+	if err := checkLimits(content, options.limits); err != nil {
+		return nil, err
+	}
+
+	result := make([]*DocumentIndex, 0)
+	errs := NewErrorBuilder()
+
+	for i, d := range libyaml.SplitYAMLBytesWithPositions(content) {
+		if strings.TrimSpace(d.Content) == "" {
+			continue
+		}
+
+		doc := []byte(d.Content)
+
+		index, err := ParseIndex(bytes.NewReader(doc), opts...)
+		if err != nil {
+			errs.AddDocumentError(i, doc, err).WithLine(d.Line)
+			continue
+		}
+
+		result = append(result, &DocumentIndex{
+			Index:  *index,
+			Number: i,
+			Line:   d.Line,
+		})
+	}
+
+	return result, errs.ErrorOrNil()
+}
+
 func (i *SchemaIndex) IsValid() bool {
 	return i.Kind != "" && i.Version != ""
 }
@@ -140,31 +223,45 @@ func (i *SchemaIndex) GroupAndGroupVersion() (string, string) {
 	}
 }
 
-func (i *SchemaIndex) invalidIndexErr(doc []byte) error {
-	return fmt.Errorf(
-		"%w: document must contain \"kind\" and \"apiVersion\" fields:\n\tapiVersion: %s\n\tkind: %s\n\n%s",
-		ErrKindValidationFailed, i.Version, i.Kind, string(doc),
-	)
+func (i *SchemaIndex) invalidIndexErr(messages *Messages, doc []byte) error {
+	msg := messages.Format(MessageInvalidIndex, InvalidIndexMessageData{
+		Kind:    i.Kind,
+		Version: i.Version,
+		Doc:     string(doc),
+	})
+
+	return fmt.Errorf("%w: %s", ErrKindValidationFailed, msg)
 }
 
 var (
 	apiVersionRegex = regexp.MustCompile(`(?m)^apiVersion:.*$`)
 	kindRegex       = regexp.MustCompile(`(?m)^kind:.*$`)
-	errSeparator    = []byte(" ")
 )
 
-func multipleKeysErr(keyName string, keys [][]byte) error {
-	joinedKeys := bytes.Join(keys, errSeparator)
-	return fmt.Errorf("%w: multiple %s keys found: %s", ErrKindValidationFailed, keyName, string(joinedKeys))
+func multipleKeysErr(messages *Messages, keyName string, keys []string) error {
+	msg := messages.Format(MessageMultipleKeys, MultipleKeysMessageData{
+		Key:  keyName,
+		Keys: keys,
+	})
+
+	return fmt.Errorf("%w: %s", ErrKindValidationFailed, msg)
 }
 
-func contentHasMultipleSchemaKeys(content []byte) error {
-	if res := apiVersionRegex.FindAll(content, 2); len(res) > 1 {
-		return multipleKeysErr("apiVersion", res)
+func contentHasMultipleSchemaKeys(messages *Messages, content []byte) error {
+	if res := apiVersionRegex.FindAllString(string(content), 2); len(res) > 1 {
+		return multipleKeysErr(messages, "apiVersion", res)
 	}
 
-	if res := kindRegex.FindAll(content, 2); len(res) > 1 {
-		return multipleKeysErr("kind", res)
+	if res := kindRegex.FindAllString(string(content), 2); len(res) > 1 {
+		return multipleKeysErr(messages, "kind", res)
+	}
+
+	return nil
+}
+
+func checkLimits(content []byte, limits libyaml.Limits) error {
+	if err := libyaml.CheckLimits(content, limits); err != nil {
+		return fmt.Errorf("%w: %w", ErrLimitExceeded, err)
 	}
 
 	return nil
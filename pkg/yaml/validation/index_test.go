@@ -19,6 +19,7 @@ import (
 	"io"
 	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/require"
 )
@@ -251,3 +252,54 @@ type errorReader struct{}
 func (e errorReader) Read(p []byte) (n int, err error) {
 	return 0, fmt.Errorf("error")
 }
+
+func TestParseIndexAll(t *testing.T) {
+	t.Run("multiple documents", func(t *testing.T) {
+		content := `
+apiVersion: deckhouse.io/v1
+kind: ClusterConfiguration
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: test
+---
+kind: NoVersion
+metadata:
+  name: invalid
+---
+apiVersion: dhctl.deckhouse.io/v1
+kind: SSHConfig
+---
+`
+		indexes, err := ParseIndexAll(strings.NewReader(content))
+
+		require.Equal(t, []*DocumentIndex{
+			{Index: SchemaIndex{Kind: "ClusterConfiguration", Version: "deckhouse.io/v1"}, Number: 0, Line: 2},
+			{Index: SchemaIndex{Kind: "Namespace", Version: "v1"}, Number: 1, Line: 5},
+			{Index: SchemaIndex{Kind: "SSHConfig", Version: "dhctl.deckhouse.io/v1"}, Number: 3, Line: 14},
+		}, indexes)
+
+		require.Error(t, err)
+		require.ErrorIs(t, err, ErrKindValidationFailed)
+
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Len(t, validationErr.Errors, 1)
+		require.Equal(t, 2, *validationErr.Errors[0].Index)
+		require.Equal(t, 10, validationErr.Errors[0].Line)
+		require.Equal(t, "invalid", validationErr.Errors[0].Name)
+	})
+
+	t.Run("without check valid", func(t *testing.T) {
+		indexes, err := ParseIndexAll(strings.NewReader("kind: NoVersion"), ParseIndexWithoutCheckValid())
+		require.NoError(t, err)
+		require.Len(t, indexes, 1)
+		require.Equal(t, "NoVersion", indexes[0].Index.Kind)
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		_, err := ParseIndexAll(iotest.ErrReader(fmt.Errorf("read error")))
+		require.ErrorIs(t, err, ErrRead)
+	})
+}
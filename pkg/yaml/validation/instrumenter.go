@@ -0,0 +1,112 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"errors"
+	"time"
+
+	"github.com/name212/govalue"
+)
+
+type ValidationOutcome int
+
+const (
+	OutcomeValid ValidationOutcome = iota + 1
+	OutcomeInvalid
+	// OutcomeSkipped
+	// schema for document index not found
+	OutcomeSkipped
+)
+
+func (o ValidationOutcome) String() string {
+	switch o {
+	case OutcomeValid:
+		return "Valid"
+	case OutcomeInvalid:
+		return "Invalid"
+	case OutcomeSkipped:
+		return "Skipped"
+	default:
+		return unknownErrString
+	}
+}
+
+// ValidateEndInfo
+// result of one document validation passed to Instrumenter
+type ValidateEndInfo struct {
+	Index    SchemaIndex
+	Duration time.Duration
+	Outcome  ValidationOutcome
+	// ErrorKind
+	// first validation error kind from Err, zero if document is valid
+	ErrorKind ErrorKind
+	Err       error
+}
+
+// Instrumenter
+// hooks for collecting metrics about validation, for example with prometheus
+// OnValidateStart and OnValidateEnd are called for every validated document with index,
+// including documents validated with ValidateDocuments and ValidateBatch
+// Instrumenter should be safe for concurrent use
+type Instrumenter interface {
+	OnValidateStart(index SchemaIndex)
+	OnValidateEnd(info ValidateEndInfo)
+}
+
+// SetInstrumenter
+// nil disables instrumentation
+func (v *Validator) SetInstrumenter(instrumenter Instrumenter) *Validator {
+	v.instrumenter = instrumenter
+	return v
+}
+
+func (v *Validator) instrument(index *SchemaIndex, validate func() error) error {
+	instrumenter := v.instrumenter
+	if govalue.IsNil(instrumenter) {
+		return validate()
+	}
+
+	start := time.Now()
+	instrumenter.OnValidateStart(*index)
+
+	err := validate()
+
+	instrumenter.OnValidateEnd(newValidateEndInfo(*index, time.Since(start), err))
+
+	return err
+}
+
+func newValidateEndInfo(index SchemaIndex, duration time.Duration, err error) ValidateEndInfo {
+	info := ValidateEndInfo{
+		Index:    index,
+		Duration: duration,
+		Outcome:  OutcomeValid,
+		Err:      err,
+	}
+
+	if err == nil {
+		return info
+	}
+
+	info.ErrorKind = ExtractValidationError(err)
+	info.Outcome = OutcomeInvalid
+
+	if errors.Is(err, ErrSchemaNotFound) {
+		info.Outcome = OutcomeSkipped
+	}
+
+	return info
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testInstrumenter struct {
+	mu      sync.Mutex
+	started []SchemaIndex
+	ended   []ValidateEndInfo
+}
+
+func (i *testInstrumenter) OnValidateStart(index SchemaIndex) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.started = append(i.started, index)
+}
+
+func (i *testInstrumenter) OnValidateEnd(info ValidateEndInfo) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.ended = append(i.ended, info)
+}
+
+func TestInstrumenter(t *testing.T) {
+	instrumenter := &testInstrumenter{}
+
+	validator := NewValidator(nil).SetLogger(testGetLogger()).SetInstrumenter(instrumenter)
+	err := validator.LoadSchemas(strings.NewReader(testSchemaTestKind))
+	require.NoError(t, err)
+
+	docs := []string{
+		`
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+sudoPassword: "no secret"
+`,
+		`
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+sshPort: "port"
+`,
+		`
+apiVersion: v1
+kind: Namespace
+`,
+	}
+
+	for _, d := range docs {
+		doc := []byte(d)
+		_, _ = validator.Validate(&doc, ValidateWithNoPrettyError(true))
+	}
+
+	require.Equal(t, []SchemaIndex{
+		indexTestKind,
+		indexTestKind,
+		{Kind: "Namespace", Version: "v1"},
+	}, instrumenter.started)
+
+	require.Len(t, instrumenter.ended, 3)
+
+	require.Equal(t, OutcomeValid, instrumenter.ended[0].Outcome)
+	require.Zero(t, instrumenter.ended[0].ErrorKind)
+	require.NoError(t, instrumenter.ended[0].Err)
+	require.Positive(t, instrumenter.ended[0].Duration)
+
+	require.Equal(t, OutcomeInvalid, instrumenter.ended[1].Outcome)
+	require.Equal(t, ErrDocumentValidationFailed, instrumenter.ended[1].ErrorKind)
+	require.Error(t, instrumenter.ended[1].Err)
+
+	require.Equal(t, OutcomeSkipped, instrumenter.ended[2].Outcome)
+	require.Equal(t, ErrSchemaNotFound, instrumenter.ended[2].ErrorKind)
+	require.Equal(t, "Skipped", instrumenter.ended[2].Outcome.String())
+
+	t.Run("disable", func(t *testing.T) {
+		validator.SetInstrumenter(nil)
+
+		doc := []byte(docs[0])
+		_, err := validator.Validate(&doc)
+		require.NoError(t, err)
+		require.Len(t, instrumenter.ended, 3)
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	libyaml "github.com/deckhouse/lib-dhctl/pkg/yaml"
+)
+
+func TestValidatorLimits(t *testing.T) {
+	const deepDoc = `
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+sudoPassword: "no secret"
+nested:
+  a:
+    b:
+      c: 1
+`
+
+	getValidator := func(t *testing.T, limits libyaml.Limits) *Validator {
+		validator := NewValidator(nil).SetLogger(testGetLogger())
+		err := validator.LoadSchemas(strings.NewReader(testSchemaTestKind))
+		require.NoError(t, err, "failed to load schema")
+		// set after loading, because limits are also applied to schemas
+		return validator.SetLimits(limits)
+	}
+
+	assertLimitError := func(t *testing.T, err error, limit string) {
+		require.Error(t, err)
+		require.True(t, errors.Is(err, ErrLimitExceeded))
+		require.True(t, errors.Is(err, libyaml.ErrLimitExceeded))
+
+		var limitErr *libyaml.LimitError
+		require.True(t, errors.As(err, &limitErr))
+		require.Equal(t, limit, limitErr.Limit)
+	}
+
+	t.Run("Validate", func(t *testing.T) {
+		validator := getValidator(t, libyaml.Limits{MaxDepth: 3})
+
+		doc := []byte(deepDoc)
+		_, err := validator.Validate(&doc)
+		assertLimitError(t, err, libyaml.LimitDepth)
+	})
+
+	t.Run("ValidateWithIndex", func(t *testing.T) {
+		validator := getValidator(t, libyaml.Limits{MaxDocumentSize: 10})
+
+		index := SchemaIndex{Kind: "TestKind", Version: "deckhouse.io/v1"}
+		doc := []byte(deepDoc)
+		err := validator.ValidateWithIndex(&index, &doc)
+		assertLimitError(t, err, libyaml.LimitDocumentSize)
+	})
+
+	t.Run("ParseIndex", func(t *testing.T) {
+		_, err := ParseIndex(strings.NewReader(deepDoc), ParseIndexWithLimits(libyaml.Limits{MaxDepth: 2}))
+		assertLimitError(t, err, libyaml.LimitDepth)
+
+		_, err = ParseIndex(strings.NewReader(deepDoc), ParseIndexWithLimits(libyaml.DefaultLimits()))
+		require.NoError(t, err)
+	})
+
+	t.Run("ValidateDocuments", func(t *testing.T) {
+		validator := getValidator(t, libyaml.Limits{MaxDepth: 3})
+
+		_, err := validator.ValidateDocuments([]byte(deepDoc))
+		require.ErrorIs(t, err, ErrLimitExceeded)
+		require.Contains(t, err.Error(), "nesting depth 4 exceeds maximum 3")
+	})
+
+	t.Run("LoadSchemas", func(t *testing.T) {
+		_, err := LoadSchemas(strings.NewReader(testSchemaTestKind), LoadSchemasWithLimits(libyaml.Limits{MaxDepth: 2}))
+		assertLimitError(t, err, libyaml.LimitDepth)
+
+		validator := NewValidator(nil).SetLogger(testGetLogger()).SetLimits(libyaml.Limits{MaxDocumentSize: 10})
+		err = validator.LoadSchemas(strings.NewReader(testSchemaTestKind))
+		assertLimitError(t, err, libyaml.LimitDocumentSize)
+	})
+
+	t.Run("default limits do not affect regular documents", func(t *testing.T) {
+		validator := getValidator(t, libyaml.DefaultLimits())
+
+		doc := []byte(`
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+sudoPassword: "no secret"
+`)
+		_, err := validator.Validate(&doc)
+		require.NoError(t, err)
+	})
+}
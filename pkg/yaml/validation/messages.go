@@ -0,0 +1,212 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+	"text/template"
+
+	oaerrors "github.com/go-openapi/errors"
+)
+
+type MessageID string
+
+const (
+	// MessageInvalidIndex
+	// document does not contain kind or apiVersion, data is InvalidIndexMessageData
+	MessageInvalidIndex MessageID = "InvalidIndex"
+	// MessageMultipleKeys
+	// document contains multiple kind or apiVersion keys, data is MultipleKeysMessageData
+	MessageMultipleKeys MessageID = "MultipleKeys"
+	// MessageDocumentValidationFailed
+	// header for pretty validation error, data is DocumentMessageData
+	MessageDocumentValidationFailed MessageID = "DocumentValidationFailed"
+	// MessageSchemaViolation
+	// openapi schema violation, data is SchemaViolationMessageData
+	// can be overridden for one violation code with SchemaViolationMessageID
+	MessageSchemaViolation MessageID = "SchemaViolation"
+	// MessageRequiredWhen
+	// x-required-when rule failed, data is RequiredWhenMessageData
+	MessageRequiredWhen MessageID = "RequiredWhen"
+)
+
+type InvalidIndexMessageData struct {
+	Kind    string
+	Version string
+	Doc     string
+}
+
+type MultipleKeysMessageData struct {
+	Key  string
+	Keys []string
+}
+
+type DocumentMessageData struct {
+	Doc string
+}
+
+type SchemaViolationMessageData struct {
+	// Code
+	// go-openapi errors code, for example errors.InvalidTypeCode
+	Code int32
+	// Name
+	// path to field
+	Name string
+	// Value
+	// depends on Code: actual value (actual type for errors.InvalidTypeCode) or constraint value
+	Value any
+	// Values
+	// allowed values for enum
+	Values []any
+	// Message
+	// original message
+	Message string
+}
+
+type RequiredWhenMessageData struct {
+	// Path
+	// path to object with rule, <root> for document root
+	Path  string
+	Field string
+	// Value
+	// value of Field from rule, HasValue is false if rule requires only presence of Field
+	Value    any
+	HasValue bool
+	Missing  []string
+}
+
+// SchemaViolationMessageID
+// returns message id for overriding message only for one go-openapi errors code
+func SchemaViolationMessageID(code int32) MessageID {
+	return MessageID(fmt.Sprintf("%s/%d", MessageSchemaViolation, code))
+}
+
+var defaultMessagesTemplates = map[MessageID]string{
+	MessageInvalidIndex:             "document must contain \"kind\" and \"apiVersion\" fields:\n\tapiVersion: {{ .Version }}\n\tkind: {{ .Kind }}\n\n{{ .Doc }}",
+	MessageMultipleKeys:             `multiple {{ .Key }} keys found: {{ join .Keys " " }}`,
+	MessageDocumentValidationFailed: "Document validation failed:\n---\n{{ .Doc }}\n",
+	MessageSchemaViolation:          "{{ .Message }}",
+	MessageRequiredWhen:             `{{ .Path }}: {{ join .Missing ", " }} required when {{ .Field }} {{ if .HasValue }}is {{ printf "%v" .Value }}{{ else }}is set{{ end }}`,
+}
+
+var templateFuncs = template.FuncMap{
+	"join": strings.Join,
+}
+
+var defaultMessages = mustMessages(defaultMessagesTemplates)
+
+// Messages
+// templates for validation errors messages
+// templates use text/template syntax, "join" function is available
+// nil *Messages uses default templates
+type Messages struct {
+	templates map[MessageID]*template.Template
+}
+
+// DefaultMessages
+// returns messages with default (english) templates
+func DefaultMessages() *Messages {
+	return defaultMessages
+}
+
+// NewMessages
+// returns messages with default templates overridden with passed templates
+// returns error if any template cannot be parsed
+func NewMessages(overrides map[MessageID]string) (*Messages, error) {
+	messages := &Messages{
+		templates: maps.Clone(defaultMessages.templates),
+	}
+
+	for id, text := range overrides {
+		tpl, err := template.New(string(id)).Funcs(templateFuncs).Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse message template %s: %w", id, err)
+		}
+
+		messages.templates[id] = tpl
+	}
+
+	return messages, nil
+}
+
+func mustMessages(templates map[MessageID]string) *Messages {
+	messages := &Messages{
+		templates: make(map[MessageID]*template.Template, len(templates)),
+	}
+
+	for id, text := range templates {
+		messages.templates[id] = template.Must(template.New(string(id)).Funcs(templateFuncs).Parse(text))
+	}
+
+	return messages
+}
+
+// Format
+// renders message with data
+// if template for id was not found or failed, default template is used
+func (m *Messages) Format(id MessageID, data any) string {
+	if m != nil {
+		if msg, ok := m.execute(id, data); ok {
+			return msg
+		}
+	}
+
+	msg, _ := defaultMessages.execute(id, data)
+
+	return msg
+}
+
+func (m *Messages) execute(id MessageID, data any) (string, bool) {
+	tpl, ok := m.templates[id]
+	if !ok {
+		return "", false
+	}
+
+	b := strings.Builder{}
+	if err := tpl.Execute(&b, data); err != nil {
+		return "", false
+	}
+
+	return b.String(), true
+}
+
+// schemaViolation
+// renders openapi validation error with MessageSchemaViolation templates
+// errors which are not go-openapi validation errors are returned as is
+func (m *Messages) schemaViolation(err error) error {
+	var validationErr *oaerrors.Validation
+	if !errors.As(err, &validationErr) {
+		return err
+	}
+
+	data := SchemaViolationMessageData{
+		Code:    validationErr.Code(),
+		Name:    validationErr.Name,
+		Value:   validationErr.Value,
+		Values:  validationErr.Values,
+		Message: validationErr.Error(),
+	}
+
+	if m != nil {
+		if msg, ok := m.execute(SchemaViolationMessageID(data.Code), data); ok {
+			return errors.New(msg)
+		}
+	}
+
+	return errors.New(m.Format(MessageSchemaViolation, data))
+}
@@ -0,0 +1,109 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	oaerrors "github.com/go-openapi/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessages(t *testing.T) {
+	messages, err := NewMessages(map[MessageID]string{
+		MessageInvalidIndex:                                "документ должен содержать kind и apiVersion",
+		MessageMultipleKeys:                                `несколько ключей {{ .Key }}: {{ join .Keys ", " }}`,
+		MessageDocumentValidationFailed:                    "Ошибка валидации документа",
+		SchemaViolationMessageID(oaerrors.InvalidTypeCode): "поле {{ .Name }} имеет неверный тип {{ .Value }}",
+	})
+	require.NoError(t, err)
+
+	getValidator := func(t *testing.T) *Validator {
+		validator := NewValidator(nil).SetLogger(testGetLogger()).SetMessages(messages)
+		err := validator.LoadSchemas(strings.NewReader(testSchemaTestKind))
+		require.NoError(t, err, "failed to load schema")
+		return validator
+	}
+
+	t.Run("invalid index", func(t *testing.T) {
+		doc := []byte("sshUser: ubuntu\n")
+		_, err := getValidator(t).Validate(&doc)
+		require.ErrorIs(t, err, ErrKindValidationFailed)
+		require.EqualError(t, err, "DocumentKindValidationFailed: документ должен содержать kind и apiVersion")
+
+		_, err = ParseIndex(strings.NewReader("sshUser: ubuntu\n"), ParseIndexWithMessages(messages))
+		require.EqualError(t, err, "DocumentKindValidationFailed: документ должен содержать kind и apiVersion")
+	})
+
+	t.Run("multiple keys", func(t *testing.T) {
+		_, err := ParseIndex(strings.NewReader("kind: A\nkind: B\napiVersion: v1\n"), ParseIndexWithMessages(messages))
+		require.EqualError(t, err, "DocumentKindValidationFailed: несколько ключей kind: kind: A, kind: B")
+
+		_, err = getValidator(t).ValidateDocuments([]byte("kind: A\nkind: B\napiVersion: v1\n"))
+		require.ErrorIs(t, err, ErrKindValidationFailed)
+		require.Contains(t, err.Error(), "несколько ключей kind: kind: A, kind: B")
+	})
+
+	t.Run("schema violation", func(t *testing.T) {
+		doc := []byte(`
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+sudoPassword: password
+sshPort: "22"
+`)
+		_, err := getValidator(t).Validate(&doc)
+		require.ErrorIs(t, err, ErrDocumentValidationFailed)
+		require.True(t, strings.HasPrefix(err.Error(), "Ошибка валидации документа\n"), err.Error())
+		require.Contains(t, err.Error(), "поле sshPort имеет неверный тип string")
+	})
+
+	t.Run("not overridden messages use defaults", func(t *testing.T) {
+		doc := []byte(`
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+sudoPassword: password
+unknown: field
+`)
+		_, err := getValidator(t).Validate(&doc, ValidateWithNoPrettyError(true))
+		require.ErrorIs(t, err, ErrDocumentValidationFailed)
+		require.Contains(t, err.Error(), ".unknown is a forbidden property")
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		_, err := NewMessages(map[MessageID]string{
+			MessageInvalidIndex: "{{ .Kind",
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("failed template fallbacks to default", func(t *testing.T) {
+		messages, err := NewMessages(map[MessageID]string{
+			MessageDocumentValidationFailed: "{{ .Absent }}",
+		})
+		require.NoError(t, err)
+
+		msg := messages.Format(MessageDocumentValidationFailed, DocumentMessageData{Doc: "a: 1"})
+		require.Equal(t, "Document validation failed:\n---\na: 1\n", msg)
+	})
+
+	t.Run("nil messages use defaults", func(t *testing.T) {
+		var messages *Messages
+		msg := messages.Format(MessageMultipleKeys, MultipleKeysMessageData{Key: "kind", Keys: []string{"kind: A", "kind: B"}})
+		require.Equal(t, "multiple kind keys found: kind: A kind: B", msg)
+	})
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/go-openapi/spec"
+)
+
+// normalizeNumbers
+// converts json.Number values to float64 for fields which schema type is not number or integer.
+// schema validator treats json.Number as string for not numeric types,
+// so unquoted number for string field would be passed without conversion.
+// Also converts numbers with fraction or exponent for integer fields,
+// because schema validator fails to parse them as int with raw parse error
+// instead of usual "must be of type integer" error
+func normalizeNumbers(schema *spec.Schema, data any) any {
+	if schema == nil {
+		return data
+	}
+
+	switch typed := data.(type) {
+	case json.Number:
+		if len(schema.Type) == 0 || slices.Contains(schema.Type, "number") {
+			return typed
+		}
+
+		if slices.Contains(schema.Type, "integer") && !strings.ContainsAny(typed.String(), ".eE") {
+			return typed
+		}
+
+		if f, err := typed.Float64(); err == nil {
+			return f
+		}
+	case map[string]any:
+		for key, value := range typed {
+			typed[key] = normalizeNumbers(valueSchema(schema, key), value)
+		}
+	case []any:
+		if schema.Items == nil || schema.Items.Schema == nil {
+			return typed
+		}
+
+		for i, item := range typed {
+			typed[i] = normalizeNumbers(schema.Items.Schema, item)
+		}
+	}
+
+	return data
+}
+
+func valueSchema(schema *spec.Schema, field string) *spec.Schema {
+	if fieldSchema := propertySchema(schema, field); fieldSchema != nil {
+		return fieldSchema
+	}
+
+	if schema.AdditionalProperties != nil {
+		return schema.AdditionalProperties.Schema
+	}
+
+	return nil
+}
+
+// numberValue
+// returns float64 for json.Number for comparing with values from schema extensions
+func numberValue(value any) any {
+	n, ok := value.(json.Number)
+	if !ok {
+		return value
+	}
+
+	if f, err := n.Float64(); err == nil {
+		return f
+	}
+
+	return value
+}
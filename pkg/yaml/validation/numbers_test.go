@@ -0,0 +1,155 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateWithPreserveNumbers(t *testing.T) {
+	const schemas = `
+kind: NumbersKind
+apiVersions:
+- apiVersion: deckhouse.io/v1
+  openAPISpec:
+    type: object
+    x-required-when:
+    - field: replicas
+      equals: 3
+      required: [zone]
+    properties:
+      apiVersion:
+        type: string
+      kind:
+        type: string
+      kubernetesVersion:
+        type: string
+      ratio:
+        type: number
+      replicas:
+        type: integer
+        default: 1
+      zone:
+        type: string
+      id:
+        type: integer
+      labels:
+        type: object
+        additionalProperties:
+          type: string
+`
+
+	validator := NewValidator(nil).SetLogger(testGetLogger())
+	err := validator.LoadSchemas(strings.NewReader(schemas))
+	require.NoError(t, err)
+
+	const yamlDoc = `
+apiVersion: deckhouse.io/v1
+kind: NumbersKind
+ratio: 1.20
+id: 9007199254740993
+`
+
+	t.Run("yaml input", func(t *testing.T) {
+		doc := []byte(yamlDoc)
+		_, err := validator.Validate(&doc, ValidateWithPreserveNumbers(true))
+		require.NoError(t, err)
+
+		require.Contains(t, string(doc), `"ratio":1.20`)
+		require.Contains(t, string(doc), `"id":9007199254740993`)
+		require.Contains(t, string(doc), `"replicas":1`)
+	})
+
+	t.Run("yaml input with preserve format", func(t *testing.T) {
+		doc := []byte(yamlDoc)
+		_, err := validator.Validate(&doc, ValidateWithPreserveNumbers(true), ValidateWithPreserveFormat(true))
+		require.NoError(t, err)
+
+		require.Contains(t, string(doc), "ratio: 1.20\n")
+		require.Contains(t, string(doc), "id: 9007199254740993\n")
+		require.Contains(t, string(doc), "replicas: 1\n")
+	})
+
+	t.Run("json input", func(t *testing.T) {
+		doc := []byte(`{"apiVersion": "deckhouse.io/v1", "kind": "NumbersKind", "ratio": 1.20, "id": 9007199254740993}`)
+		_, err := validator.Validate(&doc, ValidateWithPreserveNumbers(true), ValidateWithStrictUnmarshal(true))
+		require.NoError(t, err)
+
+		require.Contains(t, string(doc), `"ratio":1.20`)
+		require.Contains(t, string(doc), `"id":9007199254740993`)
+	})
+
+	t.Run("without option numbers are converted", func(t *testing.T) {
+		doc := []byte(yamlDoc)
+		_, err := validator.Validate(&doc)
+		// integer out of float64 precision is converted to float
+		require.ErrorContains(t, err, "id must be of type integer")
+
+		doc = []byte(strings.Replace(yamlDoc, "id: 9007199254740993\n", "", 1))
+		_, err = validator.Validate(&doc)
+		require.NoError(t, err)
+
+		require.Contains(t, string(doc), `"ratio":1.2,`)
+	})
+
+	t.Run("number for string field", func(t *testing.T) {
+		for _, doc := range []string{
+			yamlDoc + "kubernetesVersion: 1.30\n",
+			yamlDoc + "labels:\n  version: 1.30\n",
+		} {
+			content := []byte(doc)
+			_, err := validator.Validate(&content, ValidateWithPreserveNumbers(true))
+			require.Error(t, err)
+			require.ErrorIs(t, err, ErrDocumentValidationFailed)
+		}
+	})
+
+	t.Run("required when with number value", func(t *testing.T) {
+		doc := []byte(yamlDoc + "replicas: 3\n")
+		_, err := validator.Validate(&doc, ValidateWithPreserveNumbers(true))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "zone required when replicas is 3")
+	})
+
+	t.Run("fractional value for integer field", func(t *testing.T) {
+		content := strings.Replace(yamlDoc, "id: 9007199254740993\n", "", 1) + "replicas: 2.5\n"
+
+		doc := []byte(content)
+		_, errWithoutOption := validator.Validate(&doc)
+		require.Error(t, errWithoutOption)
+
+		doc = []byte(content)
+		_, err := validator.Validate(&doc, ValidateWithPreserveNumbers(true))
+		require.Error(t, err)
+		require.Equal(t, errWithoutOption.Error(), err.Error(), "option should not change validation messages")
+		require.Contains(t, err.Error(), "replicas must be of type integer")
+
+		for _, value := range []string{"2.0", "2e0"} {
+			doc = []byte(yamlDoc + "replicas: " + value + "\n")
+			_, err = validator.Validate(&doc, ValidateWithPreserveNumbers(true))
+			require.NoError(t, err, "integer value with fraction or exponent should be valid like without option")
+		}
+	})
+
+	t.Run("duplicated keys in strict mode", func(t *testing.T) {
+		doc := []byte(yamlDoc + "ratio: 1.5\n")
+		_, err := validator.Validate(&doc, ValidateWithPreserveNumbers(true), ValidateWithStrictUnmarshal(true))
+		require.Error(t, err)
+		require.ErrorIs(t, err, ErrKindInvalidYAML)
+	})
+}
@@ -0,0 +1,195 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+const (
+	xDiscriminatorExtension = "x-discriminator"
+
+	defsRefPrefix        = "#/$defs/"
+	definitionsRefPrefix = "#/definitions/"
+)
+
+var (
+	// keywords which values are maps of schemas
+	schemasMapKeywords = []string{"properties", "patternProperties", "definitions", "dependentSchemas"}
+	// keywords which values are lists of schemas
+	schemasListKeywords = []string{"allOf", "anyOf", "oneOf"}
+	// keywords which values are schemas
+	schemaKeywords = []string{"not", "additionalProperties", "additionalItems"}
+)
+
+// convertOpenAPI3Schema
+// down-converts OpenAPI 3.x / JSON Schema 2020-12 keywords
+// to swagger 2.0 like schema which is supported by go-openapi:
+//   - type: [T, "null"] -> type: T, nullable: true
+//   - const: V -> enum: [V]
+//   - prefixItems: [...] -> items: [...], items: S -> additionalItems: S
+//   - discriminator object -> x-discriminator extension
+//   - numeric exclusiveMinimum/exclusiveMaximum -> minimum/maximum with boolean exclusive flag
+//   - $defs and #/$defs/ refs -> definitions and #/definitions/ refs
+//
+// swagger 2.0 schemas are not changed
+func convertOpenAPI3Schema(schema any) any {
+	s, ok := schema.(map[string]any)
+	if !ok {
+		return schema
+	}
+
+	if defs, ok := s["$defs"]; ok {
+		delete(s, "$defs")
+		if _, hasDefinitions := s["definitions"]; !hasDefinitions {
+			s["definitions"] = defs
+		}
+	}
+
+	if ref, ok := s["$ref"].(string); ok && strings.HasPrefix(ref, defsRefPrefix) {
+		s["$ref"] = definitionsRefPrefix + strings.TrimPrefix(ref, defsRefPrefix)
+	}
+
+	convertNullableType(s)
+
+	if c, ok := s["const"]; ok {
+		delete(s, "const")
+		s["enum"] = []any{c}
+	}
+
+	if d, ok := s["discriminator"]; ok {
+		if _, isObject := d.(map[string]any); isObject {
+			delete(s, "discriminator")
+			s[xDiscriminatorExtension] = d
+		}
+	}
+
+	convertExclusiveBound(s, "exclusiveMinimum", "minimum", true)
+	convertExclusiveBound(s, "exclusiveMaximum", "maximum", false)
+
+	if prefixItems, ok := s["prefixItems"].([]any); ok {
+		delete(s, "prefixItems")
+		if items, ok := s["items"]; ok {
+			s["additionalItems"] = items
+		}
+		s["items"] = prefixItems
+	}
+
+	for _, keyword := range schemasMapKeywords {
+		if m, ok := s[keyword].(map[string]any); ok {
+			for k, v := range m {
+				m[k] = convertOpenAPI3Schema(v)
+			}
+		}
+	}
+
+	for _, keyword := range schemasListKeywords {
+		if l, ok := s[keyword].([]any); ok {
+			for i, v := range l {
+				l[i] = convertOpenAPI3Schema(v)
+			}
+		}
+	}
+
+	for _, keyword := range schemaKeywords {
+		if v, ok := s[keyword]; ok {
+			s[keyword] = convertOpenAPI3Schema(v)
+		}
+	}
+
+	switch items := s["items"].(type) {
+	case []any:
+		for i, v := range items {
+			items[i] = convertOpenAPI3Schema(v)
+		}
+	case map[string]any:
+		s["items"] = convertOpenAPI3Schema(items)
+	}
+
+	return s
+}
+
+func convertNullableType(s map[string]any) {
+	types, ok := s["type"].([]any)
+	if !ok {
+		return
+	}
+
+	notNull := make([]any, 0, len(types))
+	hasNull := false
+	for _, t := range types {
+		if t == "null" {
+			hasNull = true
+			continue
+		}
+		notNull = append(notNull, t)
+	}
+
+	if !hasNull || len(notNull) != 1 {
+		return
+	}
+
+	s["type"] = notNull[0]
+	s["nullable"] = true
+}
+
+// convertExclusiveBound
+// if inclusive bound already exists, stricter bound is kept:
+// exclusiveMinimum: 0 with minimum: 1 -> minimum: 1
+// exclusiveMinimum: 1 with minimum: 0 -> minimum: 1, exclusiveMinimum: true
+func convertExclusiveBound(s map[string]any, exclusiveKey, boundKey string, lower bool) {
+	bound, ok := s[exclusiveKey]
+	if !ok {
+		return
+	}
+
+	if _, isBool := bound.(bool); isBool {
+		return
+	}
+
+	exclusive, isExclusiveNumber := boundValue(bound)
+	inclusive, isInclusiveNumber := boundValue(s[boundKey])
+	if isExclusiveNumber && isInclusiveNumber {
+		inclusiveStricter := inclusive > exclusive
+		if !lower {
+			inclusiveStricter = inclusive < exclusive
+		}
+
+		if inclusiveStricter {
+			delete(s, exclusiveKey)
+			return
+		}
+	}
+
+	s[boundKey] = bound
+	s[exclusiveKey] = true
+}
+
+func boundValue(value any) (float64, bool) {
+	switch typed := value.(type) {
+	case float64:
+		return typed, true
+	case int:
+		return float64(typed), true
+	case int64:
+		return float64(typed), true
+	case json.Number:
+		f, err := typed.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
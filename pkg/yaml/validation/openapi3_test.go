@@ -0,0 +1,163 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadOpenAPI3Schema(t *testing.T) {
+	const schema = `
+kind: OpenAPI3Kind
+apiVersions:
+- apiVersion: deckhouse.io/v1
+  openAPISpec:
+    type: object
+    $defs:
+      port:
+        type: integer
+        exclusiveMinimum: 0
+        exclusiveMaximum: 65536
+    properties:
+      apiVersion:
+        type: string
+      kind:
+        type: string
+      comment:
+        type: [string, "null"]
+      mode:
+        const: Static
+      port:
+        $ref: "#/$defs/port"
+      replicas:
+        type: number
+        minimum: 1
+        exclusiveMinimum: 0
+        maximum: 10
+        exclusiveMaximum: 5
+      pair:
+        type: array
+        prefixItems:
+        - type: string
+        - type: integer
+      provider:
+        additionalProperties: true
+        oneOf:
+        - type: object
+          required: [type, region]
+          properties:
+            type:
+              const: AWS
+            region:
+              type: string
+        - type: object
+          required: [type, zone]
+          properties:
+            type:
+              const: GCP
+            zone:
+              type: string
+        discriminator:
+          propertyName: type
+`
+	schemas, err := LoadSchemas(strings.NewReader(schema))
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+
+	loaded := schemas[0].Schema
+	require.True(t, loaded.Properties["comment"].Nullable)
+	require.Contains(t, loaded.Properties["provider"].Extensions, xDiscriminatorExtension)
+
+	validator := NewValidator(nil).AddSchema(schemas[0].Index, loaded)
+
+	tests := []struct {
+		name        string
+		fields      string
+		shouldError bool
+	}{
+		{
+			name: "valid",
+			fields: `
+comment: null
+mode: Static
+port: 22
+pair: ["a", 1]
+provider:
+  type: AWS
+  region: eu
+`,
+		},
+		{
+			name:        "nullable type",
+			fields:      "comment: 1",
+			shouldError: true,
+		},
+		{
+			name:        "const",
+			fields:      "mode: Cloud",
+			shouldError: true,
+		},
+		{
+			name:        "exclusive minimum from defs",
+			fields:      "port: 0",
+			shouldError: true,
+		},
+		{
+			name:   "inclusive minimum stricter than exclusive",
+			fields: "replicas: 1",
+		},
+		{
+			name:        "value between exclusive and inclusive minimum",
+			fields:      "replicas: 0.5",
+			shouldError: true,
+		},
+		{
+			name:        "exclusive maximum stricter than inclusive",
+			fields:      "replicas: 5",
+			shouldError: true,
+		},
+		{
+			name:        "prefix items",
+			fields:      `pair: [1, "a"]`,
+			shouldError: true,
+		},
+		{
+			name: "one of",
+			fields: `
+provider:
+  type: GCP
+  region: eu
+`,
+			shouldError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			doc := []byte("apiVersion: deckhouse.io/v1\nkind: OpenAPI3Kind\n" + test.fields)
+			_, err := validator.Validate(&doc, ValidateWithNoPrettyError(true))
+			if test.shouldError {
+				require.Error(t, err)
+				require.ErrorIs(t, err, ErrDocumentValidationFailed)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/spec"
+	"sigs.k8s.io/yaml"
+
+	libyaml "github.com/deckhouse/lib-dhctl/pkg/yaml"
+)
+
+type schemaOverlayOptions struct {
+	mergeKeys map[string]string
+}
+
+type SchemaOverlayOption func(o *schemaOverlayOptions)
+
+// SchemaOverlayWithStrategicMergeKeys
+// applies overlay as strategic merge patch instead of RFC 7386 merge patch
+// keys are paths to lists in schema and values are merge keys for list items,
+// for example: {"anyOf": "required", "properties.provider.oneOf": "title"}
+// see libyaml.MergePatchWithStrategicMergeKeys for merge rules
+func SchemaOverlayWithStrategicMergeKeys(keys map[string]string) SchemaOverlayOption {
+	return func(o *schemaOverlayOptions) {
+		o.mergeKeys = keys
+	}
+}
+
+// AddSchemaOverlay
+// merge patch (RFC 7386) passed as yaml or json onto loaded schema for index
+// null values in patch remove fields from schema
+// lists in schema replaced by patch lists unless SchemaOverlayWithStrategicMergeKeys passed
+// if schema for index not found returns ErrSchemaNotFound
+func (v *Validator) AddSchemaOverlay(index SchemaIndex, patch []byte, opts ...SchemaOverlayOption) error {
+	options := &schemaOverlayOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	schema := v.Get(&index)
+	if schema == nil {
+		return fmt.Errorf("%w: cannot add overlay for %s", ErrSchemaNotFound, index.String())
+	}
+
+	patched, err := applySchemaOverlay(schema, patch, options)
+	if err != nil {
+		return fmt.Errorf("cannot add overlay for %s: %w", index.String(), err)
+	}
+
+	v.AddSchema(index, patched)
+
+	return nil
+}
+
+func applySchemaOverlay(schema *spec.Schema, patch []byte, options *schemaOverlayOptions) (*spec.Schema, error) {
+	var patchObj any
+	if err := yaml.Unmarshal(patch, &patchObj); err != nil {
+		return nil, fmt.Errorf("%w: overlay unmarshal failed: %w", ErrKindInvalidYAML, err)
+	}
+
+	schemaContent, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("schema marshal failed: %w", err)
+	}
+
+	var schemaObj any
+	if err := json.Unmarshal(schemaContent, &schemaObj); err != nil {
+		return nil, fmt.Errorf("schema unmarshal failed: %w", err)
+	}
+
+	var mergeOpts []libyaml.MergePatchOption
+	if len(options.mergeKeys) > 0 {
+		mergeOpts = append(mergeOpts, libyaml.MergePatchWithStrategicMergeKeys(options.mergeKeys))
+	}
+
+	patchedContent, err := json.Marshal(libyaml.MergePatchValue(schemaObj, patchObj, mergeOpts...))
+	if err != nil {
+		return nil, fmt.Errorf("patched schema marshal failed: %w", err)
+	}
+
+	result := new(spec.Schema)
+	if err := json.Unmarshal(patchedContent, result); err != nil {
+		return nil, fmt.Errorf("patched schema unmarshal failed: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddSchemaOverlay(t *testing.T) {
+	getValidator := func(t *testing.T) *Validator {
+		validator := NewValidator(nil).SetLogger(testGetLogger())
+		err := validator.LoadSchemas(strings.NewReader(testSchemaTestKind))
+		require.NoError(t, err, "failed to load schema")
+		return validator
+	}
+
+	assertValidate := func(t *testing.T, validator *Validator, doc string, shouldError bool) {
+		bytes := []byte(doc)
+		_, err := validator.Validate(&bytes, ValidateWithNoPrettyError(true))
+		if shouldError {
+			require.Error(t, err)
+			require.ErrorIs(t, err, ErrDocumentValidationFailed)
+			return
+		}
+
+		require.NoError(t, err)
+	}
+
+	t.Run("inject property", func(t *testing.T) {
+		doc := `
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+sudoPassword: "no secret"
+provider: AWS
+`
+		validator := getValidator(t)
+		assertValidate(t, validator, doc, true)
+
+		err := validator.AddSchemaOverlay(indexTestKind, []byte(`
+properties:
+  provider:
+    type: string
+    enum: [AWS]
+`))
+		require.NoError(t, err)
+
+		assertValidate(t, validator, doc, false)
+
+		schema := validator.Get(&indexTestKind)
+		require.Contains(t, schema.Properties, "sshUser", "should keep not patched properties")
+		require.Contains(t, schema.Properties["sshAgentPrivateKeys"].Items.Schema.Extensions, "x-rules", "should keep extensions")
+	})
+
+	t.Run("remove field with null", func(t *testing.T) {
+		doc := `
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+`
+		validator := getValidator(t)
+		assertValidate(t, validator, doc, true)
+
+		err := validator.AddSchemaOverlay(indexTestKind, []byte(`{"anyOf": null}`))
+		require.NoError(t, err)
+
+		assertValidate(t, validator, doc, false)
+	})
+
+	t.Run("strategic merge list items", func(t *testing.T) {
+		doc := `
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+`
+		validator := getValidator(t)
+		assertValidate(t, validator, doc, true)
+
+		err := validator.AddSchemaOverlay(indexTestKind, []byte(`
+anyOf:
+  - required: [apiVersion, kind, sshUser, sudoPassword]
+    $patch: delete
+  - required: [apiVersion, kind, sshUser]
+`), SchemaOverlayWithStrategicMergeKeys(map[string]string{"anyOf": "required"}))
+		require.NoError(t, err)
+
+		assertValidate(t, validator, doc, false)
+
+		schema := validator.Get(&indexTestKind)
+		require.Len(t, schema.AnyOf, 2)
+		require.Equal(t, []string{"apiVersion", "kind", "sshUser", "sshAgentPrivateKeys"}, schema.AnyOf[0].Required, "should keep not patched items")
+		require.Equal(t, []string{"apiVersion", "kind", "sshUser"}, schema.AnyOf[1].Required, "should append new item")
+	})
+
+	t.Run("merge patch replaces lists", func(t *testing.T) {
+		validator := getValidator(t)
+
+		err := validator.AddSchemaOverlay(indexTestKind, []byte(`
+anyOf:
+  - required: [apiVersion, kind, sshUser]
+`))
+		require.NoError(t, err)
+
+		schema := validator.Get(&indexTestKind)
+		require.Len(t, schema.AnyOf, 1)
+	})
+
+	t.Run("schema not found", func(t *testing.T) {
+		err := getValidator(t).AddSchemaOverlay(indexAnotherTestKind, []byte(`{"type": "object"}`))
+		require.Error(t, err)
+		require.ErrorIs(t, err, ErrSchemaNotFound)
+	})
+
+	t.Run("invalid patch", func(t *testing.T) {
+		err := getValidator(t).AddSchemaOverlay(indexTestKind, []byte(`{invalid`))
+		require.Error(t, err)
+		require.ErrorIs(t, err, ErrKindInvalidYAML)
+	})
+}
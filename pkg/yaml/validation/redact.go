@@ -0,0 +1,134 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/spec"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	RedactedValue = "***"
+
+	xSecretExtension    = "x-secret"
+	xSensitiveExtension = "x-sensitive"
+	passwordFormat      = "password"
+)
+
+// RedactSecrets
+// returns copy of doc in which all values of fields marked as secret in schema for index
+// replaced with RedactedValue
+// field is secret if schema contains x-secret: true or x-sensitive: true or format: password
+// if schema not found returns ErrSchemaNotFound
+func (v *Validator) RedactSecrets(index *SchemaIndex, doc []byte) ([]byte, error) {
+	// copy, because getSchemaWithFallback can change index
+	i := *index
+
+	schema := v.getSchemaWithFallback(&i)
+	if schema == nil {
+		return nil, fmt.Errorf("%w: cannot redact secrets for %s", ErrSchemaNotFound, index.String())
+	}
+
+	return RedactSecretsWithSchema(schema, doc)
+}
+
+// RedactSecretsWithSchema
+// like Validator.RedactSecrets but use passed schema
+func RedactSecretsWithSchema(schema *spec.Schema, doc []byte) ([]byte, error) {
+	var obj any
+	if err := yaml.Unmarshal(doc, &obj); err != nil {
+		return nil, fmt.Errorf("%w: cannot unmarshal document for redact: %w", ErrKindInvalidYAML, err)
+	}
+
+	redacted, err := yaml.Marshal(redactValue(schema, obj))
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal redacted document: %w", err)
+	}
+
+	return redacted, nil
+}
+
+func isSecretSchema(schema *spec.Schema) bool {
+	if schema.Format == passwordFormat {
+		return true
+	}
+
+	for _, ext := range []string{xSecretExtension, xSensitiveExtension} {
+		if v, ok := schema.Extensions.GetBool(ext); ok && v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func redactValue(schema *spec.Schema, value any) any {
+	if schema == nil || value == nil {
+		return value
+	}
+
+	if isSecretSchema(schema) {
+		return RedactedValue
+	}
+
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, val := range typed {
+			if prop := propertySchema(schema, key); prop != nil {
+				typed[key] = redactValue(prop, val)
+				continue
+			}
+
+			if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+				typed[key] = redactValue(schema.AdditionalProperties.Schema, val)
+			}
+		}
+	case []any:
+		if schema.Items == nil {
+			return typed
+		}
+
+		for i, item := range typed {
+			itemSchema := schema.Items.Schema
+			if itemSchema == nil && i < len(schema.Items.Schemas) {
+				itemSchema = &schema.Items.Schemas[i]
+			}
+
+			typed[i] = redactValue(itemSchema, item)
+		}
+	}
+
+	return value
+}
+
+// propertySchema
+// search property schema in properties and in allOf, anyOf and oneOf branches
+func propertySchema(schema *spec.Schema, key string) *spec.Schema {
+	if prop, ok := schema.Properties[key]; ok {
+		return &prop
+	}
+
+	for _, branches := range [][]spec.Schema{schema.AllOf, schema.AnyOf, schema.OneOf} {
+		for i := range branches {
+			if prop := propertySchema(&branches[i], key); prop != nil {
+				return prop
+			}
+		}
+	}
+
+	return nil
+}
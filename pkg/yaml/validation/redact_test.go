@@ -0,0 +1,95 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+func TestRedactSecrets(t *testing.T) {
+	const schema = `
+kind: SecretKind
+apiVersions:
+- apiVersion: deckhouse.io/v1
+  openAPISpec:
+    type: object
+    properties:
+      sshUser:
+        type: string
+      sudoPassword:
+        type: string
+        format: password
+      sshAgentPrivateKeys:
+        type: array
+        items:
+          type: object
+          properties:
+            key:
+              type: string
+              x-secret: true
+            passphrase:
+              type: string
+              x-sensitive: true
+      registry:
+        type: object
+        additionalProperties:
+          type: string
+          x-secret: true
+`
+	validator := NewValidator(nil)
+	err := validator.LoadSchemas(strings.NewReader(schema))
+	require.NoError(t, err)
+
+	doc := `
+apiVersion: deckhouse.io/v1
+kind: SecretKind
+sshUser: ubuntu
+sudoPassword: "secret"
+sshAgentPrivateKeys:
+- key: "private"
+  passphrase: "pass"
+- key: "private2"
+registry:
+  auth: "token"
+`
+	index := &SchemaIndex{Kind: "SecretKind", Version: "deckhouse.io/v1"}
+
+	redacted, err := validator.RedactSecrets(index, []byte(doc))
+	require.NoError(t, err)
+
+	result := make(map[string]any)
+	err = yaml.Unmarshal(redacted, &result)
+	require.NoError(t, err)
+
+	require.Equal(t, "ubuntu", result["sshUser"])
+	require.Equal(t, RedactedValue, result["sudoPassword"])
+	require.Equal(t, []any{
+		map[string]any{"key": RedactedValue, "passphrase": RedactedValue},
+		map[string]any{"key": RedactedValue},
+	}, result["sshAgentPrivateKeys"])
+	require.Equal(t, map[string]any{"auth": RedactedValue}, result["registry"])
+
+	require.NotContains(t, string(redacted), "secret")
+	require.Contains(t, doc, "secret", "should not change input")
+
+	t.Run("schema not found", func(t *testing.T) {
+		_, err := validator.RedactSecrets(&indexTestKind, []byte(doc))
+		require.ErrorIs(t, err, ErrSchemaNotFound)
+	})
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/go-openapi/spec"
+)
+
+const xRequiredWhenExtension = "x-required-when"
+
+// RequiredWhenRule
+// rule of x-required-when extension declared on object schema:
+//
+//	x-required-when:
+//	- field: mode
+//	  equals: Static
+//	  required: [host, port]
+//
+// Required fields of object are required when Field of the same object equals Equals
+// if Equals is not set, Required fields are required when Field is present and not null
+type RequiredWhenRule struct {
+	Field    string   `json:"field"`
+	Equals   any      `json:"equals,omitempty"`
+	Required []string `json:"required"`
+}
+
+// RequiredWhenError
+// returned as one of document validation errors when x-required-when rule failed
+type RequiredWhenError struct {
+	// Path
+	// path to object with rule, empty for document root
+	Path    string
+	Rule    RequiredWhenRule
+	Missing []string
+
+	message string
+}
+
+func (e *RequiredWhenError) Error() string {
+	return e.message
+}
+
+// requiredWhenViolations
+// walks schema with data and checks all x-required-when rules
+func requiredWhenViolations(messages *Messages, schema *spec.Schema, data any, path string) []error {
+	if schema == nil {
+		return nil
+	}
+
+	res := make([]error, 0)
+
+	switch typed := data.(type) {
+	case map[string]any:
+		res = append(res, checkRequiredWhen(messages, schema, typed, path)...)
+
+		fields := make([]string, 0, len(schema.Properties))
+		for field := range schema.Properties {
+			fields = append(fields, field)
+		}
+		// sort for stable errors
+		slices.Sort(fields)
+
+		for _, field := range fields {
+			value, ok := typed[field]
+			if !ok {
+				continue
+			}
+
+			prop := schema.Properties[field]
+			res = append(res, requiredWhenViolations(messages, &prop, value, fieldPath(path, field))...)
+		}
+	case []any:
+		if schema.Items != nil && schema.Items.Schema != nil {
+			for i, item := range typed {
+				res = append(res, requiredWhenViolations(messages, schema.Items.Schema, item, itemPath(path, i))...)
+			}
+		}
+	}
+
+	return res
+}
+
+func checkRequiredWhen(messages *Messages, schema *spec.Schema, obj map[string]any, path string) []error {
+	raw, ok := schema.Extensions[xRequiredWhenExtension]
+	if !ok || raw == nil {
+		return nil
+	}
+
+	rules, err := parseRequiredWhenRules(raw)
+	if err != nil {
+		return []error{fmt.Errorf("%s: invalid %s extension: %w", pathOrRoot(path), xRequiredWhenExtension, err)}
+	}
+
+	res := make([]error, 0)
+
+	for _, rule := range rules {
+		value, ok := obj[rule.Field]
+		if !ok || value == nil {
+			continue
+		}
+
+		if rule.Equals != nil && !reflect.DeepEqual(rule.Equals, numberValue(value)) {
+			continue
+		}
+
+		missing := make([]string, 0)
+		for _, field := range rule.Required {
+			if v, ok := obj[field]; !ok || v == nil {
+				missing = append(missing, field)
+			}
+		}
+
+		if len(missing) == 0 {
+			continue
+		}
+
+		res = append(res, &RequiredWhenError{
+			Path:    path,
+			Rule:    rule,
+			Missing: missing,
+			message: messages.Format(MessageRequiredWhen, RequiredWhenMessageData{
+				Path:     pathOrRoot(path),
+				Field:    rule.Field,
+				Value:    rule.Equals,
+				HasValue: rule.Equals != nil,
+				Missing:  missing,
+			}),
+		})
+	}
+
+	return res
+}
+
+func parseRequiredWhenRules(raw any) ([]RequiredWhenRule, error) {
+	content, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []RequiredWhenRule
+	if err := json.Unmarshal(content, &rules); err != nil {
+		return nil, err
+	}
+
+	for i, rule := range rules {
+		if rule.Field == "" || len(rule.Required) == 0 {
+			return nil, fmt.Errorf("rule #%d should contain field and required", i+1)
+		}
+	}
+
+	return rules, nil
+}
+
+func pathOrRoot(path string) string {
+	if path == "" {
+		return "<root>"
+	}
+
+	return path
+}
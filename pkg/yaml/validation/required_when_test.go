@@ -0,0 +1,167 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequiredWhen(t *testing.T) {
+	const schemas = `
+kind: RequiredWhenKind
+apiVersions:
+- apiVersion: deckhouse.io/v1
+  openAPISpec:
+    type: object
+    x-required-when:
+    - field: mode
+      equals: Static
+      required: [host, port]
+    - field: bastionHost
+      required: [bastionUser]
+    properties:
+      apiVersion:
+        type: string
+      kind:
+        type: string
+      mode:
+        type: string
+        default: Static
+      host:
+        type: string
+      port:
+        type: integer
+      bastionHost:
+        type: string
+      bastionUser:
+        type: string
+      nodes:
+        type: array
+        items:
+          type: object
+          x-required-when:
+          - field: count
+            equals: 0
+            required: [reason]
+          properties:
+            count:
+              type: integer
+            reason:
+              type: string
+`
+
+	validator := NewValidator(nil).SetLogger(testGetLogger())
+	err := validator.LoadSchemas(strings.NewReader(schemas))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		doc    string
+		errors []string
+	}{
+		{
+			name: "valid",
+			doc: `
+mode: Static
+host: 127.0.0.1
+port: 22
+`,
+		},
+		{
+			name: "equals not matched",
+			doc: `
+mode: Cloud
+`,
+		},
+		{
+			name: "default value matched",
+			doc: `
+host: 127.0.0.1
+`,
+			errors: []string{"<root>: port required when mode is Static"},
+		},
+		{
+			name: "present",
+			doc: `
+mode: Cloud
+bastionHost: 127.0.0.1
+`,
+			errors: []string{"<root>: bastionUser required when bastionHost is set"},
+		},
+		{
+			name: "nested with schema violation",
+			doc: `
+mode: Static
+port: "22"
+nodes:
+- count: 0
+`,
+			errors: []string{
+				"port must be of type integer",
+				"<root>: host required when mode is Static",
+				"nodes[0]: reason required when count is 0",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			doc := []byte("apiVersion: deckhouse.io/v1\nkind: RequiredWhenKind\n" + test.doc)
+			_, err := validator.Validate(&doc, ValidateWithNoPrettyError(true))
+
+			if len(test.errors) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, ErrDocumentValidationFailed)
+			for _, e := range test.errors {
+				require.Contains(t, err.Error(), e)
+			}
+
+			var requiredWhenErr *RequiredWhenError
+			require.ErrorAs(t, err, &requiredWhenErr)
+		})
+	}
+
+	t.Run("invalid rule", func(t *testing.T) {
+		invalid := strings.Replace(schemas, "required: [bastionUser]", "required: []", 1)
+
+		validator := NewValidator(nil).SetLogger(testGetLogger())
+		require.NoError(t, validator.LoadSchemas(strings.NewReader(invalid)))
+
+		doc := []byte("apiVersion: deckhouse.io/v1\nkind: RequiredWhenKind\nmode: Cloud\n")
+		_, err := validator.Validate(&doc)
+		require.ErrorIs(t, err, ErrDocumentValidationFailed)
+		require.Contains(t, err.Error(), "invalid x-required-when extension")
+	})
+
+	t.Run("override message", func(t *testing.T) {
+		messages, err := NewMessages(map[MessageID]string{
+			MessageRequiredWhen: `{{ .Path }}: укажите {{ join .Missing ", " }}`,
+		})
+		require.NoError(t, err)
+
+		validator := NewValidator(nil).SetLogger(testGetLogger()).SetMessages(messages)
+		require.NoError(t, validator.LoadSchemas(strings.NewReader(schemas)))
+
+		doc := []byte("apiVersion: deckhouse.io/v1\nkind: RequiredWhenKind\nmode: Cloud\nbastionHost: host\n")
+		_, err = validator.Validate(&doc)
+		require.ErrorContains(t, err, "<root>: укажите bastionUser")
+	})
+}
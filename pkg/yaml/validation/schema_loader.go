@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"io"
 
+	libyaml "github.com/deckhouse/lib-dhctl/pkg/yaml"
 	"github.com/deckhouse/lib-dhctl/pkg/yaml/validation/transformer"
 
 	"github.com/go-openapi/spec"
@@ -40,12 +41,36 @@ type SchemaWithIndex struct {
 	Index  SchemaIndex
 }
 
-func LoadSchemas(reader io.Reader) ([]*SchemaWithIndex, error) {
+type loadSchemasOptions struct {
+	limits libyaml.Limits
+}
+
+type LoadSchemasOption func(o *loadSchemasOptions)
+
+// LoadSchemasWithLimits
+// check content with limits before unmarshal
+// if any limit exceeded returns ErrLimitExceeded
+func LoadSchemasWithLimits(limits libyaml.Limits) LoadSchemasOption {
+	return func(o *loadSchemasOptions) {
+		o.limits = limits
+	}
+}
+
+func LoadSchemas(reader io.Reader, opts ...LoadSchemasOption) ([]*SchemaWithIndex, error) {
+	options := &loadSchemasOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
 	fileContent, err := io.ReadAll(reader)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrRead, err)
 	}
 
+	if err := checkLimits(fileContent, options.limits); err != nil {
+		return nil, err
+	}
+
 	openAPISchema := new(OpenAPISchema)
 	if err := yaml.UnmarshalStrict(fileContent, openAPISchema); err != nil {
 		return nil, fmt.Errorf("Failed unmarshal openapi schema: %v", err)
@@ -56,7 +81,7 @@ func LoadSchemas(reader io.Reader) ([]*SchemaWithIndex, error) {
 	for _, parsedSchema := range openAPISchema.Versions {
 		schema := new(spec.Schema)
 
-		d, err := json.Marshal(parsedSchema.Schema)
+		d, err := json.Marshal(convertOpenAPI3Schema(parsedSchema.Schema))
 		if err != nil {
 			return nil, fmt.Errorf("expand the schema: %v", err)
 		}
@@ -0,0 +1,95 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schemas
+// contains basic dhctl configuration schemas
+// (ClusterConfiguration, InitConfiguration, StaticClusterConfiguration, SSHConfig, SSHHost)
+// embedded into binary.
+// schemas are not synced with candi and dhctl sources of deckhouse and describe only main fields,
+// values like kubernetes versions are not restricted. Load schemas from deckhouse sources for strict validation
+package schemas
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+
+	"github.com/deckhouse/lib-dhctl/pkg/log"
+	"github.com/deckhouse/lib-dhctl/pkg/yaml/validation"
+)
+
+const schemasDir = "openapi"
+
+//go:embed openapi/*.yaml
+var files embed.FS
+
+var (
+	ClusterConfiguration       = validation.SchemaIndex{Kind: "ClusterConfiguration", Version: "deckhouse.io/v1"}
+	InitConfiguration          = validation.SchemaIndex{Kind: "InitConfiguration", Version: "deckhouse.io/v1"}
+	StaticClusterConfiguration = validation.SchemaIndex{Kind: "StaticClusterConfiguration", Version: "deckhouse.io/v1"}
+	SSHConfig                  = validation.SchemaIndex{Kind: "SSHConfig", Version: "dhctl.deckhouse.io/v1"}
+	SSHHost                    = validation.SchemaIndex{Kind: "SSHHost", Version: "dhctl.deckhouse.io/v1"}
+)
+
+// FS
+// returns file system with embedded schemas files
+func FS() fs.FS {
+	sub, err := fs.Sub(files, schemasDir)
+	if err != nil {
+		// cannot be, directory is embedded
+		panic(err)
+	}
+
+	return sub
+}
+
+// Load
+// loads all embedded schemas into validator
+func Load(validator *validation.Validator) error {
+	schemasFS := FS()
+
+	names, err := fs.Glob(schemasFS, "*.yaml")
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		content, err := fs.ReadFile(schemasFS, name)
+		if err != nil {
+			return fmt.Errorf("cannot read embedded schema %s: %w", name, err)
+		}
+
+		if err := validator.LoadSchemas(bytes.NewReader(content)); err != nil {
+			return fmt.Errorf("cannot load embedded schema %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// NewDefaultValidator
+// returns validator preloaded with all embedded schemas
+func NewDefaultValidator() (*validation.Validator, error) {
+	return NewDefaultValidatorWithLogger(log.SilentLoggerProvider())
+}
+
+func NewDefaultValidatorWithLogger(loggerProvider log.LoggerProvider) (*validation.Validator, error) {
+	validator := validation.NewValidatorWithLogger(nil, loggerProvider)
+	if err := Load(validator); err != nil {
+		return nil, err
+	}
+
+	return validator, nil
+}
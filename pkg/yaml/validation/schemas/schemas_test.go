@@ -0,0 +1,107 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemas
+
+import (
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	"github.com/deckhouse/lib-dhctl/pkg/yaml/validation"
+)
+
+func TestNewDefaultValidator(t *testing.T) {
+	validator, err := NewDefaultValidator()
+	require.NoError(t, err)
+
+	indexes := []validation.SchemaIndex{
+		ClusterConfiguration,
+		InitConfiguration,
+		StaticClusterConfiguration,
+		SSHConfig,
+		SSHHost,
+	}
+
+	for _, index := range indexes {
+		t.Run(index.String(), func(t *testing.T) {
+			schema := validator.Get(&index)
+			require.NotNil(t, schema)
+
+			examples, ok := schema.Extensions["x-examples"].([]any)
+			require.True(t, ok, "schema should contain examples")
+			require.NotEmpty(t, examples)
+
+			for _, example := range examples {
+				doc, err := yaml.Marshal(example)
+				require.NoError(t, err)
+
+				validatedIndex, err := validator.Validate(&doc)
+				require.NoError(t, err, "example should be valid:\n%s", string(doc))
+				require.Equal(t, index, *validatedIndex)
+			}
+		})
+	}
+
+	t.Run("invalid document", func(t *testing.T) {
+		doc := []byte(`
+apiVersion: deckhouse.io/v1
+kind: ClusterConfiguration
+clusterType: Unknown
+`)
+		_, err := validator.Validate(&doc)
+		require.ErrorIs(t, err, validation.ErrDocumentValidationFailed)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		doc := []byte(`
+apiVersion: dhctl.deckhouse.io/v1
+kind: SSHConfig
+sshUser: ubuntu
+sudoPassword: password
+`)
+		_, err := validator.Validate(&doc)
+		require.NoError(t, err)
+
+		var cfg map[string]any
+		require.NoError(t, yaml.Unmarshal(doc, &cfg))
+		require.Equal(t, float64(22), cfg["sshPort"])
+	})
+
+	t.Run("unknown kubernetes version", func(t *testing.T) {
+		doc := []byte(`
+apiVersion: deckhouse.io/v1
+kind: ClusterConfiguration
+clusterType: Static
+kubernetesVersion: "1.99"
+podSubnetCIDR: 10.111.0.0/16
+serviceSubnetCIDR: 10.222.0.0/16
+clusterDomain: cluster.local
+`)
+		_, err := validator.Validate(&doc)
+		require.NoError(t, err)
+	})
+
+	t.Run("self-check examples", func(t *testing.T) {
+		require.NoError(t, validator.ValidateExamples())
+	})
+
+	t.Run("fs", func(t *testing.T) {
+		names, err := fs.Glob(FS(), "*.yaml")
+		require.NoError(t, err)
+		require.Len(t, names, len(indexes))
+	})
+}
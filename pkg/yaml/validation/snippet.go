@@ -0,0 +1,201 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	oaerrors "github.com/go-openapi/errors"
+	"github.com/gookit/color"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	snippetGutterStyle = color.New(color.Gray)
+	snippetCaretStyle  = color.New(color.FgRed, color.Bold)
+)
+
+// RenderSourceSnippet
+// renders line of doc which contains field by path with caret under field and message:
+//
+//	5 | sshPort: "22"
+//	  |          ^ sshPort must be of type integer: "string"
+//
+// path is dot separated fields and list indexes like "sshAgentPrivateKeys.0.key"
+// if field not found, parent field is used. If no any field found returns message only
+// if colored is true, gutter and caret are colored like in Pretty logger
+func RenderSourceSnippet(doc []byte, path, message string, colored bool) string {
+	lines, ok := sourceSnippetLines(doc, path, message, colored)
+	if !ok {
+		return message
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// sourceSnippetLines
+// returns source line and caret line with message
+// returns false if field and its parents not found in doc
+func sourceSnippetLines(doc []byte, path, message string, colored bool) ([]string, bool) {
+	root := &yaml.Node{}
+	if err := yaml.Unmarshal(doc, root); err != nil {
+		return nil, false
+	}
+
+	node := findSnippetNode(root, splitSnippetPath(path))
+	if node == nil || node.Line == 0 {
+		return nil, false
+	}
+
+	docLines := strings.Split(string(doc), "\n")
+	if node.Line > len(docLines) {
+		return nil, false
+	}
+
+	style := func(s color.Style, text string) string {
+		if !colored {
+			return text
+		}
+		return s.Sprint(text)
+	}
+
+	lineNumber := strconv.Itoa(node.Line)
+	gutter := strings.Repeat(" ", len(lineNumber))
+
+	caret := "^"
+	if message != "" {
+		caret += " " + message
+	}
+
+	return []string{
+		style(snippetGutterStyle, lineNumber+" | ") + docLines[node.Line-1],
+		style(snippetGutterStyle, gutter+" | ") + strings.Repeat(" ", max(node.Column-1, 0)) + style(snippetCaretStyle, caret),
+	}, true
+}
+
+// renderSchemaViolationSnippet
+// renders message for go-openapi validation error with source snippet
+// for other errors returns message as is
+func renderSchemaViolationSnippet(doc []byte, err error, message string, colored bool) error {
+	var validationErr *oaerrors.Validation
+	if !errors.As(err, &validationErr) {
+		return errors.New(message)
+	}
+
+	path := validationErr.Name
+	if validationErr.Code() == oaerrors.UnallowedPropertyCode {
+		if key, ok := validationErr.Value.(string); ok {
+			path = path + "." + key
+		}
+	}
+
+	lines, ok := sourceSnippetLines(doc, path, "", colored)
+	if !ok {
+		return errors.New(message)
+	}
+
+	// indent snippet for multierror list
+	return errors.New(message + "\n\t\t" + strings.Join(lines, "\n\t\t"))
+}
+
+func splitSnippetPath(path string) []string {
+	path = strings.Trim(path, ".")
+	if path == "" {
+		return nil
+	}
+
+	return strings.Split(path, ".")
+}
+
+// findSnippetNode
+// returns value node for scalars, key node for maps and lists
+// if path not found returns deepest found node
+func findSnippetNode(root *yaml.Node, path []string) *yaml.Node {
+	current := root
+	if current.Kind == yaml.DocumentNode {
+		if len(current.Content) == 0 {
+			return nil
+		}
+		current = current.Content[0]
+	}
+
+	found := current
+	for i := 0; i < len(path); i++ {
+		segment := path[i]
+
+		if current.Kind == yaml.AliasNode && current.Alias != nil {
+			current = current.Alias
+		}
+
+		switch current.Kind {
+		case yaml.MappingNode:
+			var key, value *yaml.Node
+			for j := 0; j+1 < len(current.Content); j += 2 {
+				if current.Content[j].Value == segment {
+					key, value = current.Content[j], current.Content[j+1]
+					break
+				}
+			}
+
+			if key == nil {
+				return found
+			}
+
+			found = value
+			if value.Kind != yaml.ScalarNode {
+				found = key
+			}
+
+			current = value
+		case yaml.SequenceNode:
+			index, err := strconv.Atoi(segment)
+			if err != nil {
+				// go-openapi does not add item index to path for some errors,
+				// use first item which contains field and process segment again for this item
+				index = sequenceItemWithKey(current, segment)
+				i--
+			}
+
+			if index < 0 || index >= len(current.Content) {
+				return found
+			}
+
+			current = current.Content[index]
+			found = current
+		default:
+			return found
+		}
+	}
+
+	return found
+}
+
+func sequenceItemWithKey(sequence *yaml.Node, key string) int {
+	for i, item := range sequence.Content {
+		if item.Kind != yaml.MappingNode {
+			continue
+		}
+
+		for j := 0; j+1 < len(item.Content); j += 2 {
+			if item.Content[j].Value == key {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourceSnippets(t *testing.T) {
+	const doc = `apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+sudoPassword: password
+sshPort: "22"
+sshAgentPrivateKeys:
+- key: key
+  unknown: field
+`
+
+	t.Run("render", func(t *testing.T) {
+		tests := []struct {
+			path     string
+			expected string
+		}{
+			{
+				path:     "sshPort",
+				expected: "5 | sshPort: \"22\"\n  |          ^ message",
+			},
+			{
+				path:     "sshAgentPrivateKeys.0.unknown",
+				expected: "8 |   unknown: field\n  |            ^ message",
+			},
+			{
+				path:     "sshAgentPrivateKeys",
+				expected: "6 | sshAgentPrivateKeys:\n  | ^ message",
+			},
+			{
+				path:     "sshAgentPrivateKeys.0.absent",
+				expected: "7 | - key: key\n  |   ^ message",
+			},
+			{
+				path:     "sshAgentPrivateKeys.unknown",
+				expected: "8 |   unknown: field\n  |            ^ message",
+			},
+			{
+				path:     "",
+				expected: "1 | apiVersion: deckhouse.io/v1\n  | ^ message",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.path, func(t *testing.T) {
+				require.Equal(t, tt.expected, RenderSourceSnippet([]byte(doc), tt.path, "message", false))
+			})
+		}
+	})
+
+	t.Run("invalid yaml", func(t *testing.T) {
+		require.Equal(t, "message", RenderSourceSnippet([]byte("a: [1"), "a", "message", false))
+	})
+
+	t.Run("colored", func(t *testing.T) {
+		res := RenderSourceSnippet([]byte(doc), "sshPort", "message", true)
+		require.Contains(t, res, "sshPort: \"22\"")
+		require.Contains(t, res, "^ message")
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		validator := NewValidator(nil).SetLogger(testGetLogger())
+		require.NoError(t, validator.LoadSchemas(strings.NewReader(testSchemaTestKind)))
+
+		docForValidate := []byte(doc)
+		_, err := validator.Validate(&docForValidate, ValidateWithSourceSnippets(true, false))
+		require.ErrorIs(t, err, ErrDocumentValidationFailed)
+
+		msg := err.Error()
+		require.NotContains(t, msg, "Document validation failed", "should not dump whole document")
+		require.Contains(t, msg, "sshPort must be of type integer: \"string\"\n\t\t5 | sshPort: \"22\"\n\t\t  |          ^")
+		require.Contains(t, msg, "sshAgentPrivateKeys.unknown is a forbidden property\n\t\t8 |   unknown: field\n\t\t  |            ^")
+	})
+}
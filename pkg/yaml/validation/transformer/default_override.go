@@ -0,0 +1,135 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transformer
+
+import (
+	"maps"
+	"strings"
+
+	"github.com/go-openapi/spec"
+)
+
+const itemsPathSuffix = "[]"
+
+// DefaultOverrideTransformer
+// overrides or strips default values in schema by fields paths
+// path is dot separated fields, list items are marked with [] suffix,
+// for example "registry.host" or "sshAgentPrivateKeys[].passphrase"
+// leading "$." is allowed. Paths not present in schema are skipped
+type DefaultOverrideTransformer struct {
+	overrides map[string]any
+	strips    map[string]struct{}
+}
+
+func NewDefaultOverrideTransformer() *DefaultOverrideTransformer {
+	return &DefaultOverrideTransformer{
+		overrides: make(map[string]any),
+		strips:    make(map[string]struct{}),
+	}
+}
+
+// Override
+// set default value for field by path
+func (t *DefaultOverrideTransformer) Override(path string, value any) *DefaultOverrideTransformer {
+	path = normalizeDefaultPath(path)
+
+	delete(t.strips, path)
+	t.overrides[path] = value
+
+	return t
+}
+
+// Strip
+// remove default value for field by path
+func (t *DefaultOverrideTransformer) Strip(path string) *DefaultOverrideTransformer {
+	path = normalizeDefaultPath(path)
+
+	delete(t.overrides, path)
+	t.strips[path] = struct{}{}
+
+	return t
+}
+
+// Transform returns copy of schema with set or removed defaults.
+// passed schema is not changed, unchanged subschemas are shared with passed schema
+func (t *DefaultOverrideTransformer) Transform(s *spec.Schema) *spec.Schema {
+	if s == nil {
+		return nil
+	}
+
+	res := *s
+
+	for path, value := range t.overrides {
+		res = withUpdatedField(res, splitDefaultPath(path), func(field *spec.Schema) {
+			field.Default = value
+		})
+	}
+
+	for path := range t.strips {
+		res = withUpdatedField(res, splitDefaultPath(path), func(field *spec.Schema) {
+			field.Default = nil
+		})
+	}
+
+	return &res
+}
+
+func normalizeDefaultPath(path string) string {
+	path = strings.TrimPrefix(path, "$")
+	return strings.TrimPrefix(path, ".")
+}
+
+func splitDefaultPath(path string) []string {
+	if path == "" {
+		return nil
+	}
+
+	return strings.Split(path, ".")
+}
+
+// withUpdatedField
+// returns copy of schema with updated field, properties and items on path to field are copied
+// returns schema as is if path is not present in schema
+func withUpdatedField(s spec.Schema, segments []string, update func(*spec.Schema)) spec.Schema {
+	if len(segments) == 0 {
+		update(&s)
+		return s
+	}
+
+	field, isItems := strings.CutSuffix(segments[0], itemsPathSuffix)
+
+	prop, ok := s.Properties[field]
+	if !ok {
+		return s
+	}
+
+	if isItems {
+		if prop.Items == nil || prop.Items.Schema == nil {
+			return s
+		}
+
+		item := withUpdatedField(*prop.Items.Schema, segments[1:], update)
+		items := *prop.Items
+		items.Schema = &item
+		prop.Items = &items
+	} else {
+		prop = withUpdatedField(prop, segments[1:], update)
+	}
+
+	s.Properties = maps.Clone(s.Properties)
+	s.Properties[field] = prop
+
+	return s
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transformer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultOverrideTransformer(t *testing.T) {
+	const schemaJSON = `{
+  "type": "object",
+  "properties": {
+    "registry": {
+      "type": "object",
+      "properties": {
+        "host": {"type": "string", "default": "registry.deckhouse.io"},
+        "scheme": {"type": "string", "default": "https"}
+      }
+    },
+    "sshAgentPrivateKeys": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "passphrase": {"type": "string", "default": "secret"}
+        }
+      }
+    }
+  }
+}`
+
+	schema := new(spec.Schema)
+	require.NoError(t, json.Unmarshal([]byte(schemaJSON), schema))
+
+	res := NewDefaultOverrideTransformer().
+		Override("$.registry.host", "registry.local").
+		Override("registry.port", 5000).
+		Strip("sshAgentPrivateKeys[].passphrase").
+		Strip("registry.scheme").
+		Override("registry.scheme", "http").
+		Transform(schema)
+
+	registry := res.Properties["registry"]
+	require.Equal(t, "registry.local", registry.Properties["host"].Default)
+	require.Equal(t, "http", registry.Properties["scheme"].Default)
+	require.NotContains(t, registry.Properties, "port", "should skip not existing path")
+
+	keys := res.Properties["sshAgentPrivateKeys"]
+	require.Nil(t, keys.Items.Schema.Properties["passphrase"].Default)
+
+	// passed schema is not changed
+	origin := schema.Properties["registry"]
+	require.Equal(t, "registry.deckhouse.io", origin.Properties["host"].Default)
+	require.Equal(t, "https", origin.Properties["scheme"].Default)
+	require.Equal(t, "secret", schema.Properties["sshAgentPrivateKeys"].Items.Schema.Properties["passphrase"].Default)
+
+	require.Nil(t, NewDefaultOverrideTransformer().Transform(nil))
+}
@@ -16,11 +16,15 @@ package validation
 
 import (
 	"bytes"
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"slices"
+	"sync"
 
 	"github.com/deckhouse/lib-dhctl/pkg/log"
+	libyaml "github.com/deckhouse/lib-dhctl/pkg/yaml"
 	"github.com/deckhouse/lib-dhctl/pkg/yaml/validation/transformer"
 
 	"github.com/go-openapi/spec"
@@ -29,17 +33,35 @@ import (
 	"github.com/go-openapi/validate/post"
 	"github.com/hashicorp/go-multierror"
 	"github.com/name212/govalue"
-	"sigs.k8s.io/yaml"
 )
 
 type validateOptions struct {
 	omitDocInError  bool
 	strictUnmarshal bool
 	noPrettyError   bool
+
+	collectUnknownKinds bool
+
+	sourceSnippets        bool
+	sourceSnippetsColored bool
+
+	preserveFormat  bool
+	preserveNumbers bool
+
+	defaultedPaths *[]string
 }
 
 type ValidateOption func(o *validateOptions)
 
+func newValidateOptions(opts ...ValidateOption) *validateOptions {
+	options := &validateOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return options
+}
+
 func ValidateWithOmitDocInError(v bool) ValidateOption {
 	return func(o *validateOptions) {
 		o.omitDocInError = v
@@ -58,6 +80,26 @@ func ValidateWithNoPrettyError(v bool) ValidateOption {
 	}
 }
 
+// ValidateWithCollectUnknownKinds
+// used only in ValidateDocuments
+// documents without schema will be collected as resources instead of fail
+func ValidateWithCollectUnknownKinds(v bool) ValidateOption {
+	return func(o *validateOptions) {
+		o.collectUnknownKinds = v
+	}
+}
+
+// ValidateWithSourceSnippets
+// render every schema violation with source line and caret under failed field
+// instead of dumping whole document into error
+// if colored is true, snippets are colored like in Pretty logger
+func ValidateWithSourceSnippets(v bool, colored bool) ValidateOption {
+	return func(o *validateOptions) {
+		o.sourceSnippets = v
+		o.sourceSnippetsColored = colored
+	}
+}
+
 type PreValidator interface {
 	// Validate
 	// if validator does not provide our own schema please return nil
@@ -65,14 +107,47 @@ type PreValidator interface {
 	Validate(doc []byte, logger log.Logger) (*spec.Schema, error)
 }
 
+// ContextPreValidator
+// PreValidator which can receive ctx passed to ValidateContext and another context-aware methods
+// if PreValidator implements ContextPreValidator, ValidateContext will be called instead of Validate
+type ContextPreValidator interface {
+	PreValidator
+	ValidateContext(ctx context.Context, doc []byte, logger log.Logger) (*spec.Schema, error)
+}
+
+func runPreValidator(ctx context.Context, preValidator PreValidator, doc []byte, logger log.Logger) (*spec.Schema, error) {
+	if withContext, ok := preValidator.(ContextPreValidator); ok {
+		return withContext.ValidateContext(ctx, doc, logger)
+	}
+
+	return preValidator.Validate(doc, logger)
+}
+
 type Validator struct {
-	schemas              map[SchemaIndex]*spec.Schema
-	preValidators        map[SchemaIndex]PreValidator
-	loggerProvider       log.LoggerProvider
-	versionFallbacks     map[string]string
-	transformers         map[SchemaIndex][]transformer.SchemaTransformer
-	defaultTransformers  []transformer.SchemaTransformer
-	extensionsValidators []*ExtensionsValidator
+	schemas                 map[SchemaIndex]*spec.Schema
+	preValidators           map[SchemaIndex][]PreValidator
+	defaultPreValidators    []PreValidator
+	loggerProvider          log.LoggerProvider
+	versionFallbacks        map[string]string
+	versionFallbackFuncs    []VersionFallbackFunc
+	kindAliases             map[string]string
+	transformers            map[SchemaIndex][]transformer.SchemaTransformer
+	defaultTransformers     []transformer.SchemaTransformer
+	conditionalTransformers []conditionalTransformers
+	extensionsValidators    []*ExtensionsValidator
+	limits                  libyaml.Limits
+	messages                *Messages
+	instrumenter            Instrumenter
+	decryptors              []Decryptor
+	cache                   *ValidationCache
+	bestVersionNegotiation  bool
+
+	// transformers are applied to schema copy, because schemas are read concurrently
+	// and shared with clones. mutex is shared with clones, because transformers are shared
+	// and can be not safe for concurrent use
+	transformMutex *sync.Mutex
+	// schemas can be reloaded with WatchSchemasDir
+	schemasMutex sync.RWMutex
 }
 
 func NewValidator(schemas map[SchemaIndex]*spec.Schema) *Validator {
@@ -86,23 +161,34 @@ func NewValidatorWithLogger(schemas map[SchemaIndex]*spec.Schema, loggerProvider
 	return &Validator{
 		schemas:        schemas,
 		loggerProvider: loggerProvider,
-		preValidators:  make(map[SchemaIndex]PreValidator),
+		preValidators:  make(map[SchemaIndex][]PreValidator),
+		transformMutex: &sync.Mutex{},
 		versionFallbacks: map[string]string{
 			"deckhouse.io/v1alpha1": "deckhouse.io/v1",
 		},
-		defaultTransformers:  make([]transformer.SchemaTransformer, 0),
-		transformers:         make(map[SchemaIndex][]transformer.SchemaTransformer),
-		extensionsValidators: make([]*ExtensionsValidator, 0),
+		versionFallbackFuncs:    make([]VersionFallbackFunc, 0),
+		defaultPreValidators:    make([]PreValidator, 0),
+		kindAliases:             make(map[string]string),
+		defaultTransformers:     make([]transformer.SchemaTransformer, 0),
+		transformers:            make(map[SchemaIndex][]transformer.SchemaTransformer),
+		conditionalTransformers: make([]conditionalTransformers, 0),
+		extensionsValidators:    make([]*ExtensionsValidator, 0),
+		decryptors:              make([]Decryptor, 0),
 	}
 }
 
 func (v *Validator) AddSchema(index SchemaIndex, schema *spec.Schema) *Validator {
+	v.schemasMutex.Lock()
+	defer v.schemasMutex.Unlock()
+
 	v.schemas[index] = schema
+	v.purgeCache()
+
 	return v
 }
 
 func (v *Validator) LoadSchemas(reader io.Reader) error {
-	schemas, err := LoadSchemas(reader)
+	schemas, err := LoadSchemas(reader, LoadSchemasWithLimits(v.limits))
 	if err != nil {
 		return err
 	}
@@ -114,8 +200,20 @@ func (v *Validator) LoadSchemas(reader io.Reader) error {
 	return nil
 }
 
-func (v *Validator) AddPreValidator(index SchemaIndex, validator PreValidator) *Validator {
-	v.preValidators[index] = validator
+// AddPreValidator
+// add prevalidators for index. Prevalidators for index are called in order of adding
+// after default prevalidators. Every prevalidator can swap schema,
+// schema returned by last prevalidator is used for validation
+func (v *Validator) AddPreValidator(index SchemaIndex, validators ...PreValidator) *Validator {
+	v.preValidators[index] = append(v.preValidators[index], validators...)
+	return v
+}
+
+// AddDefaultPreValidator
+// add prevalidators called for all indexes before prevalidators added for index
+// they are called for documents without schema also
+func (v *Validator) AddDefaultPreValidator(validators ...PreValidator) *Validator {
+	v.defaultPreValidators = append(v.defaultPreValidators, validators...)
 	return v
 }
 
@@ -124,11 +222,51 @@ func (v *Validator) AddExtensionsValidators(validators ...*ExtensionsValidator)
 	return v
 }
 
+// AddVersionFallback
+// if schema for version not found, schema for fallback version will be used
+// fallbacks can be chained: v1alpha1 -> v1beta1 -> v1
+// failVersion can contain wildcard group like */v1alpha1, and fallback can use
+// * for substitute matched group like */v1
 func (v *Validator) AddVersionFallback(failVersion, fallback string) *Validator {
 	v.versionFallbacks[failVersion] = fallback
 	return v
 }
 
+// AddVersionFallbackFunc
+// add dynamic fallback resolution. Functions call in order of adding
+// if no fallback found in fallbacks added with AddVersionFallback
+func (v *Validator) AddVersionFallbackFunc(f VersionFallbackFunc) *Validator {
+	if f != nil {
+		v.versionFallbackFuncs = append(v.versionFallbackFuncs, f)
+	}
+
+	return v
+}
+
+// AddKindAlias
+// if schema for kind not found (with version fallbacks), schema for newKind will be used
+// aliases can be chained like fallbacks. Validate returns index with matched kind
+func (v *Validator) AddKindAlias(oldKind, newKind string) *Validator {
+	v.kindAliases[oldKind] = newKind
+	return v
+}
+
+// SetMessages
+// set templates for validation errors messages
+// nil resets messages to default
+func (v *Validator) SetMessages(messages *Messages) *Validator {
+	v.messages = messages
+	return v
+}
+
+// SetLimits
+// set limits for checking documents and schemas before unmarshal
+// no limits by default
+func (v *Validator) SetLimits(limits libyaml.Limits) *Validator {
+	v.limits = limits
+	return v
+}
+
 func (v *Validator) SetLogger(loggerProvider log.LoggerProvider) *Validator {
 	v.loggerProvider = loggerProvider
 
@@ -149,38 +287,97 @@ func (v *Validator) SetDefaultTransformers(transformers ...transformer.SchemaTra
 }
 
 func (v *Validator) Get(index *SchemaIndex) *spec.Schema {
+	v.schemasMutex.RLock()
+	defer v.schemasMutex.RUnlock()
+
 	return v.schemas[*index]
 }
 
 func (v *Validator) Validate(doc *[]byte, opts ...ValidateOption) (*SchemaIndex, error) {
+	return v.ValidateContext(context.Background(), doc, opts...)
+}
+
+// ValidateContext
+// like Validate but pass ctx into PreValidators and extensions handlers
+// if ctx is done before validation, returns ctx error
+func (v *Validator) ValidateContext(ctx context.Context, doc *[]byte, opts ...ValidateOption) (*SchemaIndex, error) {
+	decrypted, err := v.decrypt(ctx, *doc)
+	if err != nil {
+		return nil, err
+	}
+
+	*doc = decrypted
+
 	// no validate for valid. checking in one place in ValidateWithIndex
-	index, err := ParseIndex(bytes.NewReader(*doc), parseIndexNoCheckValidOpt)
+	index, err := ParseIndex(
+		bytes.NewReader(*doc),
+		parseIndexNoCheckValidOpt,
+		ParseIndexWithLimits(v.limits),
+		ParseIndexWithMessages(v.messages),
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	return index, v.ValidateWithIndex(index, doc, opts...)
+	return index, v.validateWithIndex(ctx, index, doc, opts...)
 }
 
 // ValidateWithIndex
 // validate one document with schema
 // if schema not fount then return ErrSchemaNotFound
 func (v *Validator) ValidateWithIndex(index *SchemaIndex, doc *[]byte, opts ...ValidateOption) error {
-	if !index.IsValid() {
-		return index.invalidIndexErr(*doc)
+	return v.ValidateWithIndexContext(context.Background(), index, doc, opts...)
+}
+
+// ValidateWithIndexContext
+// like ValidateWithIndex but pass ctx into PreValidators and extensions handlers
+func (v *Validator) ValidateWithIndexContext(ctx context.Context, index *SchemaIndex, doc *[]byte, opts ...ValidateOption) error {
+	decrypted, err := v.decrypt(ctx, *doc)
+	if err != nil {
+		return err
 	}
 
-	options := &validateOptions{}
-	for _, opt := range opts {
-		opt(options)
+	*doc = decrypted
+
+	if err := checkLimits(*doc, v.limits); err != nil {
+		return err
+	}
+
+	return v.validateWithIndex(ctx, index, doc, opts...)
+}
+
+func (v *Validator) validateWithIndex(ctx context.Context, index *SchemaIndex, doc *[]byte, opts ...ValidateOption) error {
+	original := *doc
+
+	err := v.validateWithCache(index, doc, opts, func() error {
+		return v.instrument(index, func() error {
+			return v.validateDocument(ctx, index, doc, opts...)
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	return fillDefaultedPaths(original, *doc, newValidateOptions(opts...))
+}
+
+func (v *Validator) validateDocument(ctx context.Context, index *SchemaIndex, doc *[]byte, opts ...ValidateOption) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if !index.IsValid() {
+		return index.invalidIndexErr(v.messages, *doc)
 	}
 
+	options := newValidateOptions(opts...)
+
 	docForValidate := *doc
 
 	schema := v.getSchemaWithFallback(index)
 
 	var err error
-	schema, err = v.runPreValidation(index, schema, docForValidate)
+	schema, err = v.runPreValidation(ctx, index, schema, docForValidate)
 	if err != nil {
 		return fmt.Errorf("%w: %w", ErrDocumentValidationFailed, err)
 	}
@@ -191,14 +388,17 @@ func (v *Validator) ValidateWithIndex(index *SchemaIndex, doc *[]byte, opts ...V
 		return ErrSchemaNotFound
 	}
 
-	schema = v.addTransformersForSchema(index, schema)
+	schema, err = v.addTransformersForSchema(index, schema, docForValidate)
+	if err != nil {
+		return fmt.Errorf("cannot transform schema for %s: %w", index.String(), err)
+	}
 
-	isValid, err := v.openAPIValidate(&docForValidate, schema, options)
+	isValid, err := v.openAPIValidate(ctx, &docForValidate, schema, options)
 	if !isValid {
-		if options.omitDocInError || options.noPrettyError {
+		if options.omitDocInError || options.noPrettyError || options.sourceSnippets {
 			return fmt.Errorf("%q: %w", index.String(), err)
 		}
-		return fmt.Errorf("Document validation failed:\n---\n%s\n\n%w", string(*doc), err)
+		return fmt.Errorf("%s\n%w", v.messages.Format(MessageDocumentValidationFailed, DocumentMessageData{Doc: string(*doc)}), err)
 	}
 
 	*doc = docForValidate
@@ -206,30 +406,53 @@ func (v *Validator) ValidateWithIndex(index *SchemaIndex, doc *[]byte, opts ...V
 	return nil
 }
 
-func (v *Validator) runPreValidation(index *SchemaIndex, schema *spec.Schema, doc []byte) (*spec.Schema, error) {
-	preValidator, ok := v.preValidators[*index]
-	if ok && !govalue.IsNil(preValidator) {
-		schemaFromValidator, err := preValidator.Validate(doc, v.logger())
+func (v *Validator) runPreValidation(ctx context.Context, index *SchemaIndex, schema *spec.Schema, doc []byte) (*spec.Schema, error) {
+	preValidators := slices.Concat(v.defaultPreValidators, v.preValidators[*index])
+
+	for _, preValidator := range preValidators {
+		if govalue.IsNil(preValidator) {
+			continue
+		}
+
+		schemaFromValidator, err := runPreValidator(ctx, preValidator, doc, v.logger())
 		if err != nil {
 			return nil, err
 		}
 
 		if !govalue.IsNil(schemaFromValidator) {
-			return schemaFromValidator, nil
+			schema = schemaFromValidator
 		}
 	}
 
 	return schema, nil
 }
 
-func (v *Validator) addTransformersForSchema(index *SchemaIndex, schema *spec.Schema) *spec.Schema {
+func (v *Validator) transformersForIndex(index *SchemaIndex) []transformer.SchemaTransformer {
 	transformers := v.transformers[*index]
 	if len(transformers) == 0 {
 		transformers = v.defaultTransformers
 	}
 
-	if len(transformers) == 0 {
-		return schema
+	return transformers
+}
+
+func (v *Validator) addTransformersForSchema(index *SchemaIndex, schema *spec.Schema, doc []byte) (*spec.Schema, error) {
+	transformers := v.transformersForIndex(index)
+
+	if len(transformers) == 0 && len(v.conditionalTransformers) == 0 {
+		return schema, nil
+	}
+
+	v.transformMutex.Lock()
+	defer v.transformMutex.Unlock()
+
+	if len(transformers) > 0 {
+		var err error
+		// transformers change schema in place, registered schema can be read by another validation
+		schema, err = copySchema(schema)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	for _, t := range transformers {
@@ -240,66 +463,91 @@ func (v *Validator) addTransformersForSchema(index *SchemaIndex, schema *spec.Sc
 		schema = t.Transform(schema)
 	}
 
-	return schema
+	return v.applyConditionalTransformers(index, schema, doc)
 }
 
-func (v *Validator) getSchemaWithFallback(index *SchemaIndex) *spec.Schema {
-	schema := v.Get(index)
-	if schema != nil {
-		return schema
+func (v *Validator) openAPIValidate(ctx context.Context, dataObj *[]byte, schema *spec.Schema, options *validateOptions) (bool, error) {
+	validator := validate.NewSchemaValidator(schema, nil, "", strfmt.Default)
+
+	dataBytes := *dataObj
+	format := DetectDocumentFormat(dataBytes)
+
+	blank, err := unmarshalDocument(dataBytes, format, options)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", ErrKindInvalidYAML, err)
 	}
 
-	fallback, ok := v.versionFallbacks[index.Version]
-	if !ok || fallback == "" {
-		v.logger().DebugF("No fallback schema for version %s", index.Version)
-		return nil
+	if options.preserveNumbers {
+		normalizeNumbers(schema, blank)
 	}
 
-	index.Version = fallback
+	result := validator.Validate(blank)
+	isValid := result.IsValid()
 
-	return v.Get(index)
-}
+	var allErrs *multierror.Error
 
-func (v *Validator) openAPIValidate(dataObj *[]byte, schema *spec.Schema, options *validateOptions) (bool, error) {
-	validator := validate.NewSchemaValidator(schema, nil, "", strfmt.Default)
+	if !isValid {
+		for _, resultErr := range result.Errors {
+			violation := v.messages.schemaViolation(resultErr)
+			if options.sourceSnippets {
+				violation = renderSchemaViolationSnippet(dataBytes, resultErr, violation.Error(), options.sourceSnippetsColored)
+			}
 
-	var blank map[string]interface{}
+			allErrs = multierror.Append(allErrs, violation)
+		}
+		allErrs = multierror.Append(allErrs, explainAlternatives(schema, schema, blank, "")...)
+	}
 
-	dataBytes := *dataObj
+	for _, extensionsValidator := range v.extensionsValidators {
+		err := extensionsValidator.ValidateWithContext(ctx, v.logger(), dataBytes, *schema)
+		allErrs = multierror.Append(allErrs, extensionsErrors(err, isValid)...)
+	}
 
-	unmarshal := yaml.Unmarshal
-	msg := "json unmarshal"
-	if options.strictUnmarshal {
-		unmarshal = yaml.UnmarshalStrict
-		msg = "json unmarshal strict"
+	if isValid {
+		// Add default values from openAPISpec
+		// required when rules check after defaults applied, because rule field can have default value
+		post.ApplyDefaults(result)
 	}
 
-	if err := unmarshal(dataBytes, &blank); err != nil {
-		return false, fmt.Errorf("%w: %s: %w", ErrKindInvalidYAML, msg, err)
+	allErrs = multierror.Append(allErrs, requiredWhenViolations(v.messages, schema, blank, "")...)
+
+	if err := allErrs.ErrorOrNil(); err != nil {
+		return false, fmt.Errorf("%w: %w", ErrDocumentValidationFailed, err)
 	}
 
-	result := validator.Validate(blank)
-	if !result.IsValid() {
-		var allErrs *multierror.Error
-		allErrs = multierror.Append(allErrs, result.Errors...)
-		var resErr error = ErrDocumentValidationFailed
-		if err := allErrs.ErrorOrNil(); err != nil {
-			resErr = fmt.Errorf("%w: %w", resErr, err)
-		}
-		return false, resErr
+	*dataObj, _ = marshalDocument(result.Data(), format, options)
+
+	return true, nil
+}
+
+// extensionsErrors
+// if document does not match schema, errors for fields which cannot be walked by extensions validator
+// duplicate schema violations, so they are skipped
+func extensionsErrors(err error, isValid bool) []error {
+	if err == nil {
+		return nil
 	}
 
-	for _, extensionsValidator := range v.extensionsValidators {
-		if err := extensionsValidator.Validate(dataBytes, *schema); err != nil {
-			return false, fmt.Errorf("%w: %w", ErrDocumentValidationFailed, err)
-		}
+	var errs *multierror.Error
+	if !errors.As(err, &errs) {
+		return []error{err}
 	}
 
-	// Add default values from openAPISpec
-	post.ApplyDefaults(result)
-	*dataObj, _ = json.Marshal(result.Data())
+	if isValid {
+		return errs.Errors
+	}
 
-	return true, nil
+	res := make([]error, 0, len(errs.Errors))
+	for _, e := range errs.Errors {
+		var ruleErr *ExtensionRuleError
+		if errors.As(e, &ruleErr) && ruleErr.Rule == "" {
+			continue
+		}
+
+		res = append(res, e)
+	}
+
+	return res
 }
 
 func (v *Validator) logger() log.Logger {
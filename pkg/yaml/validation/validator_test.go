@@ -209,6 +209,54 @@ sshPort: 22456
 
 			asserValidateTestKind(t, validator, doc, ErrDocumentValidationFailed, nil)
 		})
+
+		t.Run("chain", func(t *testing.T) {
+			doc := `
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+sudoPassword: "no secret"
+sshPort: 22456
+`
+			calls := make([]string, 0)
+
+			validator := NewValidator(nil)
+			validator.SetLogger(logger)
+
+			validator.AddPreValidator(
+				indexTestKind,
+				newTestKindPreValidator(t, testSchemaAnotherTestKind),
+				newTestCallsPreValidator(&calls, "first", nil),
+			)
+			validator.AddPreValidator(indexTestKind, newTestKindPreValidator(t, testSchemaTestKind))
+			validator.AddDefaultPreValidator(newTestCallsPreValidator(&calls, "default", nil))
+
+			asserValidateTestKind(t, validator, doc, nil, &testKind{
+				SSHUser:      "ubuntu",
+				SudoPassword: "no secret",
+				SSHPort:      22456,
+			})
+
+			require.Equal(t, []string{"default", "first"}, calls)
+		})
+
+		t.Run("chain stops on error", func(t *testing.T) {
+			doc := `
+apiVersion: deckhouse.io/v1
+kind: TestKind
+sshUser: ubuntu
+sudoPassword: "no secret"
+sshPort: 22456
+`
+			calls := make([]string, 0)
+
+			validator := getValidatorTestKind(t)
+			validator.AddDefaultPreValidator(newTestCallsPreValidator(&calls, "default", fmt.Errorf("default error")))
+			validator.AddPreValidator(indexTestKind, newTestCallsPreValidator(&calls, "for index", nil))
+
+			asserValidateTestKind(t, validator, doc, ErrDocumentValidationFailed, nil)
+			require.Equal(t, []string{"default"}, calls)
+		})
 	})
 
 	t.Run("add transformers", func(t *testing.T) {
@@ -595,6 +643,25 @@ func (p *testKindPreValidator) Validate(doc []byte, _ log.Logger) (*spec.Schema,
 	return nil, fmt.Errorf("invalid SSH port: %d", v.SSHPort)
 }
 
+type testCallsPreValidator struct {
+	calls *[]string
+	name  string
+	err   error
+}
+
+func newTestCallsPreValidator(calls *[]string, name string, err error) *testCallsPreValidator {
+	return &testCallsPreValidator{
+		calls: calls,
+		name:  name,
+		err:   err,
+	}
+}
+
+func (p *testCallsPreValidator) Validate([]byte, log.Logger) (*spec.Schema, error) {
+	*p.calls = append(*p.calls, p.name)
+	return nil, p.err
+}
+
 func asserTestKind(t *testing.T, data []byte, expected *testKind) {
 	result := testKind{}
 
@@ -0,0 +1,330 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/spec"
+
+	libyaml "github.com/deckhouse/lib-dhctl/pkg/yaml"
+)
+
+const defaultWatchSchemasInterval = 5 * time.Second
+
+var schemasFilesExtensions = []string{".yaml", ".yml", ".json"}
+
+type watchSchemasOptions struct {
+	interval time.Duration
+	polling  bool
+	onReload func(err error)
+}
+
+// schemasDirNotifier
+// sends event to Events channel when files in dir may be changed
+// Events channel is closed when notifier cannot send events anymore
+type schemasDirNotifier interface {
+	Events() <-chan struct{}
+	Close() error
+}
+
+type WatchSchemasOption func(o *watchSchemasOptions)
+
+// WatchSchemasWithInterval
+// set interval for polling changes in directory, 5 seconds by default
+// used if file system events are not available or polling forced with WatchSchemasWithPolling
+func WatchSchemasWithInterval(interval time.Duration) WatchSchemasOption {
+	return func(o *watchSchemasOptions) {
+		o.interval = interval
+	}
+}
+
+// WatchSchemasWithPolling
+// use polling instead of file system events,
+// for example for network filesystems where inotify events are not delivered
+func WatchSchemasWithPolling() WatchSchemasOption {
+	return func(o *watchSchemasOptions) {
+		o.polling = true
+	}
+}
+
+// WatchSchemasWithOnReload
+// set function which will be called after every reload attempt
+// err is nil if schemas were reloaded successfully
+func WatchSchemasWithOnReload(f func(err error)) WatchSchemasOption {
+	return func(o *watchSchemasOptions) {
+		o.onReload = f
+	}
+}
+
+// WatchSchemasDir
+// loads schemas from all yaml and json files in dir and reloads them when files changed
+// changes are detected with inotify events on linux (fsnotify is not used to avoid new dependency),
+// polling files names, sizes and modification times is used on other systems,
+// if inotify cannot be initialized, after watched dir was removed or with WatchSchemasWithPolling
+// schemas are reloaded only if files names, sizes or modification times changed
+// reloaded schemas are swapped atomically: schemas from dir which were removed are deleted,
+// schemas added with AddSchema or loaded from another sources are kept,
+// also schemas from dir replaced with AddSchema (or AddSchemaOverlay) are kept and not reloaded
+// if reload failed, error is logged and previous schemas are kept
+// returns error if initial loading failed. Watching stops when ctx done
+func (v *Validator) WatchSchemasDir(ctx context.Context, dir string, opts ...WatchSchemasOption) error {
+	options := &watchSchemasOptions{
+		interval: defaultWatchSchemasInterval,
+	}
+
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	snapshot, err := schemasDirSnapshot(dir)
+	if err != nil {
+		return err
+	}
+
+	loaded, err := v.loadSchemasDir(dir)
+	if err != nil {
+		return err
+	}
+
+	installed := v.swapSchemas(nil, loaded)
+
+	notifier := v.newSchemasDirNotifier(dir, options)
+
+	go func() {
+		defer func() {
+			_ = notifier.Close()
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case _, ok := <-notifier.Events():
+				if !ok {
+					v.logger().DebugF("Events for %s are not available, switch to polling", dir)
+					notifier = newPollingSchemasDirNotifier(options.interval)
+					continue
+				}
+			}
+
+			newSnapshot, err := schemasDirSnapshot(dir)
+			if err == nil && newSnapshot == snapshot {
+				continue
+			}
+
+			if err == nil {
+				var newLoaded map[SchemaIndex]*spec.Schema
+				newLoaded, err = v.loadSchemasDir(dir)
+				if err == nil {
+					installed = v.swapSchemas(installed, newLoaded)
+					snapshot = newSnapshot
+					v.logger().DebugF("Schemas from %s reloaded", dir)
+				}
+			}
+
+			if err != nil {
+				v.logger().WarnF("Cannot reload schemas from %s: %v", dir, err)
+			}
+
+			if options.onReload != nil {
+				options.onReload(err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (v *Validator) newSchemasDirNotifier(dir string, options *watchSchemasOptions) schemasDirNotifier {
+	if !options.polling {
+		notifier, err := newSchemasDirNotifier(dir)
+		if err == nil {
+			return notifier
+		}
+
+		v.logger().DebugF("Cannot watch events for %s, use polling: %v", dir, err)
+	}
+
+	return newPollingSchemasDirNotifier(options.interval)
+}
+
+type pollingSchemasDirNotifier struct {
+	ticker *time.Ticker
+	events chan struct{}
+	done   chan struct{}
+	once   sync.Once
+}
+
+func newPollingSchemasDirNotifier(interval time.Duration) *pollingSchemasDirNotifier {
+	n := &pollingSchemasDirNotifier{
+		ticker: time.NewTicker(interval),
+		events: make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+
+	go func() {
+		for {
+			select {
+			case <-n.done:
+				return
+			case <-n.ticker.C:
+			}
+
+			select {
+			case <-n.done:
+				return
+			case n.events <- struct{}{}:
+			}
+		}
+	}()
+
+	return n
+}
+
+func (n *pollingSchemasDirNotifier) Events() <-chan struct{} {
+	return n.events
+}
+
+func (n *pollingSchemasDirNotifier) Close() error {
+	n.once.Do(func() {
+		n.ticker.Stop()
+		close(n.done)
+	})
+
+	return nil
+}
+
+// swapSchemas
+// replaces schemas installed by watcher with loaded under one lock
+// only schemas which were installed by watcher and were not replaced with AddSchema are deleted,
+// schemas replaced with AddSchema are kept and are not overwritten by loaded ones
+// returns schemas installed by watcher
+func (v *Validator) swapSchemas(installed, loaded map[SchemaIndex]*spec.Schema) map[SchemaIndex]*spec.Schema {
+	v.schemasMutex.Lock()
+	defer v.schemasMutex.Unlock()
+
+	replaced := make(map[SchemaIndex]struct{})
+	for index, schema := range installed {
+		current, ok := v.schemas[index]
+		if !ok {
+			continue
+		}
+
+		if current != schema {
+			replaced[index] = struct{}{}
+			continue
+		}
+
+		delete(v.schemas, index)
+	}
+
+	result := make(map[SchemaIndex]*spec.Schema, len(loaded))
+	for index, schema := range loaded {
+		if _, ok := replaced[index]; ok {
+			continue
+		}
+
+		v.schemas[index] = schema
+		result[index] = schema
+	}
+
+	v.purgeCache()
+
+	return result
+}
+
+func (v *Validator) loadSchemasDir(dir string) (map[SchemaIndex]*spec.Schema, error) {
+	files, err := schemasFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[SchemaIndex]*spec.Schema)
+
+	for _, file := range files {
+		schemas, err := loadSchemasFile(file, v.limits)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, sc := range schemas {
+			result[sc.Index] = sc.Schema
+		}
+	}
+
+	return result, nil
+}
+
+func loadSchemasFile(path string, limits libyaml.Limits) ([]*SchemaWithIndex, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRead, err)
+	}
+	defer file.Close()
+
+	schemas, err := LoadSchemas(file, LoadSchemasWithLimits(limits))
+	if err != nil {
+		return nil, fmt.Errorf("cannot load schemas from %s: %w", path, err)
+	}
+
+	return schemas, nil
+}
+
+func schemasFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRead, err)
+	}
+
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !slices.Contains(schemasFilesExtensions, filepath.Ext(entry.Name())) {
+			continue
+		}
+
+		files = append(files, filepath.Join(dir, entry.Name()))
+	}
+
+	return files, nil
+}
+
+// schemasDirSnapshot
+// returns string which changes when any schema file added, removed or changed
+func schemasDirSnapshot(dir string) (string, error) {
+	files, err := schemasFiles(dir)
+	if err != nil {
+		return "", err
+	}
+
+	b := strings.Builder{}
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return "", fmt.Errorf("%w: %w", ErrRead, err)
+		}
+
+		b.WriteString(fmt.Sprintf("%s:%d:%d\n", file, info.Size(), info.ModTime().UnixNano()))
+	}
+
+	return b.String(), nil
+}
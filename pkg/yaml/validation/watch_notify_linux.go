@@ -0,0 +1,109 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package validation
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// schemasDirEventsMask
+// IN_MODIFY is not watched because file can be partially written,
+// changes are handled after IN_CLOSE_WRITE or rename (editors and kubernetes configmaps)
+const schemasDirEventsMask = syscall.IN_CLOSE_WRITE |
+	syscall.IN_MOVED_TO |
+	syscall.IN_MOVED_FROM |
+	syscall.IN_DELETE |
+	syscall.IN_ATTRIB |
+	syscall.IN_DELETE_SELF |
+	syscall.IN_MOVE_SELF
+
+type inotifySchemasDirNotifier struct {
+	file   *os.File
+	events chan struct{}
+}
+
+// newSchemasDirNotifier
+// returns notifier based on inotify events for dir
+func newSchemasDirNotifier(dir string) (schemasDirNotifier, error) {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot init inotify: %w", err)
+	}
+
+	if _, err := syscall.InotifyAddWatch(fd, dir, schemasDirEventsMask); err != nil {
+		_ = syscall.Close(fd)
+		return nil, fmt.Errorf("Cannot watch %s with inotify: %w", dir, err)
+	}
+
+	n := &inotifySchemasDirNotifier{
+		// non-blocking fd is registered in runtime poller, so Close interrupts Read
+		file:   os.NewFile(uintptr(fd), "inotify"),
+		events: make(chan struct{}, 1),
+	}
+
+	go n.read()
+
+	return n, nil
+}
+
+func (n *inotifySchemasDirNotifier) Events() <-chan struct{} {
+	return n.events
+}
+
+func (n *inotifySchemasDirNotifier) Close() error {
+	return n.file.Close()
+}
+
+// read
+// sends event for every read batch and closes events channel when
+// notifier closed or watched dir removed
+func (n *inotifySchemasDirNotifier) read() {
+	defer close(n.events)
+
+	buf := make([]byte, 64*(syscall.SizeofInotifyEvent+syscall.NAME_MAX+1))
+
+	for {
+		count, err := n.file.Read(buf)
+		if err != nil {
+			return
+		}
+
+		removed := false
+		for offset := 0; offset+syscall.SizeofInotifyEvent <= count; {
+			event := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+			if event.Mask&syscall.IN_IGNORED != 0 {
+				removed = true
+			}
+
+			offset += syscall.SizeofInotifyEvent + int(event.Len)
+		}
+
+		// events are coalesced, watcher checks dir snapshot after every event
+		select {
+		case n.events <- struct{}{}:
+		default:
+		}
+
+		if removed {
+			_ = n.file.Close()
+			return
+		}
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchSchemasDir(t *testing.T) {
+	const (
+		watchKindSchema = `
+kind: WatchKind
+apiVersions:
+- apiVersion: deckhouse.io/v1
+  openAPISpec:
+    type: object
+    properties:
+      apiVersion:
+        type: string
+      kind:
+        type: string
+      replicas:
+        type: integer
+        maximum: %MAX%
+`
+		watchKindDoc = `
+apiVersion: deckhouse.io/v1
+kind: WatchKind
+replicas: 3
+`
+	)
+
+	dir := t.TempDir()
+	schemaPath := filepath.Join(dir, "watch_kind.yaml")
+
+	writeSchema := func(t *testing.T, content string) {
+		require.NoError(t, os.WriteFile(schemaPath, []byte(content), 0o644))
+	}
+
+	validate := func(t *testing.T, validator *Validator) error {
+		doc := []byte(watchKindDoc)
+		_, err := validator.Validate(&doc)
+		return err
+	}
+
+	writeSchema(t, strings.ReplaceAll(watchKindSchema, "%MAX%", "5"))
+	// not schema file should be skipped
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# schemas"), 0o644))
+
+	validator := NewValidator(nil).SetLogger(testGetLogger())
+	require.NoError(t, validator.LoadSchemas(strings.NewReader(testSchemaTestKind)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reloads := make(chan error, 10)
+	err := validator.WatchSchemasDir(
+		ctx,
+		dir,
+		WatchSchemasWithInterval(10*time.Millisecond),
+		WatchSchemasWithOnReload(func(err error) {
+			reloads <- err
+		}),
+	)
+	require.NoError(t, err)
+
+	waitReload := func(t *testing.T) error {
+		select {
+		case err := <-reloads:
+			return err
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "timeout waiting reload")
+			return nil
+		}
+	}
+
+	require.NoError(t, validate(t, validator), "initial schemas should be loaded")
+
+	writeSchema(t, strings.ReplaceAll(watchKindSchema, "%MAX%", "2"))
+	require.NoError(t, waitReload(t))
+	require.ErrorIs(t, validate(t, validator), ErrDocumentValidationFailed, "should use reloaded schema")
+
+	writeSchema(t, "kind: [invalid")
+	require.Error(t, waitReload(t))
+	require.ErrorIs(t, validate(t, validator), ErrDocumentValidationFailed, "should keep previous schema")
+
+	require.NoError(t, os.Remove(schemaPath))
+	require.NoError(t, waitReload(t))
+	require.ErrorIs(t, validate(t, validator), ErrSchemaNotFound, "should delete removed schema")
+	require.NotNil(t, validator.Get(&indexTestKind), "should keep not watched schemas")
+
+	t.Run("keep schemas replaced with AddSchema", func(t *testing.T) {
+		dir := t.TempDir()
+		schemaPath := filepath.Join(dir, "watch_kind.yaml")
+		require.NoError(t, os.WriteFile(schemaPath, []byte(strings.ReplaceAll(watchKindSchema, "%MAX%", "5")), 0o644))
+
+		validator := NewValidator(nil).SetLogger(testGetLogger())
+
+		reloads := make(chan error, 10)
+		err := validator.WatchSchemasDir(
+			ctx,
+			dir,
+			WatchSchemasWithInterval(10*time.Millisecond),
+			WatchSchemasWithOnReload(func(err error) {
+				reloads <- err
+			}),
+		)
+		require.NoError(t, err)
+
+		index := SchemaIndex{Kind: "WatchKind", Version: "deckhouse.io/v1"}
+		require.NotNil(t, validator.Get(&index), "initial schemas should be loaded")
+
+		userSchema := &spec.Schema{SchemaProps: spec.SchemaProps{Type: spec.StringOrArray{"object"}}}
+		validator.AddSchema(index, userSchema)
+
+		require.NoError(t, os.WriteFile(schemaPath, []byte(strings.ReplaceAll(watchKindSchema, "%MAX%", "2")), 0o644))
+		select {
+		case err := <-reloads:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "timeout waiting reload")
+		}
+		require.Same(t, userSchema, validator.Get(&index), "should not overwrite schema added by user")
+
+		require.NoError(t, os.Remove(schemaPath))
+		select {
+		case err := <-reloads:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "timeout waiting reload")
+		}
+		require.Same(t, userSchema, validator.Get(&index), "should not delete schema added by user")
+	})
+
+	t.Run("initial load error", func(t *testing.T) {
+		err := NewValidator(nil).WatchSchemasDir(ctx, filepath.Join(dir, "absent"))
+		require.ErrorIs(t, err, ErrRead)
+	})
+}
+
+func TestWatchSchemasDirNotifiers(t *testing.T) {
+	const schema = `
+kind: NotifyKind
+apiVersions:
+- apiVersion: deckhouse.io/v1
+  openAPISpec:
+    type: object
+    properties:
+      apiVersion:
+        type: string
+      kind:
+        type: string
+`
+	index := SchemaIndex{Kind: "NotifyKind", Version: "deckhouse.io/v1"}
+
+	// one logger for all watchers, because pretty logger initializes global state
+	logger := testGetLogger()
+
+	watch := func(t *testing.T, dir string, opts ...WatchSchemasOption) (*Validator, <-chan error) {
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+
+		validator := NewValidator(nil).SetLogger(logger)
+
+		reloads := make(chan error, 10)
+		opts = append(opts, WatchSchemasWithOnReload(func(err error) {
+			reloads <- err
+		}))
+
+		require.NoError(t, validator.WatchSchemasDir(ctx, dir, opts...))
+
+		return validator, reloads
+	}
+
+	waitReload := func(t *testing.T, reloads <-chan error) error {
+		select {
+		case err := <-reloads:
+			return err
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "timeout waiting reload")
+			return nil
+		}
+	}
+
+	t.Run("events", func(t *testing.T) {
+		if runtime.GOOS != "linux" {
+			t.Skip("file system events are supported only on linux")
+		}
+
+		dir := t.TempDir()
+		// polling is not used with events
+		validator, reloads := watch(t, dir, WatchSchemasWithInterval(time.Hour))
+		require.Nil(t, validator.Get(&index))
+
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "notify.yaml"), []byte(schema), 0o644))
+		require.NoError(t, waitReload(t, reloads))
+		require.NotNil(t, validator.Get(&index))
+
+		// rename is used by editors and kubernetes configmaps
+		require.NoError(t, os.Rename(filepath.Join(dir, "notify.yaml"), filepath.Join(dir, "notify.txt")))
+		require.NoError(t, waitReload(t, reloads))
+		require.Nil(t, validator.Get(&index))
+	})
+
+	t.Run("polling", func(t *testing.T) {
+		dir := t.TempDir()
+		validator, reloads := watch(t, dir, WatchSchemasWithPolling(), WatchSchemasWithInterval(10*time.Millisecond))
+
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "notify.yaml"), []byte(schema), 0o644))
+		require.NoError(t, waitReload(t, reloads))
+		require.NotNil(t, validator.Get(&index))
+	})
+
+	t.Run("switch to polling after dir removed", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "schemas")
+		require.NoError(t, os.Mkdir(dir, 0o755))
+		validator, reloads := watch(t, dir, WatchSchemasWithInterval(10*time.Millisecond))
+
+		require.NoError(t, os.Remove(dir))
+		require.ErrorIs(t, waitReload(t, reloads), ErrRead)
+
+		require.NoError(t, os.Mkdir(dir, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "notify.yaml"), []byte(schema), 0o644))
+
+		for {
+			if err := waitReload(t, reloads); err == nil {
+				break
+			}
+		}
+		require.NotNil(t, validator.Get(&index))
+	})
+}